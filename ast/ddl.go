@@ -36,7 +36,11 @@ var (
 	_ DDLNode = &CreateSequenceStmt{}
 	_ DDLNode = &CreatePlacementPolicyStmt{}
 	_ DDLNode = &DropDatabaseStmt{}
+	_ DDLNode = &CreateTriggerStmt{}
 	_ DDLNode = &DropTriggerStmt{}
+	_ DDLNode = &CreateEventStmt{}
+	_ DDLNode = &AlterEventStmt{}
+	_ DDLNode = &DropEventStmt{}
 	_ DDLNode = &DropIndexStmt{}
 	_ DDLNode = &DropTableStmt{}
 	_ DDLNode = &DropSequenceStmt{}
@@ -226,6 +230,167 @@ func (n *AlterDatabaseStmt) isAllPlacementOptions() bool {
 	return true
 }
 
+// TriggerTiming is the action time of a trigger.
+type TriggerTiming int
+
+// Trigger action times.
+const (
+	TriggerBefore TriggerTiming = iota + 1
+	TriggerAfter
+)
+
+// String implements fmt.Stringer interface.
+func (t TriggerTiming) String() string {
+	switch t {
+	case TriggerBefore:
+		return "BEFORE"
+	case TriggerAfter:
+		return "AFTER"
+	}
+	return ""
+}
+
+// TriggerEvent is the kind of operation that activates a trigger.
+type TriggerEvent int
+
+// Trigger events.
+const (
+	TriggerEventInsert TriggerEvent = iota + 1
+	TriggerEventUpdate
+	TriggerEventDelete
+)
+
+// String implements fmt.Stringer interface.
+func (t TriggerEvent) String() string {
+	switch t {
+	case TriggerEventInsert:
+		return "INSERT"
+	case TriggerEventUpdate:
+		return "UPDATE"
+	case TriggerEventDelete:
+		return "DELETE"
+	}
+	return ""
+}
+
+// TriggerOrderType is the type of the trigger order clause.
+type TriggerOrderType int
+
+// Trigger order types.
+const (
+	TriggerOrderFollows TriggerOrderType = iota + 1
+	TriggerOrderPrecedes
+)
+
+// TriggerOrder is the FOLLOWS|PRECEDES clause of CREATE TRIGGER.
+type TriggerOrder struct {
+	Tp           TriggerOrderType
+	OtherTrigger model.CIStr
+}
+
+// Restore implements Node interface.
+func (n *TriggerOrder) Restore(ctx *format.RestoreCtx) error {
+	switch n.Tp {
+	case TriggerOrderFollows:
+		ctx.WriteKeyWord("FOLLOWS ")
+	case TriggerOrderPrecedes:
+		ctx.WriteKeyWord("PRECEDES ")
+	default:
+		return errors.Errorf("invalid TriggerOrderType: %d", n.Tp)
+	}
+	ctx.WriteName(n.OtherTrigger.O)
+	return nil
+}
+
+// CreateTriggerStmt is a statement to create a trigger.
+// See https://dev.mysql.com/doc/refman/8.0/en/create-trigger.html
+type CreateTriggerStmt struct {
+	ddlNode
+
+	IfNotExists bool
+	Definer     *auth.UserIdentity
+	Trigger     *TableName
+	Timing      TriggerTiming
+	Event       TriggerEvent
+	Table       *TableName
+	Order       *TriggerOrder
+	Body        StmtNode
+}
+
+// Restore implements Node interface.
+func (n *CreateTriggerStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("CREATE ")
+	if err := restoreDefiner(ctx, n.Definer); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateTriggerStmt.Definer")
+	}
+	ctx.WriteKeyWord("TRIGGER ")
+	if n.IfNotExists {
+		ctx.WriteKeyWord("IF NOT EXISTS ")
+	}
+	if err := n.Trigger.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateTriggerStmt.Trigger")
+	}
+	ctx.WritePlain(" ")
+	ctx.WriteKeyWord(n.Timing.String())
+	ctx.WritePlain(" ")
+	ctx.WriteKeyWord(n.Event.String())
+	ctx.WriteKeyWord(" ON ")
+	if err := n.Table.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateTriggerStmt.Table")
+	}
+	ctx.WriteKeyWord(" FOR EACH ROW ")
+	if n.Order != nil {
+		if err := n.Order.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore CreateTriggerStmt.Order")
+		}
+		ctx.WritePlain(" ")
+	}
+	if err := n.Body.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateTriggerStmt.Body")
+	}
+	return nil
+}
+
+// restoreDefiner restores the `DEFINER = user ` clause of a stored object, such as
+// a trigger, a stored routine or an event. The clause is omitted for the current user.
+func restoreDefiner(ctx *format.RestoreCtx, definer *auth.UserIdentity) error {
+	if definer == nil || definer.CurrentUser {
+		return nil
+	}
+	ctx.WriteKeyWord("DEFINER")
+	ctx.WritePlain(" = ")
+	if err := definer.Restore(ctx); err != nil {
+		return err
+	}
+	ctx.WritePlain(" ")
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *CreateTriggerStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CreateTriggerStmt)
+	node, ok := n.Trigger.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Trigger = node.(*TableName)
+	node, ok = n.Table.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Table = node.(*TableName)
+	node, ok = n.Body.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Body = node.(StmtNode)
+	return v.Leave(n)
+}
+
 // DropTriggerStmt is a statement to drop a trigger in the database.
 // See https://dev.mysql.com/doc/refman/5.7/en/drop-trigger.html
 type DropTriggerStmt struct {
@@ -254,6 +419,292 @@ func (n *DropTriggerStmt) Accept(v Visitor) (Node, bool) {
 		return v.Leave(newNode)
 	}
 	n = newNode.(*DropTriggerStmt)
+	node, ok := n.Trigger.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Trigger = node.(*TableName)
+	return v.Leave(n)
+}
+
+// EventSchedule is the ON SCHEDULE clause of CREATE/ALTER EVENT.
+type EventSchedule struct {
+	// At is the time of a one-time event, nil for recurring events.
+	At ExprNode
+	// Every and Unit are the interval of a recurring event.
+	Every  ExprNode
+	Unit   TimeUnitType
+	Starts ExprNode
+	Ends   ExprNode
+}
+
+// Restore implements Node interface.
+func (n *EventSchedule) Restore(ctx *format.RestoreCtx) error {
+	if n.At != nil {
+		ctx.WriteKeyWord("AT ")
+		if err := n.At.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore EventSchedule.At")
+		}
+		return nil
+	}
+	ctx.WriteKeyWord("EVERY ")
+	if err := n.Every.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore EventSchedule.Every")
+	}
+	ctx.WritePlain(" ")
+	ctx.WriteKeyWord(n.Unit.String())
+	if n.Starts != nil {
+		ctx.WriteKeyWord(" STARTS ")
+		if err := n.Starts.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore EventSchedule.Starts")
+		}
+	}
+	if n.Ends != nil {
+		ctx.WriteKeyWord(" ENDS ")
+		if err := n.Ends.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore EventSchedule.Ends")
+		}
+	}
+	return nil
+}
+
+func (n *EventSchedule) accept(v Visitor) bool {
+	for _, expr := range []*ExprNode{&n.At, &n.Every, &n.Starts, &n.Ends} {
+		if *expr == nil {
+			continue
+		}
+		node, ok := (*expr).Accept(v)
+		if !ok {
+			return false
+		}
+		*expr = node.(ExprNode)
+	}
+	return true
+}
+
+// EventCompletion is the ON COMPLETION clause of an event.
+type EventCompletion int
+
+// Event completion types.
+const (
+	EventCompletionUnspecified EventCompletion = iota
+	EventCompletionPreserve
+	EventCompletionNotPreserve
+)
+
+// String implements fmt.Stringer interface.
+func (c EventCompletion) String() string {
+	switch c {
+	case EventCompletionPreserve:
+		return "ON COMPLETION PRESERVE"
+	case EventCompletionNotPreserve:
+		return "ON COMPLETION NOT PRESERVE"
+	}
+	return ""
+}
+
+// EventStatus is the ENABLE|DISABLE|DISABLE ON {REPLICA|SLAVE} clause of an event.
+type EventStatus int
+
+// Event status types.
+const (
+	EventStatusUnspecified EventStatus = iota
+	EventStatusEnable
+	EventStatusDisable
+	EventStatusDisableOnSlave
+	// EventStatusDisableOnReplica is the same as EventStatusDisableOnSlave,
+	// it is the spelling since MySQL 8.0.22.
+	EventStatusDisableOnReplica
+)
+
+// String implements fmt.Stringer interface.
+func (s EventStatus) String() string {
+	switch s {
+	case EventStatusEnable:
+		return "ENABLE"
+	case EventStatusDisable:
+		return "DISABLE"
+	case EventStatusDisableOnSlave:
+		return "DISABLE ON SLAVE"
+	case EventStatusDisableOnReplica:
+		return "DISABLE ON REPLICA"
+	}
+	return ""
+}
+
+func restoreEventOptions(ctx *format.RestoreCtx, completion EventCompletion, status EventStatus, comment string) {
+	if completion != EventCompletionUnspecified {
+		ctx.WritePlain(" ")
+		ctx.WriteKeyWord(completion.String())
+	}
+	if status != EventStatusUnspecified {
+		ctx.WritePlain(" ")
+		ctx.WriteKeyWord(status.String())
+	}
+	if comment != "" {
+		ctx.WriteKeyWord(" COMMENT ")
+		ctx.WriteString(comment)
+	}
+}
+
+// CreateEventStmt is a statement to create an event.
+// See https://dev.mysql.com/doc/refman/8.0/en/create-event.html
+type CreateEventStmt struct {
+	ddlNode
+
+	Definer      *auth.UserIdentity
+	IfNotExists  bool
+	Name         *TableName
+	Schedule     *EventSchedule
+	OnCompletion EventCompletion
+	Status       EventStatus
+	Comment      string
+	Body         StmtNode
+}
+
+// Restore implements Node interface.
+func (n *CreateEventStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("CREATE ")
+	if err := restoreDefiner(ctx, n.Definer); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateEventStmt.Definer")
+	}
+	ctx.WriteKeyWord("EVENT ")
+	if n.IfNotExists {
+		ctx.WriteKeyWord("IF NOT EXISTS ")
+	}
+	if err := n.Name.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateEventStmt.Name")
+	}
+	ctx.WriteKeyWord(" ON SCHEDULE ")
+	if err := n.Schedule.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateEventStmt.Schedule")
+	}
+	restoreEventOptions(ctx, n.OnCompletion, n.Status, n.Comment)
+	ctx.WriteKeyWord(" DO ")
+	if err := n.Body.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateEventStmt.Body")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *CreateEventStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CreateEventStmt)
+	if !n.Schedule.accept(v) {
+		return n, false
+	}
+	node, ok := n.Body.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Body = node.(StmtNode)
+	return v.Leave(n)
+}
+
+// AlterEventStmt is a statement to change an event.
+// See https://dev.mysql.com/doc/refman/8.0/en/alter-event.html
+type AlterEventStmt struct {
+	ddlNode
+
+	Definer *auth.UserIdentity
+	Name    *TableName
+	// Schedule, RenameTo and Body are nil when they are not changed.
+	Schedule     *EventSchedule
+	OnCompletion EventCompletion
+	RenameTo     *TableName
+	Status       EventStatus
+	Comment      string
+	Body         StmtNode
+}
+
+// Restore implements Node interface.
+func (n *AlterEventStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("ALTER ")
+	if err := restoreDefiner(ctx, n.Definer); err != nil {
+		return errors.Annotate(err, "An error occurred while restore AlterEventStmt.Definer")
+	}
+	ctx.WriteKeyWord("EVENT ")
+	if err := n.Name.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore AlterEventStmt.Name")
+	}
+	if n.Schedule != nil {
+		ctx.WriteKeyWord(" ON SCHEDULE ")
+		if err := n.Schedule.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore AlterEventStmt.Schedule")
+		}
+	}
+	if n.OnCompletion != EventCompletionUnspecified {
+		ctx.WritePlain(" ")
+		ctx.WriteKeyWord(n.OnCompletion.String())
+	}
+	if n.RenameTo != nil {
+		ctx.WriteKeyWord(" RENAME TO ")
+		if err := n.RenameTo.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore AlterEventStmt.RenameTo")
+		}
+	}
+	restoreEventOptions(ctx, EventCompletionUnspecified, n.Status, n.Comment)
+	if n.Body != nil {
+		ctx.WriteKeyWord(" DO ")
+		if err := n.Body.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore AlterEventStmt.Body")
+		}
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *AlterEventStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*AlterEventStmt)
+	if n.Schedule != nil && !n.Schedule.accept(v) {
+		return n, false
+	}
+	if n.Body != nil {
+		node, ok := n.Body.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Body = node.(StmtNode)
+	}
+	return v.Leave(n)
+}
+
+// DropEventStmt is a statement to drop an event.
+// See https://dev.mysql.com/doc/refman/8.0/en/drop-event.html
+type DropEventStmt struct {
+	ddlNode
+
+	IfExists bool
+	Name     *TableName
+}
+
+// Restore implements Node interface.
+func (n *DropEventStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("DROP EVENT ")
+	if n.IfExists {
+		ctx.WriteKeyWord("IF EXISTS ")
+	}
+	if err := n.Name.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore DropEventStmt.Name")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *DropEventStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*DropEventStmt)
 	return v.Leave(n)
 }
 
@@ -544,6 +995,7 @@ const (
 	ColumnOptionColumnFormat
 	ColumnOptionStorage
 	ColumnOptionAutoRandom
+	ColumnOptionSrid
 )
 
 var (
@@ -574,6 +1026,8 @@ type ColumnOption struct {
 	// Name is only used for Check Constraint name.
 	ConstraintName string
 	PrimaryKeyTp   model.PrimaryKeyType
+	// Srid is only used for ColumnOptionSrid, it's the spatial reference system ID of a spatial column.
+	Srid uint32
 }
 
 // Restore implements Node interface.
@@ -669,6 +1123,9 @@ func (n *ColumnOption) Restore(ctx *format.RestoreCtx) error {
 			}
 			return nil
 		})
+	case ColumnOptionSrid:
+		ctx.WriteKeyWord("SRID ")
+		ctx.WritePlainf("%d", n.Srid)
 	default:
 		return errors.New("An error occurred while splicing ColumnOption")
 	}
@@ -806,6 +1263,7 @@ const (
 	ConstraintForeignKey
 	ConstraintFulltext
 	ConstraintCheck
+	ConstraintSpatial
 )
 
 // Constraint is constraint for table definition.
@@ -860,6 +1318,8 @@ func (n *Constraint) Restore(ctx *format.RestoreCtx) error {
 		ctx.WriteKeyWord("UNIQUE INDEX")
 	case ConstraintFulltext:
 		ctx.WriteKeyWord("FULLTEXT")
+	case ConstraintSpatial:
+		ctx.WriteKeyWord("SPATIAL")
 	case ConstraintCheck:
 		if n.Name != "" {
 			ctx.WriteKeyWord("CONSTRAINT ")
@@ -2556,12 +3016,88 @@ const (
 	// SequenceRestart is only used in alter sequence statement.
 	SequenceRestart
 	SequenceRestartWith
+	// SequenceTypeOption, SequenceWorkerID and SequenceStep are used by the
+	// sequences managed by the proxy.
+	SequenceTypeOption
+	SequenceWorkerID
+	SequenceStep
+	// SequenceSetValue is only used in alter sequence statement.
+	SequenceSetValue
+)
+
+// SequenceType is the type of sequence managed by the proxy.
+type SequenceType int
+
+// Sequence types.
+const (
+	// SequenceTypeNone is a sequence not managed by the proxy.
+	SequenceTypeNone SequenceType = iota
+	// SequenceTypeGroup allocates ids by steps of a counter shared by a group of data sources.
+	SequenceTypeGroup
+	// SequenceTypeSnowflake generates time based ids, it has no state to store.
+	SequenceTypeSnowflake
+	// SequenceTypeSegment allocates ids by segments fetched from the storage.
+	SequenceTypeSegment
 )
 
+// MaxSequenceWorkerID is the max worker id of snowflake sequence, the worker id takes 10 bits.
+const MaxSequenceWorkerID = 1<<10 - 1
+
+// String implements fmt.Stringer interface.
+func (t SequenceType) String() string {
+	switch t {
+	case SequenceTypeGroup:
+		return "GROUP"
+	case SequenceTypeSnowflake:
+		return "SNOWFLAKE"
+	case SequenceTypeSegment:
+		return "SEGMENT"
+	}
+	return ""
+}
+
 // SequenceOption is used for parsing sequence option from SQL.
 type SequenceOption struct {
 	Tp       SequenceOptionType
 	IntValue int64
+	SeqType  SequenceType
+}
+
+// CheckSequenceOptions checks the ranges of the type-specific options and that
+// they match the type given by the TYPE option. The type of an altered sequence
+// is unknown if it is not given, so the type-specific options are only checked
+// against the TYPE option in the same statement.
+func CheckSequenceOptions(opts []*SequenceOption, isAlter bool) error {
+	tp, hasType := SequenceTypeNone, false
+	for _, opt := range opts {
+		if opt.Tp != SequenceTypeOption {
+			continue
+		}
+		if hasType {
+			return errors.New("duplicated sequence TYPE option")
+		}
+		tp, hasType = opt.SeqType, true
+	}
+	checkType := !isAlter || hasType
+	for _, opt := range opts {
+		switch opt.Tp {
+		case SequenceWorkerID:
+			if checkType && tp != SequenceTypeSnowflake {
+				return errors.Errorf("WORKER_ID is only supported by SNOWFLAKE sequence")
+			}
+			if opt.IntValue < 0 || opt.IntValue > MaxSequenceWorkerID {
+				return errors.Errorf("WORKER_ID should be in the range of [0, %d]", MaxSequenceWorkerID)
+			}
+		case SequenceStep:
+			if checkType && tp != SequenceTypeGroup && tp != SequenceTypeSegment {
+				return errors.Errorf("STEP is only supported by GROUP and SEGMENT sequence")
+			}
+			if opt.IntValue <= 0 {
+				return errors.Errorf("STEP should be positive")
+			}
+		}
+	}
+	return nil
 }
 
 func (n *SequenceOption) Restore(ctx *format.RestoreCtx) error {
@@ -2596,6 +3132,19 @@ func (n *SequenceOption) Restore(ctx *format.RestoreCtx) error {
 	case SequenceRestartWith:
 		ctx.WriteKeyWord("RESTART WITH ")
 		ctx.WritePlainf("%d", n.IntValue)
+	case SequenceTypeOption:
+		ctx.WriteKeyWord("TYPE ")
+		ctx.WritePlain("= ")
+		ctx.WriteKeyWord(n.SeqType.String())
+	case SequenceWorkerID:
+		ctx.WriteKeyWord("WORKER_ID ")
+		ctx.WritePlainf("= %d", n.IntValue)
+	case SequenceStep:
+		ctx.WriteKeyWord("STEP ")
+		ctx.WritePlainf("= %d", n.IntValue)
+	case SequenceSetValue:
+		ctx.WriteKeyWord("SET VALUE ")
+		ctx.WritePlainf("= %d", n.IntValue)
 	default:
 		return errors.Errorf("invalid SequenceOption: %d", n.Tp)
 	}
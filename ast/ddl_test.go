@@ -18,6 +18,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/arana-db/parser"
 	. "github.com/arana-db/parser/ast"
 	"github.com/arana-db/parser/format"
 )
@@ -36,7 +37,12 @@ func TestDDLVisitorCover(t *testing.T) {
 		{&CreateDatabaseStmt{}, 0, 0},
 		{&AlterDatabaseStmt{}, 0, 0},
 		{&DropDatabaseStmt{}, 0, 0},
-		{&DropTriggerStmt{}, 0, 0},
+		{&CreateTriggerStmt{Trigger: &TableName{}, Table: &TableName{}, Body: &SetStmt{Variables: []*VariableAssignment{{Value: ce}}}}, 1, 1},
+		{&DropTriggerStmt{Trigger: &TableName{}}, 0, 0},
+		{&CreateEventStmt{Schedule: &EventSchedule{Every: ce, Starts: ce, Ends: ce}, Body: &DoStmt{Exprs: []ExprNode{ce}}}, 4, 4},
+		{&AlterEventStmt{Schedule: &EventSchedule{At: ce}}, 1, 1},
+		{&AlterEventStmt{}, 0, 0},
+		{&DropEventStmt{}, 0, 0},
 		{&DropIndexStmt{Table: &TableName{}}, 0, 0},
 		{&DropTableStmt{Tables: []*TableName{{}, {}}}, 0, 0},
 		{&OptimizeTableStmt{Tables: []*TableName{{}, {}}}, 0, 0},
@@ -70,6 +76,34 @@ func TestDDLVisitorCover(t *testing.T) {
 	}
 }
 
+type tableNameCollector []string
+
+func (c *tableNameCollector) Enter(n Node) (Node, bool) {
+	if tbl, ok := n.(*TableName); ok {
+		*c = append(*c, tbl.Schema.O+"."+tbl.Name.O)
+	}
+	return n, false
+}
+
+func (c *tableNameCollector) Leave(n Node) (Node, bool) {
+	return n, true
+}
+
+func TestTriggerVisitTableNames(t *testing.T) {
+	p := parser.New()
+	stmt, err := p.ParseOneStmt("create trigger db.tr before insert on db.t for each row insert into log values (new.a)", "", "")
+	require.NoError(t, err)
+	var names tableNameCollector
+	stmt.Accept(&names)
+	require.Equal(t, tableNameCollector{"db.tr", "db.t", ".log"}, names)
+
+	stmt, err = p.ParseOneStmt("drop trigger if exists db.tr", "", "")
+	require.NoError(t, err)
+	names = nil
+	stmt.Accept(&names)
+	require.Equal(t, tableNameCollector{"db.tr"}, names)
+}
+
 func TestDDLIndexColNameRestore(t *testing.T) {
 	testCases := []NodeRestoreTestCase{
 		{"(a + 1)", "(`a`+1)"},
@@ -187,6 +221,8 @@ func TestDDLConstraintRestore(t *testing.T) {
 		{"fulltext key full_id (parent_id)", "FULLTEXT `full_id`(`parent_id`)"},
 		{"fulltext INDEX full_id (parent_id)", "FULLTEXT `full_id`(`parent_id`)"},
 		{"fulltext INDEX full_id ((parent_id+1))", "FULLTEXT `full_id`((`parent_id`+1))"},
+		{"spatial key sp_id (parent_id)", "SPATIAL `sp_id`(`parent_id`)"},
+		{"spatial INDEX (parent_id)", "SPATIAL(`parent_id`)"},
 		{"PRIMARY KEY (id)", "PRIMARY KEY(`id`)"},
 		{"PRIMARY KEY (id) key_block_size = 32 using hash comment 'hello'", "PRIMARY KEY(`id`) KEY_BLOCK_SIZE=32 USING HASH COMMENT 'hello'"},
 		{"PRIMARY KEY ((id+1))", "PRIMARY KEY((`id`+1))"},
@@ -246,6 +282,7 @@ func TestDDLColumnOptionRestore(t *testing.T) {
 		{"STORAGE MEMORY", "STORAGE MEMORY"},
 		{"AUTO_RANDOM (3)", "AUTO_RANDOM(3)"},
 		{"AUTO_RANDOM", "AUTO_RANDOM"},
+		{"SRID 4326", "SRID 4326"},
 	}
 	extractNodeFunc := func(node Node) Node {
 		return node.(*CreateTableStmt).Cols[0].Options[0]
@@ -613,6 +650,13 @@ func TestSequenceRestore(t *testing.T) {
 		{"create sequence seq nocycle nocache maxvalue 1000 cache 1", "CREATE SEQUENCE `seq` NOCYCLE NOCACHE MAXVALUE 1000 CACHE 1"},
 		{"create sequence seq increment -1 no minvalue no maxvalue cache = 1", "CREATE SEQUENCE `seq` INCREMENT BY -1 NO MINVALUE NO MAXVALUE CACHE 1"},
 		{"create sequence if not exists seq increment 1 minvalue 0 nomaxvalue cache 100 nocycle", "CREATE SEQUENCE IF NOT EXISTS `seq` INCREMENT BY 1 MINVALUE 0 NO MAXVALUE CACHE 100 NOCYCLE"},
+		{"create sequence seq type = snowflake worker_id 3", "CREATE SEQUENCE `seq` TYPE = SNOWFLAKE WORKER_ID = 3"},
+		{"create sequence seq type group step = 1000 cache 10", "CREATE SEQUENCE `seq` TYPE = GROUP STEP = 1000 CACHE 10"},
+		{"create sequence seq type segment", "CREATE SEQUENCE `seq` TYPE = SEGMENT"},
+
+		// test alter sequence
+		{"alter sequence seq set value 100", "ALTER SEQUENCE `seq` SET VALUE = 100"},
+		{"alter sequence if exists seq step 10 restart", "ALTER SEQUENCE IF EXISTS `seq` STEP = 10 RESTART"},
 
 		// test drop sequence
 		{"drop sequence seq", "DROP SEQUENCE `seq`"},
@@ -0,0 +1,1333 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/arana-db/parser/format"
+	"github.com/arana-db/parser/model"
+	"github.com/pingcap/errors"
+)
+
+var (
+	_ StmtNode = &CreateShardingTableRuleStmt{}
+	_ StmtNode = &AlterShardingTableRuleStmt{}
+	_ StmtNode = &DropShardingTableRuleStmt{}
+	_ StmtNode = &CreateTenantStmt{}
+	_ StmtNode = &AlterTenantStmt{}
+	_ StmtNode = &DropTenantStmt{}
+	_ StmtNode = &CreateNodeStmt{}
+	_ StmtNode = &AlterNodeStmt{}
+	_ StmtNode = &DropNodeStmt{}
+	_ StmtNode = &CreateDBGroupStmt{}
+	_ StmtNode = &AlterDBGroupStmt{}
+	_ StmtNode = &DropDBGroupStmt{}
+	_ StmtNode = &CreateShadowRuleStmt{}
+	_ StmtNode = &AlterShadowRuleStmt{}
+	_ StmtNode = &DropShadowRuleStmt{}
+
+	_ SensitiveStmtNode = &AlterTenantStmt{}
+	_ SensitiveStmtNode = &CreateNodeStmt{}
+	_ SensitiveStmtNode = &AlterNodeStmt{}
+
+	_ Node = &ShardingTableRule{}
+	_ Node = &ShadowRule{}
+)
+
+// ShardingTopologyPattern is one side of a physical topology expression,
+// such as `db_${0000..0007}`. A pattern without range names a single node.
+type ShardingTopologyPattern struct {
+	Prefix string
+	Suffix string
+	// HasRange indicates the pattern contains a `${begin..end}` range.
+	HasRange bool
+	Begin    int
+	End      int
+	// Width is the number of digits the range numbers are zero-padded to.
+	Width int
+}
+
+// Names returns the node names the pattern expands to.
+func (p *ShardingTopologyPattern) Names() []string {
+	if !p.HasRange {
+		return []string{p.Prefix + p.Suffix}
+	}
+	names := make([]string, 0, p.End-p.Begin+1)
+	for i := p.Begin; i <= p.End; i++ {
+		names = append(names, fmt.Sprintf("%s%0*d%s", p.Prefix, p.Width, i, p.Suffix))
+	}
+	return names
+}
+
+// String implements fmt.Stringer interface.
+func (p *ShardingTopologyPattern) String() string {
+	if !p.HasRange {
+		return p.Prefix + p.Suffix
+	}
+	return fmt.Sprintf("%s${%0*d..%0*d}%s", p.Prefix, p.Width, p.Begin, p.Width, p.End, p.Suffix)
+}
+
+// ShardingTopology is a physical topology expression which describes the
+// databases and tables a logical table is sharded into,
+// such as `db_${0000..0007}.t_${0000..0127}`.
+type ShardingTopology struct {
+	DB    ShardingTopologyPattern
+	Table ShardingTopologyPattern
+}
+
+// String implements fmt.Stringer interface.
+func (t *ShardingTopology) String() string {
+	return t.DB.String() + "." + t.Table.String()
+}
+
+// ParseShardingTopology parses a physical topology expression.
+func ParseShardingTopology(s string) (*ShardingTopology, error) {
+	// The range contains dots, so only split on a dot outside of `${...}`.
+	sep, depth := -1, 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		case '.':
+			if depth == 0 {
+				if sep >= 0 {
+					return nil, errors.Errorf("invalid sharding topology '%s'", s)
+				}
+				sep = i
+			}
+		}
+	}
+	if sep < 0 {
+		return nil, errors.Errorf("invalid sharding topology '%s', it should be in the form of 'db.table'", s)
+	}
+	db, err := parseShardingTopologyPattern(s[:sep])
+	if err != nil {
+		return nil, errors.Annotatef(err, "invalid sharding topology '%s'", s)
+	}
+	tbl, err := parseShardingTopologyPattern(s[sep+1:])
+	if err != nil {
+		return nil, errors.Annotatef(err, "invalid sharding topology '%s'", s)
+	}
+	return &ShardingTopology{DB: *db, Table: *tbl}, nil
+}
+
+func parseShardingTopologyPattern(s string) (*ShardingTopologyPattern, error) {
+	start := strings.Index(s, "${")
+	if start < 0 {
+		if s == "" || strings.ContainsAny(s, "${}") {
+			return nil, errors.Errorf("invalid pattern '%s'", s)
+		}
+		return &ShardingTopologyPattern{Prefix: s}, nil
+	}
+	end := strings.IndexByte(s[start:], '}')
+	if end < 0 {
+		return nil, errors.Errorf("unclosed range in pattern '%s'", s)
+	}
+	end += start
+	p := &ShardingTopologyPattern{Prefix: s[:start], Suffix: s[end+1:], HasRange: true}
+	if strings.ContainsAny(p.Prefix, "${}") || strings.ContainsAny(p.Suffix, "${}") {
+		return nil, errors.Errorf("only one range is allowed in pattern '%s'", s)
+	}
+	bounds := strings.Split(s[start+2:end], "..")
+	if len(bounds) != 2 {
+		return nil, errors.Errorf("invalid range in pattern '%s'", s)
+	}
+	var err error
+	if p.Begin, err = parseShardingTopologyBound(bounds[0]); err != nil {
+		return nil, err
+	}
+	if p.End, err = parseShardingTopologyBound(bounds[1]); err != nil {
+		return nil, err
+	}
+	if p.Begin > p.End {
+		return nil, errors.Errorf("the range begin %d is greater than the end %d", p.Begin, p.End)
+	}
+	p.Width = len(bounds[0])
+	return p, nil
+}
+
+func parseShardingTopologyBound(s string) (int, error) {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return 0, errors.Errorf("invalid range bound '%s'", s)
+		}
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, errors.Errorf("invalid range bound '%s'", s)
+	}
+	return n, nil
+}
+
+// ShardingProperty is a key-value property of a sharding algorithm.
+type ShardingProperty struct {
+	Key   string
+	Value string
+}
+
+// ShardingAlgorithm is a shard algorithm or key generator type with its properties.
+type ShardingAlgorithm struct {
+	Type       string
+	Properties []*ShardingProperty
+}
+
+// Restore implements Node interface.
+func (n *ShardingAlgorithm) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("TYPE ")
+	ctx.WritePlain("= ")
+	ctx.WriteString(n.Type)
+	if len(n.Properties) > 0 {
+		ctx.WritePlain(", ")
+		ctx.WriteKeyWord("PROPERTIES ")
+		ctx.WritePlain("= (")
+		for i, prop := range n.Properties {
+			if i > 0 {
+				ctx.WritePlain(", ")
+			}
+			ctx.WriteString(prop.Key)
+			ctx.WritePlain(" = ")
+			ctx.WriteString(prop.Value)
+		}
+		ctx.WritePlain(")")
+	}
+	return nil
+}
+
+// ShardingKeyGenerator generates the values of a column of a sharded table.
+type ShardingKeyGenerator struct {
+	Column    model.CIStr
+	Algorithm *ShardingAlgorithm
+}
+
+// ShardingTableRuleOptionType is the type of ShardingTableRuleOption.
+type ShardingTableRuleOptionType int
+
+// ShardingTableRuleOption types.
+const (
+	ShardingTableRuleOptionTopology ShardingTableRuleOptionType = iota + 1
+	ShardingTableRuleOptionColumns
+	ShardingTableRuleOptionDatabaseAlgorithm
+	ShardingTableRuleOptionTableAlgorithm
+	ShardingTableRuleOptionKeyGenerator
+)
+
+// String implements fmt.Stringer interface.
+func (t ShardingTableRuleOptionType) String() string {
+	switch t {
+	case ShardingTableRuleOptionTopology:
+		return "TOPOLOGY"
+	case ShardingTableRuleOptionColumns:
+		return "SHARDING_COLUMNS"
+	case ShardingTableRuleOptionDatabaseAlgorithm:
+		return "DATABASE_ALGORITHM"
+	case ShardingTableRuleOptionTableAlgorithm:
+		return "TABLE_ALGORITHM"
+	case ShardingTableRuleOptionKeyGenerator:
+		return "KEY_GENERATOR"
+	}
+	return ""
+}
+
+// ShardingTableRuleOption is an option of a sharding table rule.
+type ShardingTableRuleOption struct {
+	Tp           ShardingTableRuleOptionType
+	Topology     *ShardingTopology
+	Columns      []model.CIStr
+	Algorithm    *ShardingAlgorithm
+	KeyGenerator *ShardingKeyGenerator
+}
+
+// Restore implements Node interface.
+func (n *ShardingTableRuleOption) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord(n.Tp.String())
+	ctx.WritePlain(" = ")
+	switch n.Tp {
+	case ShardingTableRuleOptionTopology:
+		ctx.WriteString(n.Topology.String())
+	case ShardingTableRuleOptionColumns:
+		ctx.WritePlain("(")
+		for i, col := range n.Columns {
+			if i > 0 {
+				ctx.WritePlain(", ")
+			}
+			ctx.WriteName(col.O)
+		}
+		ctx.WritePlain(")")
+	case ShardingTableRuleOptionDatabaseAlgorithm, ShardingTableRuleOptionTableAlgorithm:
+		ctx.WritePlain("(")
+		if err := n.Algorithm.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore ShardingTableRuleOption %s", n.Tp)
+		}
+		ctx.WritePlain(")")
+	case ShardingTableRuleOptionKeyGenerator:
+		ctx.WritePlain("(")
+		ctx.WriteKeyWord("COLUMN ")
+		ctx.WritePlain("= ")
+		ctx.WriteName(n.KeyGenerator.Column.O)
+		ctx.WritePlain(", ")
+		if err := n.KeyGenerator.Algorithm.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore ShardingTableRuleOption KEY_GENERATOR")
+		}
+		ctx.WritePlain(")")
+	default:
+		return errors.Errorf("invalid ShardingTableRuleOption: %d", n.Tp)
+	}
+	return nil
+}
+
+// ShardingTableRule defines how a logical table is sharded.
+type ShardingTableRule struct {
+	node
+
+	Table   *TableName
+	Options []*ShardingTableRuleOption
+}
+
+// Option returns the last option of the given type, or nil if it's absent.
+func (n *ShardingTableRule) Option(tp ShardingTableRuleOptionType) *ShardingTableRuleOption {
+	for i := len(n.Options) - 1; i >= 0; i-- {
+		if n.Options[i].Tp == tp {
+			return n.Options[i]
+		}
+	}
+	return nil
+}
+
+// Restore implements Node interface.
+func (n *ShardingTableRule) Restore(ctx *format.RestoreCtx) error {
+	if err := n.Table.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore ShardingTableRule.Table")
+	}
+	ctx.WritePlain(" (")
+	for i, opt := range n.Options {
+		if i > 0 {
+			ctx.WritePlain(", ")
+		}
+		if err := opt.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore ShardingTableRule.Options[%d]", i)
+		}
+	}
+	ctx.WritePlain(")")
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *ShardingTableRule) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*ShardingTableRule)
+	if n.Table != nil {
+		node, ok := n.Table.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Table = node.(*TableName)
+	}
+	return v.Leave(n)
+}
+
+func restoreShardingTableRules(ctx *format.RestoreCtx, rules []*ShardingTableRule) error {
+	for i, rule := range rules {
+		if i > 0 {
+			ctx.WritePlain(", ")
+		}
+		if err := rule.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore sharding table rule [%d]", i)
+		}
+	}
+	return nil
+}
+
+func acceptShardingTableRules(v Visitor, rules []*ShardingTableRule) bool {
+	for i, rule := range rules {
+		node, ok := rule.Accept(v)
+		if !ok {
+			return false
+		}
+		rules[i] = node.(*ShardingTableRule)
+	}
+	return true
+}
+
+// CreateShardingTableRuleStmt is a statement to create sharding table rules.
+type CreateShardingTableRuleStmt struct {
+	stmtNode
+
+	IfNotExists bool
+	Rules       []*ShardingTableRule
+}
+
+// Restore implements Node interface.
+func (n *CreateShardingTableRuleStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("CREATE SHARDING TABLE RULE ")
+	if n.IfNotExists {
+		ctx.WriteKeyWord("IF NOT EXISTS ")
+	}
+	return restoreShardingTableRules(ctx, n.Rules)
+}
+
+// Accept implements Node Accept interface.
+func (n *CreateShardingTableRuleStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CreateShardingTableRuleStmt)
+	if !acceptShardingTableRules(v, n.Rules) {
+		return n, false
+	}
+	return v.Leave(n)
+}
+
+// AlterShardingTableRuleStmt is a statement to alter sharding table rules.
+// Only the options present are changed.
+type AlterShardingTableRuleStmt struct {
+	stmtNode
+
+	Rules []*ShardingTableRule
+}
+
+// Restore implements Node interface.
+func (n *AlterShardingTableRuleStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("ALTER SHARDING TABLE RULE ")
+	return restoreShardingTableRules(ctx, n.Rules)
+}
+
+// Accept implements Node Accept interface.
+func (n *AlterShardingTableRuleStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*AlterShardingTableRuleStmt)
+	if !acceptShardingTableRules(v, n.Rules) {
+		return n, false
+	}
+	return v.Leave(n)
+}
+
+// DropShardingTableRuleStmt is a statement to drop sharding table rules.
+type DropShardingTableRuleStmt struct {
+	stmtNode
+
+	IfExists bool
+	Tables   []*TableName
+}
+
+// Restore implements Node interface.
+func (n *DropShardingTableRuleStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("DROP SHARDING TABLE RULE ")
+	if n.IfExists {
+		ctx.WriteKeyWord("IF EXISTS ")
+	}
+	for i, table := range n.Tables {
+		if i > 0 {
+			ctx.WritePlain(", ")
+		}
+		if err := table.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore DropShardingTableRuleStmt.Tables[%d]", i)
+		}
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *DropShardingTableRuleStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*DropShardingTableRuleStmt)
+	for i, t := range n.Tables {
+		node, ok := t.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Tables[i] = node.(*TableName)
+	}
+	return v.Leave(n)
+}
+
+// CreateTenantStmt is a statement to create a tenant.
+type CreateTenantStmt struct {
+	stmtNode
+
+	IfNotExists bool
+	Name        string
+}
+
+// Restore implements Node interface.
+func (n *CreateTenantStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("CREATE TENANT ")
+	if n.IfNotExists {
+		ctx.WriteKeyWord("IF NOT EXISTS ")
+	}
+	ctx.WriteName(n.Name)
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *CreateTenantStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CreateTenantStmt)
+	return v.Leave(n)
+}
+
+// AlterTenantSpecType is the type of AlterTenantSpec.
+type AlterTenantSpecType int
+
+// AlterTenantSpec types.
+const (
+	AlterTenantAddUser AlterTenantSpecType = iota + 1
+	AlterTenantAlterUser
+	AlterTenantDropUser
+	AlterTenantRename
+)
+
+// AlterTenantSpec is a change to a tenant, such as adding a user to it.
+type AlterTenantSpec struct {
+	Tp AlterTenantSpecType
+	// IfNotExists is used by ADD USER, IfExists is used by DROP USER.
+	IfNotExists bool
+	IfExists    bool
+	User        string
+	Password    string
+	// NewName is the new tenant name of RENAME TO.
+	NewName string
+}
+
+// Restore implements Node interface.
+func (n *AlterTenantSpec) Restore(ctx *format.RestoreCtx) error {
+	switch n.Tp {
+	case AlterTenantAddUser:
+		ctx.WriteKeyWord("ADD USER ")
+		if n.IfNotExists {
+			ctx.WriteKeyWord("IF NOT EXISTS ")
+		}
+	case AlterTenantAlterUser:
+		ctx.WriteKeyWord("ALTER USER ")
+	case AlterTenantDropUser:
+		ctx.WriteKeyWord("DROP USER ")
+		if n.IfExists {
+			ctx.WriteKeyWord("IF EXISTS ")
+		}
+		ctx.WriteString(n.User)
+		return nil
+	case AlterTenantRename:
+		ctx.WriteKeyWord("RENAME TO ")
+		ctx.WriteName(n.NewName)
+		return nil
+	default:
+		return errors.Errorf("invalid AlterTenantSpec: %d", n.Tp)
+	}
+	ctx.WriteString(n.User)
+	ctx.WriteKeyWord(" IDENTIFIED BY ")
+	ctx.WriteString(n.Password)
+	return nil
+}
+
+// AlterTenantStmt is a statement to rename a tenant or manage its users.
+type AlterTenantStmt struct {
+	stmtNode
+
+	Name  string
+	Specs []*AlterTenantSpec
+}
+
+// Restore implements Node interface.
+func (n *AlterTenantStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("ALTER TENANT ")
+	ctx.WriteName(n.Name)
+	ctx.WritePlain(" ")
+	for i, spec := range n.Specs {
+		if i > 0 {
+			ctx.WritePlain(", ")
+		}
+		if err := spec.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore AlterTenantStmt.Specs[%d]", i)
+		}
+	}
+	return nil
+}
+
+// SecureText implements SensitiveStmtNode
+func (n *AlterTenantStmt) SecureText() string {
+	redactedStmt := *n
+	redactedStmt.Specs = make([]*AlterTenantSpec, 0, len(n.Specs))
+	for _, spec := range n.Specs {
+		if spec.Tp == AlterTenantAddUser || spec.Tp == AlterTenantAlterUser {
+			redactedSpec := *spec
+			redactedSpec.Password = "xxxxxx"
+			spec = &redactedSpec
+		}
+		redactedStmt.Specs = append(redactedStmt.Specs, spec)
+	}
+
+	var sb strings.Builder
+	_ = redactedStmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb))
+	return sb.String()
+}
+
+// Accept implements Node Accept interface.
+func (n *AlterTenantStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*AlterTenantStmt)
+	return v.Leave(n)
+}
+
+// DropTenantStmt is a statement to drop a tenant.
+type DropTenantStmt struct {
+	stmtNode
+
+	IfExists bool
+	Name     string
+}
+
+// Restore implements Node interface.
+func (n *DropTenantStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("DROP TENANT ")
+	if n.IfExists {
+		ctx.WriteKeyWord("IF EXISTS ")
+	}
+	ctx.WriteName(n.Name)
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *DropTenantStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*DropTenantStmt)
+	return v.Leave(n)
+}
+
+// NodeOptionType is the type of NodeOption.
+type NodeOptionType int
+
+// NodeOption types.
+const (
+	NodeOptionHost NodeOptionType = iota + 1
+	NodeOptionPort
+	NodeOptionUsername
+	NodeOptionPassword
+	NodeOptionWeight
+	NodeOptionLabels
+)
+
+// String implements fmt.Stringer interface.
+func (t NodeOptionType) String() string {
+	switch t {
+	case NodeOptionHost:
+		return "HOST"
+	case NodeOptionPort:
+		return "PORT"
+	case NodeOptionUsername:
+		return "USERNAME"
+	case NodeOptionPassword:
+		return "PASSWORD"
+	case NodeOptionWeight:
+		return "WEIGHT"
+	case NodeOptionLabels:
+		return "LABELS"
+	}
+	return ""
+}
+
+// NodeLabel is a key-value label of a node.
+type NodeLabel struct {
+	Key   string
+	Value string
+}
+
+// NodeOption is an option of a physical database node.
+type NodeOption struct {
+	Tp        NodeOptionType
+	StrValue  string
+	UintValue uint64
+	Labels    []*NodeLabel
+}
+
+// Restore implements Node interface.
+func (n *NodeOption) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord(n.Tp.String())
+	ctx.WritePlain(" = ")
+	switch n.Tp {
+	case NodeOptionHost, NodeOptionUsername, NodeOptionPassword:
+		ctx.WriteString(n.StrValue)
+	case NodeOptionPort, NodeOptionWeight:
+		ctx.WritePlainf("%d", n.UintValue)
+	case NodeOptionLabels:
+		ctx.WritePlain("(")
+		for i, label := range n.Labels {
+			if i > 0 {
+				ctx.WritePlain(", ")
+			}
+			ctx.WriteString(label.Key)
+			ctx.WritePlain(" = ")
+			ctx.WriteString(label.Value)
+		}
+		ctx.WritePlain(")")
+	default:
+		return errors.Errorf("invalid NodeOption: %d", n.Tp)
+	}
+	return nil
+}
+
+func restoreNodeOptions(ctx *format.RestoreCtx, options []*NodeOption) error {
+	ctx.WritePlain(" (")
+	for i, opt := range options {
+		if i > 0 {
+			ctx.WritePlain(", ")
+		}
+		if err := opt.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore node option [%d]", i)
+		}
+	}
+	ctx.WritePlain(")")
+	return nil
+}
+
+func redactNodeOptions(options []*NodeOption) []*NodeOption {
+	redacted := make([]*NodeOption, 0, len(options))
+	for _, opt := range options {
+		if opt.Tp == NodeOptionPassword {
+			opt = &NodeOption{Tp: opt.Tp, StrValue: "xxxxxx"}
+		}
+		redacted = append(redacted, opt)
+	}
+	return redacted
+}
+
+// CreateNodeStmt is a statement to add a physical database node to a tenant.
+type CreateNodeStmt struct {
+	stmtNode
+
+	IfNotExists bool
+	Name        string
+	Options     []*NodeOption
+	Tenant      string
+}
+
+// Restore implements Node interface.
+func (n *CreateNodeStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("CREATE NODE ")
+	if n.IfNotExists {
+		ctx.WriteKeyWord("IF NOT EXISTS ")
+	}
+	ctx.WriteName(n.Name)
+	if err := restoreNodeOptions(ctx, n.Options); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateNodeStmt.Options")
+	}
+	ctx.WriteKeyWord(" FROM ")
+	ctx.WriteName(n.Tenant)
+	return nil
+}
+
+// SecureText implements SensitiveStmtNode
+func (n *CreateNodeStmt) SecureText() string {
+	redactedStmt := *n
+	redactedStmt.Options = redactNodeOptions(n.Options)
+
+	var sb strings.Builder
+	_ = redactedStmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb))
+	return sb.String()
+}
+
+// Accept implements Node Accept interface.
+func (n *CreateNodeStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CreateNodeStmt)
+	return v.Leave(n)
+}
+
+// AlterNodeStmt is a statement to change options of a physical database node.
+// Only the options present are changed.
+type AlterNodeStmt struct {
+	stmtNode
+
+	Name    string
+	Options []*NodeOption
+	Tenant  string
+}
+
+// Restore implements Node interface.
+func (n *AlterNodeStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("ALTER NODE ")
+	ctx.WriteName(n.Name)
+	if err := restoreNodeOptions(ctx, n.Options); err != nil {
+		return errors.Annotate(err, "An error occurred while restore AlterNodeStmt.Options")
+	}
+	ctx.WriteKeyWord(" FROM ")
+	ctx.WriteName(n.Tenant)
+	return nil
+}
+
+// SecureText implements SensitiveStmtNode
+func (n *AlterNodeStmt) SecureText() string {
+	redactedStmt := *n
+	redactedStmt.Options = redactNodeOptions(n.Options)
+
+	var sb strings.Builder
+	_ = redactedStmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb))
+	return sb.String()
+}
+
+// Accept implements Node Accept interface.
+func (n *AlterNodeStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*AlterNodeStmt)
+	return v.Leave(n)
+}
+
+// DropNodeStmt is a statement to remove a physical database node from a tenant.
+type DropNodeStmt struct {
+	stmtNode
+
+	IfExists bool
+	Name     string
+	Tenant   string
+}
+
+// Restore implements Node interface.
+func (n *DropNodeStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("DROP NODE ")
+	if n.IfExists {
+		ctx.WriteKeyWord("IF EXISTS ")
+	}
+	ctx.WriteName(n.Name)
+	ctx.WriteKeyWord(" FROM ")
+	ctx.WriteName(n.Tenant)
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *DropNodeStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*DropNodeStmt)
+	return v.Leave(n)
+}
+
+// DBGroupLoadBalance is the strategy to pick a replica of a DB group for reads.
+type DBGroupLoadBalance int
+
+// DBGroupLoadBalance strategies.
+const (
+	DBGroupLoadBalanceRandom DBGroupLoadBalance = iota + 1
+	DBGroupLoadBalanceRoundRobin
+	DBGroupLoadBalanceWeight
+)
+
+// String implements fmt.Stringer interface.
+func (lb DBGroupLoadBalance) String() string {
+	switch lb {
+	case DBGroupLoadBalanceRandom:
+		return "RANDOM"
+	case DBGroupLoadBalanceRoundRobin:
+		return "ROUND_ROBIN"
+	case DBGroupLoadBalanceWeight:
+		return "WEIGHT"
+	}
+	return ""
+}
+
+// DBGroupTxnRouting is the policy to route the statements inside a transaction.
+type DBGroupTxnRouting int
+
+// DBGroupTxnRouting policies.
+const (
+	// DBGroupTxnRoutingPrimary routes all statements of a transaction to the primary.
+	DBGroupTxnRoutingPrimary DBGroupTxnRouting = iota + 1
+	// DBGroupTxnRoutingSticky routes the reads of a transaction to the replica chosen by its first read.
+	DBGroupTxnRoutingSticky
+	// DBGroupTxnRoutingDynamic load balances every read of a transaction.
+	DBGroupTxnRoutingDynamic
+)
+
+// String implements fmt.Stringer interface.
+func (r DBGroupTxnRouting) String() string {
+	switch r {
+	case DBGroupTxnRoutingPrimary:
+		return "PRIMARY"
+	case DBGroupTxnRoutingSticky:
+		return "STICKY"
+	case DBGroupTxnRoutingDynamic:
+		return "DYNAMIC"
+	}
+	return ""
+}
+
+// DefaultDBGroupReplicaWeight is the read weight of a replica without WEIGHT.
+const DefaultDBGroupReplicaWeight = 1
+
+// DBGroupReplica is a replica node of a DB group with its read weight.
+type DBGroupReplica struct {
+	Node   string
+	Weight uint64
+}
+
+// DBGroupOptionType is the type of DBGroupOption.
+type DBGroupOptionType int
+
+// DBGroupOption types.
+const (
+	DBGroupOptionPrimary DBGroupOptionType = iota + 1
+	DBGroupOptionReplicas
+	DBGroupOptionLoadBalance
+	DBGroupOptionTxnRouting
+)
+
+// String implements fmt.Stringer interface.
+func (t DBGroupOptionType) String() string {
+	switch t {
+	case DBGroupOptionPrimary:
+		return "PRIMARY"
+	case DBGroupOptionReplicas:
+		return "REPLICAS"
+	case DBGroupOptionLoadBalance:
+		return "LOAD_BALANCE"
+	case DBGroupOptionTxnRouting:
+		return "TRANSACTION_ROUTING"
+	}
+	return ""
+}
+
+// DBGroupOption is an option of a DB group.
+type DBGroupOption struct {
+	Tp          DBGroupOptionType
+	Primary     string
+	Replicas    []*DBGroupReplica
+	LoadBalance DBGroupLoadBalance
+	TxnRouting  DBGroupTxnRouting
+}
+
+// Restore implements Node interface.
+func (n *DBGroupOption) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord(n.Tp.String())
+	ctx.WritePlain(" = ")
+	switch n.Tp {
+	case DBGroupOptionPrimary:
+		ctx.WriteName(n.Primary)
+	case DBGroupOptionReplicas:
+		ctx.WritePlain("(")
+		for i, replica := range n.Replicas {
+			if i > 0 {
+				ctx.WritePlain(", ")
+			}
+			ctx.WriteName(replica.Node)
+			ctx.WriteKeyWord(" WEIGHT ")
+			ctx.WritePlainf("%d", replica.Weight)
+		}
+		ctx.WritePlain(")")
+	case DBGroupOptionLoadBalance:
+		ctx.WriteKeyWord(n.LoadBalance.String())
+	case DBGroupOptionTxnRouting:
+		ctx.WriteKeyWord(n.TxnRouting.String())
+	default:
+		return errors.Errorf("invalid DBGroupOption: %d", n.Tp)
+	}
+	return nil
+}
+
+func restoreDBGroupOptions(ctx *format.RestoreCtx, options []*DBGroupOption) error {
+	ctx.WritePlain(" (")
+	for i, opt := range options {
+		if i > 0 {
+			ctx.WritePlain(", ")
+		}
+		if err := opt.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore DB group option [%d]", i)
+		}
+	}
+	ctx.WritePlain(")")
+	return nil
+}
+
+// CreateDBGroupStmt is a statement to create a read/write-splitting DB group
+// out of the nodes of a tenant.
+type CreateDBGroupStmt struct {
+	stmtNode
+
+	IfNotExists bool
+	Name        string
+	Options     []*DBGroupOption
+	Tenant      string
+}
+
+// Restore implements Node interface.
+func (n *CreateDBGroupStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("CREATE DB GROUP ")
+	if n.IfNotExists {
+		ctx.WriteKeyWord("IF NOT EXISTS ")
+	}
+	ctx.WriteName(n.Name)
+	if err := restoreDBGroupOptions(ctx, n.Options); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateDBGroupStmt.Options")
+	}
+	ctx.WriteKeyWord(" FROM ")
+	ctx.WriteName(n.Tenant)
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *CreateDBGroupStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CreateDBGroupStmt)
+	return v.Leave(n)
+}
+
+// AlterDBGroupStmt is a statement to change a DB group.
+// Only the options present are changed.
+type AlterDBGroupStmt struct {
+	stmtNode
+
+	Name    string
+	Options []*DBGroupOption
+	Tenant  string
+}
+
+// Restore implements Node interface.
+func (n *AlterDBGroupStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("ALTER DB GROUP ")
+	ctx.WriteName(n.Name)
+	if err := restoreDBGroupOptions(ctx, n.Options); err != nil {
+		return errors.Annotate(err, "An error occurred while restore AlterDBGroupStmt.Options")
+	}
+	ctx.WriteKeyWord(" FROM ")
+	ctx.WriteName(n.Tenant)
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *AlterDBGroupStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*AlterDBGroupStmt)
+	return v.Leave(n)
+}
+
+// DropDBGroupStmt is a statement to drop a DB group.
+type DropDBGroupStmt struct {
+	stmtNode
+
+	IfExists bool
+	Name     string
+	Tenant   string
+}
+
+// Restore implements Node interface.
+func (n *DropDBGroupStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("DROP DB GROUP ")
+	if n.IfExists {
+		ctx.WriteKeyWord("IF EXISTS ")
+	}
+	ctx.WriteName(n.Name)
+	ctx.WriteKeyWord(" FROM ")
+	ctx.WriteName(n.Tenant)
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *DropDBGroupStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*DropDBGroupStmt)
+	return v.Leave(n)
+}
+
+// ShadowOperation is a set of statement kinds a shadow matcher applies to.
+type ShadowOperation int
+
+// ShadowOperation flags, zero means all kinds of statements.
+const (
+	ShadowOperationSelect ShadowOperation = 1 << iota
+	ShadowOperationInsert
+	ShadowOperationUpdate
+	ShadowOperationDelete
+)
+
+var shadowOperationNames = []struct {
+	op   ShadowOperation
+	name string
+}{
+	{ShadowOperationSelect, "SELECT"},
+	{ShadowOperationInsert, "INSERT"},
+	{ShadowOperationUpdate, "UPDATE"},
+	{ShadowOperationDelete, "DELETE"},
+}
+
+// ShadowMatcherType is the type of ShadowMatcher.
+type ShadowMatcherType int
+
+// ShadowMatcher types.
+const (
+	// ShadowMatcherValue matches statements whose column equals a value.
+	ShadowMatcherValue ShadowMatcherType = iota + 1
+	// ShadowMatcherRegexp matches statements whose column value matches a regular expression.
+	ShadowMatcherRegexp
+	// ShadowMatcherHint matches statements carrying the SHADOW() hint.
+	ShadowMatcherHint
+)
+
+// ShadowMatcher decides whether a statement is routed to the shadow database.
+type ShadowMatcher struct {
+	Tp         ShadowMatcherType
+	Column     model.CIStr
+	Value      ExprNode
+	Regexp     string
+	Operations ShadowOperation
+}
+
+// Restore implements Node interface.
+func (n *ShadowMatcher) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("MATCH ")
+	switch n.Tp {
+	case ShadowMatcherValue:
+		ctx.WriteName(n.Column.O)
+		ctx.WritePlain(" = ")
+		if err := n.Value.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore ShadowMatcher.Value")
+		}
+	case ShadowMatcherRegexp:
+		ctx.WriteName(n.Column.O)
+		ctx.WriteKeyWord(" REGEXP ")
+		ctx.WriteString(n.Regexp)
+	case ShadowMatcherHint:
+		ctx.WriteKeyWord("HINT")
+	default:
+		return errors.Errorf("invalid ShadowMatcher: %d", n.Tp)
+	}
+	if n.Operations != 0 {
+		ctx.WriteKeyWord(" ON ")
+		ctx.WritePlain("(")
+		first := true
+		for _, op := range shadowOperationNames {
+			if n.Operations&op.op == 0 {
+				continue
+			}
+			if !first {
+				ctx.WritePlain(", ")
+			}
+			ctx.WriteKeyWord(op.name)
+			first = false
+		}
+		ctx.WritePlain(")")
+	}
+	return nil
+}
+
+// ShadowRule routes the matched statements on a table to a shadow DB group.
+type ShadowRule struct {
+	node
+
+	Table    *TableName
+	Group    string
+	Matchers []*ShadowMatcher
+}
+
+// Restore implements Node interface.
+func (n *ShadowRule) Restore(ctx *format.RestoreCtx) error {
+	if err := n.Table.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore ShadowRule.Table")
+	}
+	ctx.WritePlain(" (")
+	ctx.WriteKeyWord("SHADOW_GROUP ")
+	ctx.WritePlain("= ")
+	ctx.WriteName(n.Group)
+	for i, matcher := range n.Matchers {
+		ctx.WritePlain(", ")
+		if err := matcher.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore ShadowRule.Matchers[%d]", i)
+		}
+	}
+	ctx.WritePlain(")")
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *ShadowRule) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*ShadowRule)
+	if n.Table != nil {
+		node, ok := n.Table.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Table = node.(*TableName)
+	}
+	for _, matcher := range n.Matchers {
+		if matcher.Value != nil {
+			node, ok := matcher.Value.Accept(v)
+			if !ok {
+				return n, false
+			}
+			matcher.Value = node.(ExprNode)
+		}
+	}
+	return v.Leave(n)
+}
+
+func restoreShadowRules(ctx *format.RestoreCtx, rules []*ShadowRule) error {
+	for i, rule := range rules {
+		if i > 0 {
+			ctx.WritePlain(", ")
+		}
+		if err := rule.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore shadow rule [%d]", i)
+		}
+	}
+	return nil
+}
+
+func acceptShadowRules(v Visitor, rules []*ShadowRule) bool {
+	for i, rule := range rules {
+		node, ok := rule.Accept(v)
+		if !ok {
+			return false
+		}
+		rules[i] = node.(*ShadowRule)
+	}
+	return true
+}
+
+// CreateShadowRuleStmt is a statement to create shadow rules.
+type CreateShadowRuleStmt struct {
+	stmtNode
+
+	IfNotExists bool
+	Rules       []*ShadowRule
+}
+
+// Restore implements Node interface.
+func (n *CreateShadowRuleStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("CREATE SHADOW RULE ")
+	if n.IfNotExists {
+		ctx.WriteKeyWord("IF NOT EXISTS ")
+	}
+	return restoreShadowRules(ctx, n.Rules)
+}
+
+// Accept implements Node Accept interface.
+func (n *CreateShadowRuleStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CreateShadowRuleStmt)
+	if !acceptShadowRules(v, n.Rules) {
+		return n, false
+	}
+	return v.Leave(n)
+}
+
+// AlterShadowRuleStmt is a statement to replace shadow rules.
+type AlterShadowRuleStmt struct {
+	stmtNode
+
+	Rules []*ShadowRule
+}
+
+// Restore implements Node interface.
+func (n *AlterShadowRuleStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("ALTER SHADOW RULE ")
+	return restoreShadowRules(ctx, n.Rules)
+}
+
+// Accept implements Node Accept interface.
+func (n *AlterShadowRuleStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*AlterShadowRuleStmt)
+	if !acceptShadowRules(v, n.Rules) {
+		return n, false
+	}
+	return v.Leave(n)
+}
+
+// DropShadowRuleStmt is a statement to drop shadow rules.
+type DropShadowRuleStmt struct {
+	stmtNode
+
+	IfExists bool
+	Tables   []*TableName
+}
+
+// Restore implements Node interface.
+func (n *DropShadowRuleStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("DROP SHADOW RULE ")
+	if n.IfExists {
+		ctx.WriteKeyWord("IF EXISTS ")
+	}
+	for i, table := range n.Tables {
+		if i > 0 {
+			ctx.WritePlain(", ")
+		}
+		if err := table.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore DropShadowRuleStmt.Tables[%d]", i)
+		}
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *DropShadowRuleStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*DropShadowRuleStmt)
+	for i, t := range n.Tables {
+		node, ok := t.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Tables[i] = node.(*TableName)
+	}
+	return v.Leave(n)
+}
@@ -0,0 +1,170 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast_test
+
+import (
+	"testing"
+
+	"github.com/arana-db/parser"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/arana-db/parser/ast"
+)
+
+func TestDistSQLVisitorCover(t *testing.T) {
+	stmts := []Node{
+		&CreateShardingTableRuleStmt{Rules: []*ShardingTableRule{{Table: &TableName{}}}},
+		&AlterShardingTableRuleStmt{Rules: []*ShardingTableRule{{Table: &TableName{}}}},
+		&DropShardingTableRuleStmt{Tables: []*TableName{{}}},
+		&CreateTenantStmt{},
+		&AlterTenantStmt{},
+		&DropTenantStmt{},
+		&CreateNodeStmt{},
+		&AlterNodeStmt{},
+		&DropNodeStmt{},
+		&CreateDBGroupStmt{},
+		&AlterDBGroupStmt{},
+		&DropDBGroupStmt{},
+		&CreateShadowRuleStmt{Rules: []*ShadowRule{{Table: &TableName{}}}},
+		&AlterShadowRuleStmt{Rules: []*ShadowRule{{Table: &TableName{}}}},
+		&DropShadowRuleStmt{Tables: []*TableName{{}}},
+	}
+
+	for _, v := range stmts {
+		v.Accept(visitor{})
+		v.Accept(visitor1{})
+	}
+}
+
+func TestParseShardingTopology(t *testing.T) {
+	topology, err := ParseShardingTopology("db_${0000..0003}.t_${00..11}_bak")
+	require.NoError(t, err)
+	require.Equal(t, ShardingTopologyPattern{Prefix: "db_", HasRange: true, Begin: 0, End: 3, Width: 4}, topology.DB)
+	require.Equal(t, ShardingTopologyPattern{Prefix: "t_", Suffix: "_bak", HasRange: true, Begin: 0, End: 11, Width: 2}, topology.Table)
+	require.Equal(t, []string{"db_0000", "db_0001", "db_0002", "db_0003"}, topology.DB.Names())
+	require.Len(t, topology.Table.Names(), 12)
+	require.Equal(t, "t_11_bak", topology.Table.Names()[11])
+	require.Equal(t, "db_${0000..0003}.t_${00..11}_bak", topology.String())
+
+	topology, err = ParseShardingTopology("db.t_${0..127}")
+	require.NoError(t, err)
+	require.False(t, topology.DB.HasRange)
+	require.Equal(t, []string{"db"}, topology.DB.Names())
+	require.Equal(t, "t_127", topology.Table.Names()[127])
+	require.Equal(t, "db.t_${0..127}", topology.String())
+
+	for _, s := range []string{
+		"db",
+		"db.t.x",
+		".t",
+		"db_${0..3.t",
+		"db_${0..3}_${0..3}.t",
+		"db_${3..0}.t",
+		"db_${0...3}.t",
+		"db_${-1..3}.t",
+		"db_${a..b}.t",
+		"db_$.t",
+	} {
+		_, err := ParseShardingTopology(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestShardingTableRuleRestore(t *testing.T) {
+	testCases := []NodeRestoreTestCase{
+		{
+			"CREATE SHARDING TABLE RULE db.t (TOPOLOGY 'db_${0..3}.t_${0..31}', SHARDING_COLUMNS (uid), DATABASE_ALGORITHM (TYPE 'mod', PROPERTIES ('divisor' = '4')))",
+			"CREATE SHARDING TABLE RULE `db`.`t` (TOPOLOGY = 'db_${0..3}.t_${0..31}', SHARDING_COLUMNS = (`uid`), DATABASE_ALGORITHM = (TYPE = 'mod', PROPERTIES = ('divisor' = '4')))",
+		},
+		{
+			"CREATE SHARDING TABLE RULE IF NOT EXISTS t (TABLE_ALGORITHM = (TYPE = 'hash'), KEY_GENERATOR = (COLUMN = id, TYPE = 'snowflake', PROPERTIES = ('worker-id' = '1'))), t2 (TOPOLOGY = 'db.t2')",
+			"CREATE SHARDING TABLE RULE IF NOT EXISTS `t` (TABLE_ALGORITHM = (TYPE = 'hash'), KEY_GENERATOR = (COLUMN = `id`, TYPE = 'snowflake', PROPERTIES = ('worker-id' = '1'))), `t2` (TOPOLOGY = 'db.t2')",
+		},
+		{"ALTER SHARDING TABLE RULE t (SHARDING_COLUMNS = (a, b))", "ALTER SHARDING TABLE RULE `t` (SHARDING_COLUMNS = (`a`, `b`))"},
+		{"DROP SHARDING TABLE RULE IF EXISTS t, db.t2", "DROP SHARDING TABLE RULE IF EXISTS `t`, `db`.`t2`"},
+	}
+	extractNodeFunc := func(node Node) Node {
+		return node
+	}
+	runNodeRestoreTest(t, testCases, "%s", extractNodeFunc)
+}
+
+func TestShardingTableRuleOption(t *testing.T) {
+	rule := &ShardingTableRule{Options: []*ShardingTableRuleOption{
+		{Tp: ShardingTableRuleOptionTableAlgorithm, Algorithm: &ShardingAlgorithm{Type: "mod"}},
+		{Tp: ShardingTableRuleOptionTableAlgorithm, Algorithm: &ShardingAlgorithm{Type: "hash"}},
+	}}
+	require.Equal(t, "hash", rule.Option(ShardingTableRuleOptionTableAlgorithm).Algorithm.Type)
+	require.Nil(t, rule.Option(ShardingTableRuleOptionTopology))
+}
+
+func TestDBGroupStmt(t *testing.T) {
+	p := parser.New()
+	stmt, err := p.ParseOneStmt("CREATE DB GROUP g (PRIMARY = n0, REPLICAS = (n1 WEIGHT 0, n2), TRANSACTION_ROUTING = PRIMARY) FROM t1", "", "")
+	require.NoError(t, err)
+	create := stmt.(*CreateDBGroupStmt)
+	require.Equal(t, "g", create.Name)
+	require.Equal(t, "t1", create.Tenant)
+	require.Len(t, create.Options, 3)
+	require.Equal(t, DBGroupOptionPrimary, create.Options[0].Tp)
+	require.Equal(t, "n0", create.Options[0].Primary)
+	require.Equal(t, []*DBGroupReplica{{Node: "n1", Weight: 0}, {Node: "n2", Weight: DefaultDBGroupReplicaWeight}}, create.Options[1].Replicas)
+	require.Equal(t, DBGroupTxnRoutingPrimary, create.Options[2].TxnRouting)
+
+	stmt, err = p.ParseOneStmt("ALTER DB GROUP g (REPLICAS = ()) FROM t1", "", "")
+	require.NoError(t, err)
+	replicas := stmt.(*AlterDBGroupStmt).Options[0].Replicas
+	require.NotNil(t, replicas)
+	require.Empty(t, replicas)
+
+	// GROUPS is a keyword when window functions are enabled.
+	stmt, err = p.ParseOneStmt("SHOW DB GROUPS FROM t1", "", "")
+	require.NoError(t, err)
+	require.EqualValues(t, ShowDBGroups, stmt.(*ShowStmt).Tp)
+}
+
+func TestShadowRuleVisitorCover(t *testing.T) {
+	ce := &checkExpr{}
+	rule := &ShadowRule{
+		Table: &TableName{},
+		Matchers: []*ShadowMatcher{
+			{Tp: ShadowMatcherValue, Value: ce},
+			{Tp: ShadowMatcherHint},
+			{Tp: ShadowMatcherValue, Value: ce},
+		},
+	}
+	(&CreateShadowRuleStmt{Rules: []*ShadowRule{rule}}).Accept(checkVisitor{})
+	require.Equal(t, 2, ce.enterCnt)
+	require.Equal(t, 2, ce.leaveCnt)
+}
+
+func TestShadowMatcher(t *testing.T) {
+	p := parser.New()
+	stmt, err := p.ParseOneStmt("CREATE SHADOW RULE t (SHADOW_GROUP = g, MATCH uid = 1 ON (INSERT, DELETE), MATCH name REGEXP '^a', MATCH HINT ON (SELECT))", "", "")
+	require.NoError(t, err)
+	rule := stmt.(*CreateShadowRuleStmt).Rules[0]
+	require.Equal(t, "g", rule.Group)
+	require.Len(t, rule.Matchers, 3)
+
+	require.Equal(t, ShadowMatcherValue, rule.Matchers[0].Tp)
+	require.Equal(t, "uid", rule.Matchers[0].Column.L)
+	require.Equal(t, ShadowOperationInsert|ShadowOperationDelete, rule.Matchers[0].Operations)
+
+	require.Equal(t, ShadowMatcherRegexp, rule.Matchers[1].Tp)
+	require.Equal(t, "^a", rule.Matchers[1].Regexp)
+	require.Equal(t, ShadowOperation(0), rule.Matchers[1].Operations)
+
+	require.Equal(t, ShadowMatcherHint, rule.Matchers[2].Tp)
+	require.Equal(t, ShadowOperationSelect, rule.Matchers[2].Operations)
+}
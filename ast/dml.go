@@ -14,6 +14,7 @@
 package ast
 
 import (
+	"sort"
 	"strings"
 
 	"github.com/pingcap/errors"
@@ -22,6 +23,7 @@ import (
 	"github.com/arana-db/parser/format"
 	"github.com/arana-db/parser/model"
 	"github.com/arana-db/parser/mysql"
+	"github.com/arana-db/parser/types"
 )
 
 var (
@@ -49,6 +51,7 @@ var (
 	_ Node = &TableName{}
 	_ Node = &TableRefsClause{}
 	_ Node = &TableSource{}
+	_ Node = &JSONTable{}
 	_ Node = &SetOprSelectList{}
 	_ Node = &WildCardField{}
 	_ Node = &WindowSpec{}
@@ -525,15 +528,45 @@ type TableSource struct {
 	node
 
 	// Source is the source of the data, can be a TableName,
-	// a SelectStmt, a SetOprStmt, or a JoinNode.
+	// a SelectStmt, a SetOprStmt, a JoinNode, or a JSONTable.
 	Source ResultSetNode
 
 	// AsName is the alias name of the table source.
 	AsName model.CIStr
+
+	// Lateral indicates a LATERAL derived table, which may refer to
+	// the tables preceding it in the same FROM clause.
+	Lateral bool
 }
 
 func (*TableSource) resultSet() {}
 
+// OuterTableRefs returns the qualifiers of the columns referenced by a derived
+// table that are not defined inside it, in order of first appearance.
+// For a LATERAL derived table these are the preceding tables it depends on.
+// Unqualified columns can't be resolved without schema and are ignored.
+func (n *TableSource) OuterTableRefs() []model.CIStr {
+	switch n.Source.(type) {
+	case *SelectStmt, *SetOprStmt:
+	default:
+		return nil
+	}
+	var collector outerTableRefCollector
+	n.Source.Accept(&collector)
+	sort.Ints(collector.outer)
+	var refs []model.CIStr
+	seen := make(map[string]struct{})
+	for _, idx := range collector.outer {
+		ref := collector.refs[idx]
+		if _, ok := seen[ref.L]; ok {
+			continue
+		}
+		seen[ref.L] = struct{}{}
+		refs = append(refs, ref)
+	}
+	return refs
+}
+
 // Restore implements Node interface.
 func (n *TableSource) Restore(ctx *format.RestoreCtx) error {
 	needParen := false
@@ -576,6 +609,9 @@ func (n *TableSource) Restore(ctx *format.RestoreCtx) error {
 			ctx.WritePlain(")")
 		}
 	} else {
+		if n.Lateral {
+			ctx.WriteKeyWord("LATERAL ")
+		}
 		if needParen {
 			ctx.WritePlain("(")
 		}
@@ -609,6 +645,189 @@ func (n *TableSource) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// JSONTableColumnType is the type of a JSON_TABLE column definition.
+type JSONTableColumnType int
+
+// JSON_TABLE column definition types.
+const (
+	// JSONTableColumnOrdinality is `name FOR ORDINALITY`.
+	JSONTableColumnOrdinality JSONTableColumnType = iota
+	// JSONTableColumnPath is `name type PATH path [on_empty] [on_error]`.
+	JSONTableColumnPath
+	// JSONTableColumnExistsPath is `name type EXISTS PATH path`.
+	JSONTableColumnExistsPath
+	// JSONTableColumnNested is `NESTED [PATH] path COLUMNS (...)`.
+	JSONTableColumnNested
+)
+
+// JSONTableResponseType is the behavior of a JSON_TABLE column on empty or on error.
+type JSONTableResponseType int
+
+// JSON_TABLE column response types.
+const (
+	JSONTableResponseNull JSONTableResponseType = iota
+	JSONTableResponseError
+	JSONTableResponseDefault
+)
+
+// JSONTableResponse is the `{NULL | ERROR | DEFAULT value} ON {EMPTY | ERROR}` clause of a JSON_TABLE column.
+type JSONTableResponse struct {
+	Tp JSONTableResponseType
+	// Default is the value used for JSONTableResponseDefault.
+	Default ExprNode
+}
+
+// Restore writes the response without the trailing ON EMPTY/ON ERROR.
+func (n *JSONTableResponse) Restore(ctx *format.RestoreCtx) error {
+	switch n.Tp {
+	case JSONTableResponseNull:
+		ctx.WriteKeyWord("NULL")
+	case JSONTableResponseError:
+		ctx.WriteKeyWord("ERROR")
+	case JSONTableResponseDefault:
+		ctx.WriteKeyWord("DEFAULT ")
+		if err := n.Default.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore JSONTableResponse.Default")
+		}
+	}
+	return nil
+}
+
+// JSONTableColumn is a column definition of JSON_TABLE.
+type JSONTableColumn struct {
+	Tp JSONTableColumnType
+	// Name is empty for nested columns.
+	Name model.CIStr
+	// FieldType is only used by path and exists path columns.
+	FieldType *types.FieldType
+	Path      string
+	OnEmpty   *JSONTableResponse
+	OnError   *JSONTableResponse
+	// Columns is only used by nested columns.
+	Columns []*JSONTableColumn
+}
+
+// Restore writes the column definition into ctx.
+func (n *JSONTableColumn) Restore(ctx *format.RestoreCtx) error {
+	if n.Tp == JSONTableColumnNested {
+		ctx.WriteKeyWord("NESTED PATH ")
+		ctx.WriteString(n.Path)
+		ctx.WritePlain(" ")
+		return restoreJSONTableColumns(ctx, n.Columns)
+	}
+	ctx.WriteName(n.Name.O)
+	if n.Tp == JSONTableColumnOrdinality {
+		ctx.WriteKeyWord(" FOR ORDINALITY")
+		return nil
+	}
+	ctx.WritePlain(" ")
+	if err := n.FieldType.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore JSONTableColumn.FieldType")
+	}
+	if n.Tp == JSONTableColumnExistsPath {
+		ctx.WriteKeyWord(" EXISTS")
+	}
+	ctx.WriteKeyWord(" PATH ")
+	ctx.WriteString(n.Path)
+	if n.OnEmpty != nil {
+		ctx.WritePlain(" ")
+		if err := n.OnEmpty.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore JSONTableColumn.OnEmpty")
+		}
+		ctx.WriteKeyWord(" ON EMPTY")
+	}
+	if n.OnError != nil {
+		ctx.WritePlain(" ")
+		if err := n.OnError.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore JSONTableColumn.OnError")
+		}
+		ctx.WriteKeyWord(" ON ERROR")
+	}
+	return nil
+}
+
+func restoreJSONTableColumns(ctx *format.RestoreCtx, cols []*JSONTableColumn) error {
+	ctx.WriteKeyWord("COLUMNS")
+	ctx.WritePlain("(")
+	for i, col := range cols {
+		if i > 0 {
+			ctx.WritePlain(", ")
+		}
+		if err := col.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore JSONTable.Columns[%d]", i)
+		}
+	}
+	ctx.WritePlain(")")
+	return nil
+}
+
+func acceptJSONTableColumns(v Visitor, cols []*JSONTableColumn) bool {
+	for _, col := range cols {
+		for _, resp := range []*JSONTableResponse{col.OnEmpty, col.OnError} {
+			if resp == nil || resp.Default == nil {
+				continue
+			}
+			node, ok := resp.Default.Accept(v)
+			if !ok {
+				return false
+			}
+			resp.Default = node.(ExprNode)
+		}
+		if !acceptJSONTableColumns(v, col.Columns) {
+			return false
+		}
+	}
+	return true
+}
+
+// JSONTable is the JSON_TABLE table function used as a table source.
+// See https://dev.mysql.com/doc/refman/8.0/en/json-table-functions.html
+type JSONTable struct {
+	node
+
+	// Expr is the JSON document expression.
+	Expr    ExprNode
+	Path    string
+	Columns []*JSONTableColumn
+}
+
+func (*JSONTable) resultSet() {}
+
+// Restore implements Node interface.
+func (n *JSONTable) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("JSON_TABLE")
+	ctx.WritePlain("(")
+	if err := n.Expr.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore JSONTable.Expr")
+	}
+	ctx.WritePlain(", ")
+	ctx.WriteString(n.Path)
+	ctx.WritePlain(" ")
+	if err := restoreJSONTableColumns(ctx, n.Columns); err != nil {
+		return err
+	}
+	ctx.WritePlain(")")
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *JSONTable) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*JSONTable)
+	node, ok := n.Expr.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Expr = node.(ExprNode)
+	if !acceptJSONTableColumns(v, n.Columns) {
+		return n, false
+	}
+	return v.Leave(n)
+}
+
 // SelectLockType is the lock type for SelectStmt.
 type SelectLockType int
 
@@ -624,10 +843,68 @@ const (
 	SelectLockForShareSkipLocked
 )
 
+// SelectLockInfo is the locking clause of a SELECT statement.
+// See https://dev.mysql.com/doc/refman/8.0/en/innodb-locking-reads.html
 type SelectLockInfo struct {
 	LockType SelectLockType
 	WaitSec  uint64
 	Tables   []*TableName
+	// LockInShareMode indicates the legacy `LOCK IN SHARE MODE` syntax of SelectLockForShare.
+	LockInShareMode bool
+	// Others are the locking clauses following the first one, such as
+	// `FOR SHARE OF t2` in `FOR UPDATE OF t1 FOR SHARE OF t2`.
+	Others []*SelectLockInfo
+}
+
+// Clauses returns the first locking clause followed by the others.
+func (n *SelectLockInfo) Clauses() []*SelectLockInfo {
+	return append([]*SelectLockInfo{n}, n.Others...)
+}
+
+// Restore writes the locking clauses into ctx.
+func (n *SelectLockInfo) Restore(ctx *format.RestoreCtx) error {
+	for i, clause := range n.Clauses() {
+		if i != 0 {
+			ctx.WritePlain(" ")
+		}
+		if err := clause.restoreClause(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n *SelectLockInfo) restoreClause(ctx *format.RestoreCtx) error {
+	if n.LockInShareMode {
+		ctx.WriteKeyWord("LOCK IN SHARE MODE")
+		return nil
+	}
+	switch n.LockType {
+	case SelectLockNone:
+		return nil
+	case SelectLockForUpdate, SelectLockForUpdateNoWait, SelectLockForUpdateWaitN, SelectLockForUpdateSkipLocked:
+		ctx.WriteKeyWord("for update")
+	case SelectLockForShare, SelectLockForShareNoWait, SelectLockForShareSkipLocked:
+		ctx.WriteKeyWord("for share")
+	default:
+		ctx.WriteKeyWord(n.LockType.String())
+	}
+	if len(n.Tables) != 0 {
+		ctx.WriteKeyWord(" OF ")
+		if err := restoreTables(ctx, n.Tables); err != nil {
+			return err
+		}
+	}
+	switch n.LockType {
+	case SelectLockForUpdateNoWait, SelectLockForShareNoWait:
+		ctx.WriteKeyWord(" nowait")
+	case SelectLockForUpdateWaitN:
+		ctx.WriteKeyWord(" wait")
+		ctx.WritePlainf(" %d", n.WaitSec)
+	case SelectLockForUpdateSkipLocked, SelectLockForShareSkipLocked:
+		ctx.WriteKeyWord(" skip locked")
+	}
+	return nil
 }
 
 // String implements fmt.Stringer.
@@ -1032,11 +1309,17 @@ func (s *TableSample) Accept(v Visitor) (node Node, ok bool) {
 	return v.Leave(s)
 }
 
+// SelectStmtKind distinguishes the query expressions represented by SelectStmt.
+// TABLE and VALUES statements are SelectStmts too, so they can be used anywhere
+// a SELECT can: in set operations, derived tables, subqueries, CTEs and INSERT.
 type SelectStmtKind uint8
 
 const (
+	// SelectStmtKindSelect is a `SELECT ...` statement.
 	SelectStmtKindSelect SelectStmtKind = iota
+	// SelectStmtKindTable is a `TABLE t [ORDER BY ...] [LIMIT ...]` statement, From holds the table.
 	SelectStmtKindTable
+	// SelectStmtKindValues is a `VALUES ROW(...), ...` statement, Lists holds the rows.
 	SelectStmtKindValues
 )
 
@@ -1330,52 +1613,10 @@ func (n *SelectStmt) Restore(ctx *format.RestoreCtx) error {
 		}
 	}
 
-	if n.LockInfo != nil {
+	if n.LockInfo != nil && n.LockInfo.LockType != SelectLockNone {
 		ctx.WritePlain(" ")
-		switch n.LockInfo.LockType {
-		case SelectLockNone:
-		case SelectLockForUpdateNoWait:
-			ctx.WriteKeyWord("for update")
-			if len(n.LockInfo.Tables) != 0 {
-				ctx.WriteKeyWord(" OF ")
-				restoreTables(ctx, n.LockInfo.Tables)
-			}
-			ctx.WriteKeyWord(" nowait")
-		case SelectLockForUpdateWaitN:
-			ctx.WriteKeyWord("for update")
-			if len(n.LockInfo.Tables) != 0 {
-				ctx.WriteKeyWord(" OF ")
-				restoreTables(ctx, n.LockInfo.Tables)
-			}
-			ctx.WriteKeyWord(" wait")
-			ctx.WritePlainf(" %d", n.LockInfo.WaitSec)
-		case SelectLockForShareNoWait:
-			ctx.WriteKeyWord("for share")
-			if len(n.LockInfo.Tables) != 0 {
-				ctx.WriteKeyWord(" OF ")
-				restoreTables(ctx, n.LockInfo.Tables)
-			}
-			ctx.WriteKeyWord(" nowait")
-		case SelectLockForUpdateSkipLocked:
-			ctx.WriteKeyWord("for update")
-			if len(n.LockInfo.Tables) != 0 {
-				ctx.WriteKeyWord(" OF ")
-				restoreTables(ctx, n.LockInfo.Tables)
-			}
-			ctx.WriteKeyWord(" skip locked")
-		case SelectLockForShareSkipLocked:
-			ctx.WriteKeyWord("for share")
-			if len(n.LockInfo.Tables) != 0 {
-				ctx.WriteKeyWord(" OF ")
-				restoreTables(ctx, n.LockInfo.Tables)
-			}
-			ctx.WriteKeyWord(" skip locked")
-		default:
-			ctx.WriteKeyWord(n.LockInfo.LockType.String())
-			if len(n.LockInfo.Tables) != 0 {
-				ctx.WriteKeyWord(" OF ")
-				restoreTables(ctx, n.LockInfo.Tables)
-			}
+		if err := n.LockInfo.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore SelectStmt.LockInfo")
 		}
 	}
 
@@ -1502,12 +1743,14 @@ func (n *SelectStmt) Accept(v Visitor) (Node, bool) {
 	}
 
 	if n.LockInfo != nil {
-		for i, t := range n.LockInfo.Tables {
-			node, ok := t.Accept(v)
-			if !ok {
-				return n, false
+		for _, clause := range n.LockInfo.Clauses() {
+			for i, t := range clause.Tables {
+				node, ok := t.Accept(v)
+				if !ok {
+					return n, false
+				}
+				clause.Tables[i] = node.(*TableName)
 			}
-			n.LockInfo.Tables[i] = node.(*TableName)
 		}
 	}
 
@@ -2021,6 +2264,11 @@ type InsertStmt struct {
 	// TableHints represents the table level Optimizer Hint for join type.
 	TableHints     []*TableOptimizerHint
 	PartitionNames []model.CIStr
+	// RowAlias is the alias of the inserted row in `VALUES (...) AS alias(col, ...)`,
+	// which can be referenced by OnDuplicate instead of the VALUES() function.
+	RowAlias model.CIStr
+	// RowAliasColumns are the optional column aliases of RowAlias.
+	RowAliasColumns []model.CIStr
 }
 
 // Restore implements Node interface.
@@ -2120,6 +2368,20 @@ func (n *InsertStmt) Restore(ctx *format.RestoreCtx) error {
 			}
 		}
 	}
+	if n.RowAlias.O != "" {
+		ctx.WriteKeyWord(" AS ")
+		ctx.WriteName(n.RowAlias.O)
+		if len(n.RowAliasColumns) > 0 {
+			ctx.WritePlain("(")
+			for i, col := range n.RowAliasColumns {
+				if i != 0 {
+					ctx.WritePlain(",")
+				}
+				ctx.WriteName(col.O)
+			}
+			ctx.WritePlain(")")
+		}
+	}
 	if n.OnDuplicate != nil {
 		ctx.WriteKeyWord(" ON DUPLICATE KEY UPDATE ")
 		for i, v := range n.OnDuplicate {
@@ -2625,6 +2887,14 @@ const (
 	ShowUsers
 	ShowDatabaseRules
 	ShowShardingTable
+	ShowBinaryLogs
+	ShowBinlogEvents
+	ShowRelaylogEvents
+	ShowDBGroups
+	ShowShadowRules
+	ShowShadowTables
+	ShowSequences
+	ShowSequenceStatus
 )
 
 const (
@@ -2666,7 +2936,11 @@ type ShowStmt struct {
 
 	ShowProfileTypes []int  // Used for `SHOW PROFILE` syntax
 	ShowProfileArgs  *int64 // Used for `SHOW PROFILE` syntax
-	Limit            *Limit // Used for `SHOW PROFILE` `SHOW WARNINGS` syntax
+	Limit            *Limit // Used for `SHOW PROFILE` `SHOW WARNINGS` `SHOW BINLOG EVENTS` `SHOW RELAYLOG EVENTS` syntax
+
+	BinlogFile string // Used for `SHOW BINLOG|RELAYLOG EVENTS IN 'log_name'`
+	BinlogPos  uint64 // Used for `SHOW BINLOG|RELAYLOG EVENTS FROM pos`
+	Channel    string // Used for `SHOW RELAYLOG EVENTS FOR CHANNEL channel`
 }
 
 // Restore implements Node interface.
@@ -2733,6 +3007,9 @@ func (n *ShowStmt) Restore(ctx *format.RestoreCtx) error {
 	case ShowNodes:
 		ctx.WriteKeyWord("NODES FROM ")
 		ctx.WriteName(n.Tenant)
+	case ShowDBGroups:
+		ctx.WriteKeyWord("DB GROUPS FROM ")
+		ctx.WriteName(n.Tenant)
 	case ShowCreateView:
 		ctx.WriteKeyWord("CREATE VIEW ")
 		if err := n.Table.Restore(ctx); err != nil {
@@ -2746,6 +3023,21 @@ func (n *ShowStmt) Restore(ctx *format.RestoreCtx) error {
 	case ShowShardingTable:
 		ctx.WriteKeyWord("SHARDING TABLE FROM ")
 		ctx.WriteName(n.DBName)
+	case ShowShadowRules, ShowShadowTables, ShowSequences, ShowSequenceStatus:
+		switch n.Tp {
+		case ShowShadowRules:
+			ctx.WriteKeyWord("SHADOW RULES")
+		case ShowShadowTables:
+			ctx.WriteKeyWord("SHADOW TABLES")
+		case ShowSequences:
+			ctx.WriteKeyWord("SEQUENCES")
+		case ShowSequenceStatus:
+			ctx.WriteKeyWord("SEQUENCE STATUS")
+		}
+		if n.DBName != "" {
+			ctx.WriteKeyWord(" FROM ")
+			ctx.WriteName(n.DBName)
+		}
 	case ShowCreateDatabase:
 		ctx.WriteKeyWord("CREATE DATABASE ")
 		if n.IfNotExists {
@@ -2786,6 +3078,29 @@ func (n *ShowStmt) Restore(ctx *format.RestoreCtx) error {
 		}
 	case ShowMasterStatus:
 		ctx.WriteKeyWord("MASTER STATUS")
+	case ShowBinaryLogs:
+		ctx.WriteKeyWord("BINARY LOGS")
+	case ShowBinlogEvents, ShowRelaylogEvents:
+		if n.Tp == ShowBinlogEvents {
+			ctx.WriteKeyWord("BINLOG EVENTS")
+		} else {
+			ctx.WriteKeyWord("RELAYLOG EVENTS")
+		}
+		if n.BinlogFile != "" {
+			ctx.WriteKeyWord(" IN ")
+			ctx.WriteString(n.BinlogFile)
+		}
+		if n.BinlogPos > 0 {
+			ctx.WriteKeyWord(" FROM ")
+			ctx.WritePlainf("%d", n.BinlogPos)
+		}
+		if n.Limit != nil {
+			ctx.WritePlain(" ")
+			if err := n.Limit.Restore(ctx); err != nil {
+				return errors.Annotate(err, "An error occurred while restore ShowStmt.Limit")
+			}
+		}
+		restoreReplicationChannel(ctx, n.Channel)
 	case ShowReplicaStatus:
 		ctx.WriteKeyWord("REPLICA STATUS")
 	case ShowProcessList:
@@ -3157,6 +3472,14 @@ const (
 	SelectIntoVars
 )
 
+// SelectIntoVar is a target variable of `SELECT ... INTO var_list`.
+type SelectIntoVar struct {
+	Name model.CIStr
+	// IsUserVar indicates the target is a user variable such as `@a`, otherwise
+	// it is a local variable or parameter of a stored program.
+	IsUserVar bool
+}
+
 type SelectIntoOption struct {
 	node
 
@@ -3164,12 +3487,27 @@ type SelectIntoOption struct {
 	FileName   string
 	FieldsInfo *FieldsClause
 	LinesInfo  *LinesClause
+	// Vars is the target variables of `SELECT ... INTO var_list`.
+	Vars []*SelectIntoVar
 }
 
 // Restore implements Node interface.
 func (n *SelectIntoOption) Restore(ctx *format.RestoreCtx) error {
+	if n.Tp == SelectIntoVars {
+		ctx.WriteKeyWord("INTO ")
+		for i, v := range n.Vars {
+			if i > 0 {
+				ctx.WritePlain(",")
+			}
+			if v.IsUserVar {
+				ctx.WritePlain("@")
+			}
+			ctx.WriteName(v.Name.O)
+		}
+		return nil
+	}
 	if n.Tp != SelectIntoOutfile {
-		// only support SELECT/TABLE/VALUES ... INTO OUTFILE statement now
+		// only support SELECT/TABLE/VALUES ... INTO OUTFILE and INTO var_list statement now
 		return errors.New("Unsupported SelectionInto type")
 	}
 
@@ -16,6 +16,7 @@ package ast_test
 import (
 	"testing"
 
+	"github.com/arana-db/parser"
 	. "github.com/arana-db/parser/ast"
 	"github.com/stretchr/testify/require"
 )
@@ -46,6 +47,10 @@ func TestDMLVisitorCover(t *testing.T) {
 		{&TableName{}, 0, 0},
 		{tableRefsClause, 1, 1},
 		{&TableSource{Source: &TableName{}}, 0, 0},
+		{&TableSource{Source: &JSONTable{Expr: ce, Columns: []*JSONTableColumn{
+			{OnEmpty: &JSONTableResponse{Default: ce}, OnError: &JSONTableResponse{}},
+			{Columns: []*JSONTableColumn{{OnError: &JSONTableResponse{Default: ce}}}},
+		}}}, 3, 3},
 		{&WildCardField{}, 0, 0},
 
 		// TODO: cover childrens
@@ -185,6 +190,8 @@ func TestTableSourceRestore(t *testing.T) {
 		{"tbl as t", "`tbl` AS `t`"},
 		{"(select * from tbl) as t", "(SELECT * FROM `tbl`) AS `t`"},
 		{"(select * from a union select * from b) as t", "(SELECT * FROM `a` UNION SELECT * FROM `b`) AS `t`"},
+		{"json_table(doc, '$' columns (id for ordinality)) jt", "JSON_TABLE(`doc`, '$' COLUMNS(`id` FOR ORDINALITY)) AS `jt`"},
+		{"lateral (select * from tbl) as t", "LATERAL (SELECT * FROM `tbl`) AS `t`"},
 	}
 	extractNodeFunc := func(node Node) Node {
 		return node.(*SelectStmt).From.TableRefs.Left
@@ -192,6 +199,78 @@ func TestTableSourceRestore(t *testing.T) {
 	runNodeRestoreTest(t, testCases, "select * from %s", extractNodeFunc)
 }
 
+func TestTableSourceOuterTableRefs(t *testing.T) {
+	cases := []struct {
+		sql  string
+		refs []string
+	}{
+		{"select * from t1, lateral (select * from t2 where t2.a = t1.a) as d", []string{"t1"}},
+		{"select * from t1 a join t2 b join lateral (select a.x, B.y, a.z from t3 where t3.c = b.c) as d", []string{"a", "B"}},
+		{"select * from t1 join lateral (select * from t2 x where x.a = a) as d", nil},
+		{"select * from t1, lateral (select * from t2 where exists (select 1 from t3 s where s.a = t1.a and s.b = t2.b)) as d", []string{"t1"}},
+		{"select * from t1, lateral (with c as (select 1 as a) select * from c where c.a = t1.a union select * from t4 where t4.a = t5.a) as d", []string{"t1", "t5"}},
+		{"select * from t1, lateral (select * from t2 where t2.a = t1.a and exists (select 1 from t1 where t1.b = 1)) as d", []string{"t1"}},
+		{"select * from t1, lateral (select * from t2 where t2.a in (select t2.b from t3 t2) and t2.c = t1.c) as d", []string{"t1"}},
+		{"select * from t1, t2, lateral (select (select t2.a) as x from t3 where t3.a = t1.a) as d", []string{"t2", "t1"}},
+	}
+	p := parser.New()
+	for _, c := range cases {
+		stmt, err := p.ParseOneStmt(c.sql, "", "")
+		require.NoError(t, err, c.sql)
+		ts := stmt.(*SelectStmt).From.TableRefs.Right.(*TableSource)
+		require.True(t, ts.Lateral)
+		var refs []string
+		for _, ref := range ts.OuterTableRefs() {
+			refs = append(refs, ref.O)
+		}
+		require.Equal(t, c.refs, refs, c.sql)
+	}
+	require.Nil(t, (&TableSource{Source: &TableName{}}).OuterTableRefs())
+}
+
+func TestInsertRowAlias(t *testing.T) {
+	p := parser.New()
+	stmt, err := p.ParseOneStmt("insert into t values (1, 2) as new(m, n) on duplicate key update a = new.a + m, b = t.b + b + (select max(new.b) from s)", "", "")
+	require.NoError(t, err)
+	insert := stmt.(*InsertStmt)
+	require.Equal(t, "new", insert.RowAlias.O)
+	require.Equal(t, []string{"m", "n"}, []string{insert.RowAliasColumns[0].O, insert.RowAliasColumns[1].O})
+
+	var marked, unmarked []string
+	collector := columnNameCollector(func(col *ColumnName) {
+		if col.IsRowAlias {
+			marked = append(marked, col.String())
+		} else {
+			unmarked = append(unmarked, col.String())
+		}
+	})
+	for _, assignment := range insert.OnDuplicate {
+		assignment.Expr.Accept(collector)
+	}
+	require.Equal(t, []string{"new.a", "m", "new.b"}, marked)
+	require.Equal(t, []string{"t.b", "b"}, unmarked)
+
+	stmt, err = p.ParseOneStmt("insert into t values row(1, 2) as new on duplicate key update a = new.b", "", "")
+	require.NoError(t, err)
+	insert = stmt.(*InsertStmt)
+	require.Equal(t, "new", insert.RowAlias.O)
+	require.Equal(t, SelectStmtKindValues, insert.Select.(*SelectStmt).Kind)
+	require.True(t, insert.OnDuplicate[0].Expr.(*ColumnNameExpr).Name.IsRowAlias)
+}
+
+type columnNameCollector func(col *ColumnName)
+
+func (c columnNameCollector) Enter(n Node) (Node, bool) {
+	if col, ok := n.(*ColumnName); ok {
+		c(col)
+	}
+	return n, false
+}
+
+func (c columnNameCollector) Leave(n Node) (Node, bool) {
+	return n, true
+}
+
 func TestOnConditionRestore(t *testing.T) {
 	testCases := []NodeRestoreTestCase{
 		{"on t1.a=t2.a", "ON `t1`.`a`=`t2`.`a`"},
@@ -41,6 +41,7 @@ var (
 	_ ExprNode = &PatternInExpr{}
 	_ ExprNode = &PatternLikeExpr{}
 	_ ExprNode = &PatternRegexpExpr{}
+	_ ExprNode = &MemberOfExpr{}
 	_ ExprNode = &PositionExpr{}
 	_ ExprNode = &RowExpr{}
 	_ ExprNode = &SubqueryExpr{}
@@ -508,6 +509,9 @@ type ColumnName struct {
 	Schema model.CIStr
 	Table  model.CIStr
 	Name   model.CIStr
+	// IsRowAlias indicates the column refers to the row alias of
+	// `INSERT ... AS alias ON DUPLICATE KEY UPDATE` instead of a real table.
+	IsRowAlias bool
 }
 
 // Restore implements Node interface.
@@ -1108,6 +1112,61 @@ func (n *PatternRegexpExpr) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// MemberOfExpr is the expression for "expr MEMBER OF (json_array)".
+// See https://dev.mysql.com/doc/refman/8.0/en/json-search-functions.html#operator_member-of
+type MemberOfExpr struct {
+	exprNode
+	// Expr is the value to be searched.
+	Expr ExprNode
+	// Array is the JSON array to search in.
+	Array ExprNode
+}
+
+// Restore implements Node interface.
+func (n *MemberOfExpr) Restore(ctx *format.RestoreCtx) error {
+	if err := n.Expr.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore MemberOfExpr.Expr")
+	}
+	ctx.WritePlain(" ")
+	if err := opcode.MemberOf.Restore(ctx); err != nil {
+		return errors.Trace(err)
+	}
+	ctx.WritePlain(" (")
+	if err := n.Array.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore MemberOfExpr.Array")
+	}
+	ctx.WritePlain(")")
+	return nil
+}
+
+// Format the ExprNode into a Writer.
+func (n *MemberOfExpr) Format(w io.Writer) {
+	n.Expr.Format(w)
+	fmt.Fprint(w, " MEMBER OF (")
+	n.Array.Format(w)
+	fmt.Fprint(w, ")")
+}
+
+// Accept implements Node Accept interface.
+func (n *MemberOfExpr) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*MemberOfExpr)
+	node, ok := n.Expr.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Expr = node.(ExprNode)
+	node, ok = n.Array.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Array = node.(ExprNode)
+	return v.Leave(n)
+}
+
 // RowExpr is the expression for row constructor.
 // See https://dev.mysql.com/doc/refman/5.7/en/row-subqueries.html
 type RowExpr struct {
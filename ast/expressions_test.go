@@ -83,6 +83,7 @@ func TestExpresionsVisitorCover(t *testing.T) {
 			{&PatternInExpr{Expr: ce, List: []ExprNode{ce, ce, ce}, Sel: ce}, 5, 5},
 			{&PatternLikeExpr{Expr: ce, Pattern: ce}, 2, 2},
 			{&PatternRegexpExpr{Expr: ce, Pattern: ce}, 2, 2},
+			{&MemberOfExpr{Expr: ce, Array: ce}, 2, 2},
 			{&PositionExpr{}, 0, 0},
 			{&RowExpr{Values: []ExprNode{ce, ce}}, 2, 2},
 			{&UnaryOperationExpr{V: ce}, 1, 1},
@@ -322,6 +323,18 @@ func TestPatternRegexpExprRestore(t *testing.T) {
 	runNodeRestoreTest(t, testCases, "select %s", extractNodeFunc)
 }
 
+func TestMemberOfExprRestore(t *testing.T) {
+	testCases := []NodeRestoreTestCase{
+		{"1 member of (a)", "1 MEMBER OF (`a`)"},
+		{"'ab' member of (j->'$.tags')", "_UTF8MB4'ab' MEMBER OF (JSON_EXTRACT(`j`, _UTF8MB4'$.tags'))"},
+		{"a + 1 member of ('[1, 2]')", "`a`+1 MEMBER OF (_UTF8MB4'[1, 2]')"},
+	}
+	extractNodeFunc := func(node Node) Node {
+		return node.(*SelectStmt).Fields.Fields[0].Expr
+	}
+	runNodeRestoreTest(t, testCases, "select %s", extractNodeFunc)
+}
+
 func TestRowExprRestore(t *testing.T) {
 	testCases := []NodeRestoreTestCase{
 		{"(1,2)", "ROW(1,2)"},
@@ -341,6 +341,87 @@ const (
 	JSONKeys          = "json_keys"
 	JSONLength        = "json_length"
 
+	// spatial functions
+	Point              = "point"
+	LineString         = "linestring"
+	Polygon            = "polygon"
+	MultiPoint         = "multipoint"
+	MultiLineString    = "multilinestring"
+	MultiPolygon       = "multipolygon"
+	GeometryCollection = "geometrycollection"
+	GeomCollection     = "geomcollection"
+	STArea             = "st_area"
+	STAsBinary         = "st_asbinary"
+	STAsGeoJSON        = "st_asgeojson"
+	STAsText           = "st_astext"
+	STAsWKB            = "st_aswkb"
+	STAsWKT            = "st_aswkt"
+	STBuffer           = "st_buffer"
+	STCentroid         = "st_centroid"
+	STContains         = "st_contains"
+	STConvexHull       = "st_convexhull"
+	STCrosses          = "st_crosses"
+	STDifference       = "st_difference"
+	STDimension        = "st_dimension"
+	STDisjoint         = "st_disjoint"
+	STDistance         = "st_distance"
+	STDistanceSphere   = "st_distance_sphere"
+	STEndPoint         = "st_endpoint"
+	STEnvelope         = "st_envelope"
+	STEquals           = "st_equals"
+	STExteriorRing     = "st_exteriorring"
+	STGeoHash          = "st_geohash"
+	STGeomCollFromText = "st_geomcollfromtext"
+	STGeomCollFromWKB  = "st_geomcollfromwkb"
+	STGeometryN        = "st_geometryn"
+	STGeometryType     = "st_geometrytype"
+	STGeomFromGeoJSON  = "st_geomfromgeojson"
+	STGeomFromText     = "st_geomfromtext"
+	STGeomFromWKB      = "st_geomfromwkb"
+	STInteriorRingN    = "st_interiorringn"
+	STIntersection     = "st_intersection"
+	STIntersects       = "st_intersects"
+	STIsClosed         = "st_isclosed"
+	STIsEmpty          = "st_isempty"
+	STIsSimple         = "st_issimple"
+	STIsValid          = "st_isvalid"
+	STLatFromGeoHash   = "st_latfromgeohash"
+	STLatitude         = "st_latitude"
+	STLength           = "st_length"
+	STLineFromText     = "st_linefromtext"
+	STLineFromWKB      = "st_linefromwkb"
+	STLongFromGeoHash  = "st_longfromgeohash"
+	STLongitude        = "st_longitude"
+	STMakeEnvelope     = "st_makeenvelope"
+	STMLineFromText    = "st_mlinefromtext"
+	STMLineFromWKB     = "st_mlinefromwkb"
+	STMPointFromText   = "st_mpointfromtext"
+	STMPointFromWKB    = "st_mpointfromwkb"
+	STMPolyFromText    = "st_mpolyfromtext"
+	STMPolyFromWKB     = "st_mpolyfromwkb"
+	STNumGeometries    = "st_numgeometries"
+	STNumInteriorRing  = "st_numinteriorring"
+	STNumPoints        = "st_numpoints"
+	STOverlaps         = "st_overlaps"
+	STPointFromGeoHash = "st_pointfromgeohash"
+	STPointFromText    = "st_pointfromtext"
+	STPointFromWKB     = "st_pointfromwkb"
+	STPointN           = "st_pointn"
+	STPolyFromText     = "st_polyfromtext"
+	STPolyFromWKB      = "st_polyfromwkb"
+	STSimplify         = "st_simplify"
+	STSRID             = "st_srid"
+	STStartPoint       = "st_startpoint"
+	STSwapXY           = "st_swapxy"
+	STSymDifference    = "st_symdifference"
+	STTouches          = "st_touches"
+	STTransform        = "st_transform"
+	STUnion            = "st_union"
+	STValidate         = "st_validate"
+	STWithin           = "st_within"
+	STX                = "st_x"
+	STY                = "st_y"
+
 	// TiDB internal function.
 	TiDBDecodeKey       = "tidb_decode_key"
 	TiDBDecodeBase64Key = "tidb_decode_base64_key"
@@ -556,6 +637,9 @@ type FuncCastExpr struct {
 	FunctionType CastFunctionType
 	// ExplicitCharSet is true when charset is explicit indicated.
 	ExplicitCharSet bool
+	// Array is true for "CAST(expr AS type ARRAY)" and "CONVERT(expr, type ARRAY)",
+	// which are used by multi-valued indexes.
+	Array bool
 }
 
 // Restore implements Node interface.
@@ -569,6 +653,9 @@ func (n *FuncCastExpr) Restore(ctx *format.RestoreCtx) error {
 		}
 		ctx.WriteKeyWord(" AS ")
 		n.Tp.RestoreAsCastType(ctx, n.ExplicitCharSet)
+		if n.Array {
+			ctx.WriteKeyWord(" ARRAY")
+		}
 		ctx.WritePlain(")")
 	case CastConvertFunction:
 		ctx.WriteKeyWord("CONVERT")
@@ -578,6 +665,9 @@ func (n *FuncCastExpr) Restore(ctx *format.RestoreCtx) error {
 		}
 		ctx.WritePlain(", ")
 		n.Tp.RestoreAsCastType(ctx, n.ExplicitCharSet)
+		if n.Array {
+			ctx.WriteKeyWord(" ARRAY")
+		}
 		ctx.WritePlain(")")
 	case CastBinaryOperator:
 		ctx.WriteKeyWord("BINARY ")
@@ -596,12 +686,18 @@ func (n *FuncCastExpr) Format(w io.Writer) {
 		n.Expr.Format(w)
 		fmt.Fprint(w, " AS ")
 		n.Tp.FormatAsCastType(w, n.ExplicitCharSet)
+		if n.Array {
+			fmt.Fprint(w, " ARRAY")
+		}
 		fmt.Fprint(w, ")")
 	case CastConvertFunction:
 		fmt.Fprint(w, "CONVERT(")
 		n.Expr.Format(w)
 		fmt.Fprint(w, ", ")
 		n.Tp.FormatAsCastType(w, n.ExplicitCharSet)
+		if n.Array {
+			fmt.Fprint(w, " ARRAY")
+		}
 		fmt.Fprint(w, ")")
 	case CastBinaryOperator:
 		fmt.Fprint(w, "BINARY ")
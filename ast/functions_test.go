@@ -109,6 +109,9 @@ func TestFuncCastExprRestore(t *testing.T) {
 		{"CONVERT('Müller' USING UtF8Mb4)", "CONVERT(_UTF8MB4'Müller' USING 'utf8mb4')"},
 		{"CONVERT('Müller', CHAR(32) CHARACTER SET UtF8)", "CONVERT(_UTF8MB4'Müller', CHAR(32) CHARSET UTF8)"},
 		{"CAST('test' AS CHAR CHARACTER SET UtF8)", "CAST(_UTF8MB4'test' AS CHAR CHARSET UTF8)"},
+		{"CAST(j AS UNSIGNED ARRAY)", "CAST(`j` AS UNSIGNED ARRAY)"},
+		{"CAST(j AS CHAR(10) ARRAY)", "CAST(`j` AS CHAR(10) ARRAY)"},
+		{"CONVERT(j, SIGNED ARRAY)", "CONVERT(`j`, SIGNED ARRAY)"},
 		{"BINARY 'New York'", "BINARY _UTF8MB4'New York'"},
 	}
 	extractNodeFunc := func(node Node) Node {
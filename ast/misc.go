@@ -15,6 +15,7 @@ package ast
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
 	"net/url"
 	"strconv"
@@ -30,6 +31,7 @@ import (
 
 var (
 	_ StmtNode = &AdminStmt{}
+	_ StmtNode = &CheckShardingTableStmt{}
 	_ StmtNode = &AlterUserStmt{}
 	_ StmtNode = &BeginStmt{}
 	_ StmtNode = &BinlogStmt{}
@@ -39,9 +41,13 @@ var (
 	_ StmtNode = &DoStmt{}
 	_ StmtNode = &ExecuteStmt{}
 	_ StmtNode = &ExplainStmt{}
+	_ StmtNode = &ExplainShardingStmt{}
 	_ StmtNode = &GrantStmt{}
 	_ StmtNode = &PrepareStmt{}
 	_ StmtNode = &RollbackStmt{}
+	_ StmtNode = &SavepointStmt{}
+	_ StmtNode = &ReleaseSavepointStmt{}
+	_ StmtNode = &XAStmt{}
 	_ StmtNode = &SetPwdStmt{}
 	_ StmtNode = &SetRoleStmt{}
 	_ StmtNode = &SetDefaultRoleStmt{}
@@ -57,6 +63,13 @@ var (
 	_ StmtNode = &RenameUserStmt{}
 	_ StmtNode = &HelpStmt{}
 	_ StmtNode = &PlanReplayerStmt{}
+	_ StmtNode = &ChangeReplicationSourceStmt{}
+	_ StmtNode = &StartReplicaStmt{}
+	_ StmtNode = &StopReplicaStmt{}
+	_ StmtNode = &ResetReplicaStmt{}
+	_ StmtNode = &ResetMasterStmt{}
+	_ StmtNode = &PurgeBinaryLogsStmt{}
+	_ StmtNode = &ResetPersistStmt{}
 
 	_ Node = &PrivElem{}
 	_ Node = &VariableAssignment{}
@@ -256,6 +269,45 @@ func (n *ExplainStmt) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// ExplainShardingStmt is a statement to preview how a DML statement is routed
+// by the proxy, it returns the physical SQL to be executed on each data source.
+// The wrapped statement keeps its original text and offset.
+type ExplainShardingStmt struct {
+	stmtNode
+
+	Stmt StmtNode
+	// Preview indicates the statement is written as PREVIEW rather than EXPLAIN SHARDING.
+	Preview bool
+}
+
+// Restore implements Node interface.
+func (n *ExplainShardingStmt) Restore(ctx *format.RestoreCtx) error {
+	if n.Preview {
+		ctx.WriteKeyWord("PREVIEW ")
+	} else {
+		ctx.WriteKeyWord("EXPLAIN SHARDING ")
+	}
+	if err := n.Stmt.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore ExplainShardingStmt.Stmt")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *ExplainShardingStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*ExplainShardingStmt)
+	node, ok := n.Stmt.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Stmt = node.(StmtNode)
+	return v.Leave(n)
+}
+
 // PlanReplayerStmt is a statement to dump or load information for recreating plans
 type PlanReplayerStmt struct {
 	stmtNode
@@ -611,11 +663,18 @@ type RollbackStmt struct {
 	stmtNode
 	// CompletionType overwrites system variable `completion_type` within transaction
 	CompletionType CompletionType
+	// SavepointName is the name of the savepoint to roll back to, empty for the whole transaction.
+	SavepointName string
 }
 
 // Restore implements Node interface.
 func (n *RollbackStmt) Restore(ctx *format.RestoreCtx) error {
 	ctx.WriteKeyWord("ROLLBACK")
+	if n.SavepointName != "" {
+		ctx.WriteKeyWord(" TO SAVEPOINT ")
+		ctx.WriteName(n.SavepointName)
+		return nil
+	}
 	if err := n.CompletionType.Restore(ctx); err != nil {
 		return errors.Annotate(err, "An error occurred while restore RollbackStmt.CompletionType")
 	}
@@ -632,6 +691,193 @@ func (n *RollbackStmt) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// SavepointStmt is a statement to set a named transaction savepoint.
+// See https://dev.mysql.com/doc/refman/8.0/en/savepoint.html
+type SavepointStmt struct {
+	stmtNode
+	Name string
+}
+
+// Restore implements Node interface.
+func (n *SavepointStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("SAVEPOINT ")
+	ctx.WriteName(n.Name)
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *SavepointStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*SavepointStmt)
+	return v.Leave(n)
+}
+
+// ReleaseSavepointStmt is a statement to remove a named savepoint.
+// See https://dev.mysql.com/doc/refman/8.0/en/savepoint.html
+type ReleaseSavepointStmt struct {
+	stmtNode
+	Name string
+}
+
+// Restore implements Node interface.
+func (n *ReleaseSavepointStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("RELEASE SAVEPOINT ")
+	ctx.WriteName(n.Name)
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *ReleaseSavepointStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*ReleaseSavepointStmt)
+	return v.Leave(n)
+}
+
+// XAStmtType is the type of an XA statement.
+type XAStmtType int
+
+// XA statement types.
+const (
+	XAStart XAStmtType = iota
+	XAEnd
+	XAPrepare
+	XACommit
+	XARollback
+	XARecover
+)
+
+// String implements fmt.Stringer interface.
+func (t XAStmtType) String() string {
+	switch t {
+	case XAStart:
+		return "START"
+	case XAEnd:
+		return "END"
+	case XAPrepare:
+		return "PREPARE"
+	case XACommit:
+		return "COMMIT"
+	case XARollback:
+		return "ROLLBACK"
+	case XARecover:
+		return "RECOVER"
+	}
+	return ""
+}
+
+// XIDDefaultFormatID is the formatID used when an XID doesn't specify one.
+const XIDDefaultFormatID uint64 = 1
+
+// XID identifies an XA transaction branch.
+// See https://dev.mysql.com/doc/refman/8.0/en/xa-statements.html
+type XID struct {
+	// Gtrid is the global transaction identifier.
+	Gtrid *TextString
+	// Bqual is the branch qualifier, nil when it is omitted.
+	Bqual *TextString
+	// FormatID identifies the format used by Gtrid and Bqual.
+	FormatID uint64
+}
+
+func restoreXIDPart(ctx *format.RestoreCtx, part *TextString) {
+	if part.IsBinaryLiteral {
+		ctx.WritePlain("0x")
+		ctx.WritePlain(hex.EncodeToString([]byte(part.Value)))
+		return
+	}
+	ctx.WriteString(part.Value)
+}
+
+// Restore writes the XID as `gtrid[,bqual[,formatID]]`.
+func (n *XID) Restore(ctx *format.RestoreCtx) error {
+	restoreXIDPart(ctx, n.Gtrid)
+	if n.Bqual == nil && n.FormatID == XIDDefaultFormatID {
+		return nil
+	}
+	ctx.WritePlain(",")
+	if n.Bqual != nil {
+		restoreXIDPart(ctx, n.Bqual)
+	} else {
+		ctx.WriteString("")
+	}
+	if n.FormatID != XIDDefaultFormatID {
+		ctx.WritePlainf(",%d", n.FormatID)
+	}
+	return nil
+}
+
+// XAStmt is a statement to control XA transactions.
+// See https://dev.mysql.com/doc/refman/8.0/en/xa-statements.html
+type XAStmt struct {
+	stmtNode
+
+	Tp XAStmtType
+	// XID is nil for XA RECOVER.
+	XID *XID
+	// Join and Resume are only used by XA START.
+	Join   bool
+	Resume bool
+	// Suspend and ForMigrate are only used by XA END.
+	Suspend    bool
+	ForMigrate bool
+	// OnePhase is only used by XA COMMIT.
+	OnePhase bool
+	// ConvertXID is only used by XA RECOVER.
+	ConvertXID bool
+}
+
+// Restore implements Node interface.
+func (n *XAStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("XA ")
+	ctx.WriteKeyWord(n.Tp.String())
+	if n.Tp == XARecover {
+		if n.ConvertXID {
+			ctx.WriteKeyWord(" CONVERT XID")
+		}
+		return nil
+	}
+	ctx.WritePlain(" ")
+	if err := n.XID.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore XAStmt.XID")
+	}
+	switch n.Tp {
+	case XAStart:
+		if n.Join {
+			ctx.WriteKeyWord(" JOIN")
+		} else if n.Resume {
+			ctx.WriteKeyWord(" RESUME")
+		}
+	case XAEnd:
+		if n.Suspend {
+			ctx.WriteKeyWord(" SUSPEND")
+			if n.ForMigrate {
+				ctx.WriteKeyWord(" FOR MIGRATE")
+			}
+		}
+	case XACommit:
+		if n.OnePhase {
+			ctx.WriteKeyWord(" ONE PHASE")
+		}
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *XAStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*XAStmt)
+	return v.Leave(n)
+}
+
 // UseStmt is a statement to use the DBName database as the current database.
 // See https://dev.mysql.com/doc/refman/5.7/en/use.html
 type UseStmt struct {
@@ -672,6 +918,20 @@ type VariableAssignment struct {
 	Value    ExprNode
 	IsGlobal bool
 	IsSystem bool
+	// IsScoped indicates the scope of the system variable is written explicitly,
+	// such as `SET SESSION a`, `SET @@a` or `SET @@GLOBAL.a`.
+	IsScoped bool
+	// IsPersist indicates the assignment is `SET PERSIST` or `SET @@PERSIST.`,
+	// which sets the global value and also persists it. IsGlobal is set too.
+	IsPersist bool
+	// IsPersistOnly indicates the assignment is `SET PERSIST_ONLY` or
+	// `SET @@PERSIST_ONLY.`, which persists the value without changing the
+	// runtime global value.
+	IsPersistOnly bool
+	// IsLocal indicates the target is a local variable or parameter of a
+	// stored program, or a column of the NEW or OLD row inside a trigger body,
+	// such as `SET NEW.a = 1`.
+	IsLocal bool
 
 	// ExtendValue is a way to store extended info.
 	// VariableAssignment should be able to store information for SetCharset/SetPWD Stmt.
@@ -684,19 +944,31 @@ type VariableAssignment struct {
 func (n *VariableAssignment) Restore(ctx *format.RestoreCtx) error {
 	if n.IsSystem {
 		ctx.WritePlain("@@")
-		if n.IsGlobal {
+		if n.IsPersistOnly {
+			ctx.WriteKeyWord("PERSIST_ONLY")
+		} else if n.IsPersist {
+			ctx.WriteKeyWord("PERSIST")
+		} else if n.IsGlobal {
 			ctx.WriteKeyWord("GLOBAL")
 		} else {
 			ctx.WriteKeyWord("SESSION")
 		}
 		ctx.WritePlain(".")
-	} else if n.Name != SetNames && n.Name != SetCharset {
+	} else if n.Name != SetNames && n.Name != SetCharset && !n.IsLocal {
 		ctx.WriteKeyWord("@")
 	}
 	if n.Name == SetNames {
 		ctx.WriteKeyWord("NAMES ")
 	} else if n.Name == SetCharset {
 		ctx.WriteKeyWord("CHARSET ")
+	} else if n.IsLocal {
+		for i, part := range strings.Split(n.Name, ".") {
+			if i > 0 {
+				ctx.WritePlain(".")
+			}
+			ctx.WriteName(part)
+		}
+		ctx.WritePlain("=")
 	} else {
 		ctx.WriteName(n.Name)
 		ctx.WritePlain("=")
@@ -921,6 +1193,44 @@ func (n *SetStmt) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// ResetPersistStmt is the statement to remove persisted global system variable settings.
+// See https://dev.mysql.com/doc/refman/8.0/en/reset-persist.html
+type ResetPersistStmt struct {
+	stmtNode
+
+	IfExists bool
+	// Name is the system variable to remove, empty means all persisted variables.
+	Name string
+}
+
+// Restore implements Node interface.
+func (n *ResetPersistStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("RESET PERSIST")
+	if n.IfExists {
+		ctx.WriteKeyWord(" IF EXISTS")
+	}
+	if n.Name != "" {
+		ctx.WritePlain(" ")
+		for i, part := range strings.Split(n.Name, ".") {
+			if i > 0 {
+				ctx.WritePlain(".")
+			}
+			ctx.WriteName(part)
+		}
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *ResetPersistStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*ResetPersistStmt)
+	return v.Leave(n)
+}
+
 // SetConfigStmt is the statement to set cluster configs.
 type SetConfigStmt struct {
 	stmtNode
@@ -1052,6 +1362,564 @@ func (n *ChangeStmt) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// ReplicationSourceOptionType is the type of an option in CHANGE REPLICATION SOURCE TO.
+type ReplicationSourceOptionType int
+
+// ReplicationSourceOption types.
+const (
+	ReplicationSourceOptionBind ReplicationSourceOptionType = iota
+	ReplicationSourceOptionHost
+	ReplicationSourceOptionUser
+	ReplicationSourceOptionPassword
+	ReplicationSourceOptionPort
+	ReplicationSourceOptionPrivilegeChecksUser
+	ReplicationSourceOptionRequireRowFormat
+	ReplicationSourceOptionRequireTablePrimaryKeyCheck
+	ReplicationSourceOptionAssignGtidsToAnonymousTransactions
+	ReplicationSourceOptionLogFile
+	ReplicationSourceOptionLogPos
+	ReplicationSourceOptionAutoPosition
+	ReplicationSourceOptionRelayLogFile
+	ReplicationSourceOptionRelayLogPos
+	ReplicationSourceOptionHeartbeatPeriod
+	ReplicationSourceOptionConnectRetry
+	ReplicationSourceOptionRetryCount
+	ReplicationSourceOptionConnectionAutoFailover
+	ReplicationSourceOptionDelay
+	ReplicationSourceOptionCompressionAlgorithms
+	ReplicationSourceOptionZstdCompressionLevel
+	ReplicationSourceOptionSSL
+	ReplicationSourceOptionSSLCA
+	ReplicationSourceOptionSSLCAPath
+	ReplicationSourceOptionSSLCert
+	ReplicationSourceOptionSSLCRL
+	ReplicationSourceOptionSSLCRLPath
+	ReplicationSourceOptionSSLKey
+	ReplicationSourceOptionSSLCipher
+	ReplicationSourceOptionSSLVerifyServerCert
+	ReplicationSourceOptionTLSVersion
+	ReplicationSourceOptionTLSCiphersuites
+	ReplicationSourceOptionPublicKeyPath
+	ReplicationSourceOptionGetPublicKey
+	ReplicationSourceOptionNetworkNamespace
+	ReplicationSourceOptionIgnoreServerIDs
+	ReplicationSourceOptionGtidOnly
+)
+
+// String returns the option name used by CHANGE REPLICATION SOURCE TO.
+func (tp ReplicationSourceOptionType) String() string {
+	switch tp {
+	case ReplicationSourceOptionBind:
+		return "SOURCE_BIND"
+	case ReplicationSourceOptionHost:
+		return "SOURCE_HOST"
+	case ReplicationSourceOptionUser:
+		return "SOURCE_USER"
+	case ReplicationSourceOptionPassword:
+		return "SOURCE_PASSWORD"
+	case ReplicationSourceOptionPort:
+		return "SOURCE_PORT"
+	case ReplicationSourceOptionPrivilegeChecksUser:
+		return "PRIVILEGE_CHECKS_USER"
+	case ReplicationSourceOptionRequireRowFormat:
+		return "REQUIRE_ROW_FORMAT"
+	case ReplicationSourceOptionRequireTablePrimaryKeyCheck:
+		return "REQUIRE_TABLE_PRIMARY_KEY_CHECK"
+	case ReplicationSourceOptionAssignGtidsToAnonymousTransactions:
+		return "ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS"
+	case ReplicationSourceOptionLogFile:
+		return "SOURCE_LOG_FILE"
+	case ReplicationSourceOptionLogPos:
+		return "SOURCE_LOG_POS"
+	case ReplicationSourceOptionAutoPosition:
+		return "SOURCE_AUTO_POSITION"
+	case ReplicationSourceOptionRelayLogFile:
+		return "RELAY_LOG_FILE"
+	case ReplicationSourceOptionRelayLogPos:
+		return "RELAY_LOG_POS"
+	case ReplicationSourceOptionHeartbeatPeriod:
+		return "SOURCE_HEARTBEAT_PERIOD"
+	case ReplicationSourceOptionConnectRetry:
+		return "SOURCE_CONNECT_RETRY"
+	case ReplicationSourceOptionRetryCount:
+		return "SOURCE_RETRY_COUNT"
+	case ReplicationSourceOptionConnectionAutoFailover:
+		return "SOURCE_CONNECTION_AUTO_FAILOVER"
+	case ReplicationSourceOptionDelay:
+		return "SOURCE_DELAY"
+	case ReplicationSourceOptionCompressionAlgorithms:
+		return "SOURCE_COMPRESSION_ALGORITHMS"
+	case ReplicationSourceOptionZstdCompressionLevel:
+		return "SOURCE_ZSTD_COMPRESSION_LEVEL"
+	case ReplicationSourceOptionSSL:
+		return "SOURCE_SSL"
+	case ReplicationSourceOptionSSLCA:
+		return "SOURCE_SSL_CA"
+	case ReplicationSourceOptionSSLCAPath:
+		return "SOURCE_SSL_CAPATH"
+	case ReplicationSourceOptionSSLCert:
+		return "SOURCE_SSL_CERT"
+	case ReplicationSourceOptionSSLCRL:
+		return "SOURCE_SSL_CRL"
+	case ReplicationSourceOptionSSLCRLPath:
+		return "SOURCE_SSL_CRLPATH"
+	case ReplicationSourceOptionSSLKey:
+		return "SOURCE_SSL_KEY"
+	case ReplicationSourceOptionSSLCipher:
+		return "SOURCE_SSL_CIPHER"
+	case ReplicationSourceOptionSSLVerifyServerCert:
+		return "SOURCE_SSL_VERIFY_SERVER_CERT"
+	case ReplicationSourceOptionTLSVersion:
+		return "SOURCE_TLS_VERSION"
+	case ReplicationSourceOptionTLSCiphersuites:
+		return "SOURCE_TLS_CIPHERSUITES"
+	case ReplicationSourceOptionPublicKeyPath:
+		return "SOURCE_PUBLIC_KEY_PATH"
+	case ReplicationSourceOptionGetPublicKey:
+		return "GET_SOURCE_PUBLIC_KEY"
+	case ReplicationSourceOptionNetworkNamespace:
+		return "NETWORK_NAMESPACE"
+	case ReplicationSourceOptionIgnoreServerIDs:
+		return "IGNORE_SERVER_IDS"
+	case ReplicationSourceOptionGtidOnly:
+		return "GTID_ONLY"
+	default:
+		return ""
+	}
+}
+
+// LegacyString returns the option name used by CHANGE MASTER TO.
+func (tp ReplicationSourceOptionType) LegacyString() string {
+	if tp == ReplicationSourceOptionConnectionAutoFailover {
+		// There is no MASTER_ spelling of this option.
+		return tp.String()
+	}
+	return strings.Replace(tp.String(), "SOURCE_", "MASTER_", 1)
+}
+
+// ReplicationSourceOption is an option in CHANGE REPLICATION SOURCE TO.
+type ReplicationSourceOption struct {
+	Tp        ReplicationSourceOptionType
+	StrValue  string
+	UintValue uint64
+	// IsNull is used by SOURCE_TLS_CIPHERSUITES, which accepts NULL as well as a string.
+	IsNull bool
+	// Value is used by SOURCE_HEARTBEAT_PERIOD, which accepts a decimal.
+	Value ExprNode
+	// User is used by PRIVILEGE_CHECKS_USER, nil means NULL.
+	User *auth.UserIdentity
+	// ServerIDs is used by IGNORE_SERVER_IDS.
+	ServerIDs []uint64
+}
+
+func (opt *ReplicationSourceOption) restore(ctx *format.RestoreCtx, legacy bool) error {
+	if legacy {
+		ctx.WriteKeyWord(opt.Tp.LegacyString())
+	} else {
+		ctx.WriteKeyWord(opt.Tp.String())
+	}
+	ctx.WritePlain(" = ")
+	switch opt.Tp {
+	case ReplicationSourceOptionPrivilegeChecksUser:
+		if opt.User == nil {
+			ctx.WriteKeyWord("NULL")
+		} else if err := opt.User.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore ReplicationSourceOption.User")
+		}
+	case ReplicationSourceOptionRequireTablePrimaryKeyCheck:
+		ctx.WriteKeyWord(opt.StrValue)
+	case ReplicationSourceOptionAssignGtidsToAnonymousTransactions:
+		if strings.EqualFold(opt.StrValue, "OFF") || strings.EqualFold(opt.StrValue, "LOCAL") {
+			ctx.WriteKeyWord(opt.StrValue)
+		} else {
+			ctx.WriteString(opt.StrValue)
+		}
+	case ReplicationSourceOptionHeartbeatPeriod:
+		if err := opt.Value.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore ReplicationSourceOption.Value")
+		}
+	case ReplicationSourceOptionIgnoreServerIDs:
+		ctx.WritePlain("(")
+		for i, id := range opt.ServerIDs {
+			if i > 0 {
+				ctx.WritePlain(", ")
+			}
+			ctx.WritePlainf("%d", id)
+		}
+		ctx.WritePlain(")")
+	case ReplicationSourceOptionBind, ReplicationSourceOptionHost, ReplicationSourceOptionUser,
+		ReplicationSourceOptionPassword, ReplicationSourceOptionLogFile, ReplicationSourceOptionRelayLogFile,
+		ReplicationSourceOptionCompressionAlgorithms, ReplicationSourceOptionSSLCA, ReplicationSourceOptionSSLCAPath,
+		ReplicationSourceOptionSSLCert, ReplicationSourceOptionSSLCRL, ReplicationSourceOptionSSLCRLPath,
+		ReplicationSourceOptionSSLKey, ReplicationSourceOptionSSLCipher, ReplicationSourceOptionTLSVersion,
+		ReplicationSourceOptionTLSCiphersuites, ReplicationSourceOptionPublicKeyPath, ReplicationSourceOptionNetworkNamespace:
+		if opt.IsNull {
+			ctx.WriteKeyWord("NULL")
+		} else {
+			ctx.WriteString(opt.StrValue)
+		}
+	default:
+		ctx.WritePlainf("%d", opt.UintValue)
+	}
+	return nil
+}
+
+func restoreReplicationChannel(ctx *format.RestoreCtx, channel string) {
+	if channel != "" {
+		ctx.WriteKeyWord(" FOR CHANNEL ")
+		ctx.WriteString(channel)
+	}
+}
+
+// ChangeReplicationSourceStmt is a statement to change the source a replica reads from.
+// See https://dev.mysql.com/doc/refman/8.0/en/change-replication-source-to.html
+type ChangeReplicationSourceStmt struct {
+	stmtNode
+
+	// Legacy is true for the CHANGE MASTER TO spelling.
+	Legacy  bool
+	Options []*ReplicationSourceOption
+	Channel string
+}
+
+// Restore implements Node interface.
+func (n *ChangeReplicationSourceStmt) Restore(ctx *format.RestoreCtx) error {
+	if n.Legacy {
+		ctx.WriteKeyWord("CHANGE MASTER TO ")
+	} else {
+		ctx.WriteKeyWord("CHANGE REPLICATION SOURCE TO ")
+	}
+	for i, opt := range n.Options {
+		if i > 0 {
+			ctx.WritePlain(", ")
+		}
+		if err := opt.restore(ctx, n.Legacy); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore ChangeReplicationSourceStmt.Options[%d]", i)
+		}
+	}
+	restoreReplicationChannel(ctx, n.Channel)
+	return nil
+}
+
+// SecureText implements SensitiveStmtNode
+func (n *ChangeReplicationSourceStmt) SecureText() string {
+	redactedStmt := *n
+	redactedStmt.Options = make([]*ReplicationSourceOption, 0, len(n.Options))
+	for _, opt := range n.Options {
+		if opt.Tp == ReplicationSourceOptionPassword {
+			opt = &ReplicationSourceOption{Tp: opt.Tp, StrValue: "xxxxxx"}
+		}
+		redactedStmt.Options = append(redactedStmt.Options, opt)
+	}
+
+	var sb strings.Builder
+	_ = redactedStmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb))
+	return sb.String()
+}
+
+// Accept implements Node Accept interface.
+func (n *ChangeReplicationSourceStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*ChangeReplicationSourceStmt)
+	for _, opt := range n.Options {
+		if opt.Value == nil {
+			continue
+		}
+		node, ok := opt.Value.Accept(v)
+		if !ok {
+			return n, false
+		}
+		opt.Value = node.(ExprNode)
+	}
+	return v.Leave(n)
+}
+
+// ReplicaThreadType is a set of replication threads controlled by START REPLICA and STOP REPLICA.
+type ReplicaThreadType int
+
+// ReplicaThread types, zero means all threads.
+const (
+	ReplicaThreadIO ReplicaThreadType = 1 << iota
+	ReplicaThreadSQL
+)
+
+func (tp ReplicaThreadType) restore(ctx *format.RestoreCtx) {
+	if tp&ReplicaThreadIO != 0 {
+		ctx.WriteKeyWord(" IO_THREAD")
+		if tp&ReplicaThreadSQL != 0 {
+			ctx.WritePlain(",")
+		}
+	}
+	if tp&ReplicaThreadSQL != 0 {
+		ctx.WriteKeyWord(" SQL_THREAD")
+	}
+}
+
+// ReplicaUntilType is the type of the UNTIL clause of START REPLICA.
+type ReplicaUntilType int
+
+// ReplicaUntil types.
+const (
+	ReplicaUntilSQLBeforeGTIDs ReplicaUntilType = iota
+	ReplicaUntilSQLAfterGTIDs
+	ReplicaUntilSourceLogPos
+	ReplicaUntilRelayLogPos
+	ReplicaUntilSQLAfterMTSGaps
+)
+
+// ReplicaUntil is the UNTIL clause of START REPLICA.
+type ReplicaUntil struct {
+	Tp ReplicaUntilType
+	// GTIDSet is used by SQL_BEFORE_GTIDS and SQL_AFTER_GTIDS.
+	GTIDSet string
+	// LogFile and LogPos are used by the source and relay log positions.
+	LogFile string
+	LogPos  uint64
+}
+
+func (n *ReplicaUntil) restore(ctx *format.RestoreCtx, legacy bool) {
+	ctx.WriteKeyWord(" UNTIL ")
+	switch n.Tp {
+	case ReplicaUntilSQLBeforeGTIDs:
+		ctx.WriteKeyWord("SQL_BEFORE_GTIDS")
+		ctx.WritePlain(" = ")
+		ctx.WriteString(n.GTIDSet)
+	case ReplicaUntilSQLAfterGTIDs:
+		ctx.WriteKeyWord("SQL_AFTER_GTIDS")
+		ctx.WritePlain(" = ")
+		ctx.WriteString(n.GTIDSet)
+	case ReplicaUntilSourceLogPos, ReplicaUntilRelayLogPos:
+		file, pos := ReplicationSourceOptionLogFile, ReplicationSourceOptionLogPos
+		if n.Tp == ReplicaUntilRelayLogPos {
+			file, pos = ReplicationSourceOptionRelayLogFile, ReplicationSourceOptionRelayLogPos
+		}
+		(&ReplicationSourceOption{Tp: file, StrValue: n.LogFile}).restore(ctx, legacy)
+		ctx.WritePlain(", ")
+		(&ReplicationSourceOption{Tp: pos, UintValue: n.LogPos}).restore(ctx, legacy)
+	case ReplicaUntilSQLAfterMTSGaps:
+		ctx.WriteKeyWord("SQL_AFTER_MTS_GAPS")
+	}
+}
+
+func restoreReplicaKeyword(ctx *format.RestoreCtx, legacy bool) {
+	if legacy {
+		ctx.WriteKeyWord("SLAVE")
+	} else {
+		ctx.WriteKeyWord("REPLICA")
+	}
+}
+
+// StartReplicaStmt is a statement to start the replication threads.
+// See https://dev.mysql.com/doc/refman/8.0/en/start-replica.html
+type StartReplicaStmt struct {
+	stmtNode
+
+	// Legacy is true for the START SLAVE spelling.
+	Legacy  bool
+	Threads ReplicaThreadType
+	Until   *ReplicaUntil
+
+	User        string
+	Password    string
+	DefaultAuth string
+	PluginDir   string
+
+	Channel string
+}
+
+// Restore implements Node interface.
+func (n *StartReplicaStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("START ")
+	restoreReplicaKeyword(ctx, n.Legacy)
+	n.Threads.restore(ctx)
+	if n.Until != nil {
+		n.Until.restore(ctx, n.Legacy)
+	}
+	for _, opt := range []struct {
+		name  string
+		value string
+	}{
+		{"USER", n.User},
+		{"PASSWORD", n.Password},
+		{"DEFAULT_AUTH", n.DefaultAuth},
+		{"PLUGIN_DIR", n.PluginDir},
+	} {
+		if opt.value != "" {
+			ctx.WritePlain(" ")
+			ctx.WriteKeyWord(opt.name)
+			ctx.WritePlain(" = ")
+			ctx.WriteString(opt.value)
+		}
+	}
+	restoreReplicationChannel(ctx, n.Channel)
+	return nil
+}
+
+// SecureText implements SensitiveStmtNode
+func (n *StartReplicaStmt) SecureText() string {
+	redactedStmt := *n
+	if redactedStmt.Password != "" {
+		redactedStmt.Password = "xxxxxx"
+	}
+
+	var sb strings.Builder
+	_ = redactedStmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb))
+	return sb.String()
+}
+
+// Accept implements Node Accept interface.
+func (n *StartReplicaStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*StartReplicaStmt)
+	return v.Leave(n)
+}
+
+// StopReplicaStmt is a statement to stop the replication threads.
+// See https://dev.mysql.com/doc/refman/8.0/en/stop-replica.html
+type StopReplicaStmt struct {
+	stmtNode
+
+	// Legacy is true for the STOP SLAVE spelling.
+	Legacy  bool
+	Threads ReplicaThreadType
+	Channel string
+}
+
+// Restore implements Node interface.
+func (n *StopReplicaStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("STOP ")
+	restoreReplicaKeyword(ctx, n.Legacy)
+	n.Threads.restore(ctx)
+	restoreReplicationChannel(ctx, n.Channel)
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *StopReplicaStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*StopReplicaStmt)
+	return v.Leave(n)
+}
+
+// ResetReplicaStmt is a statement to make a replica forget its replication position.
+// See https://dev.mysql.com/doc/refman/8.0/en/reset-replica.html
+type ResetReplicaStmt struct {
+	stmtNode
+
+	// Legacy is true for the RESET SLAVE spelling.
+	Legacy  bool
+	All     bool
+	Channel string
+}
+
+// Restore implements Node interface.
+func (n *ResetReplicaStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("RESET ")
+	restoreReplicaKeyword(ctx, n.Legacy)
+	if n.All {
+		ctx.WriteKeyWord(" ALL")
+	}
+	restoreReplicationChannel(ctx, n.Channel)
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *ResetReplicaStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*ResetReplicaStmt)
+	return v.Leave(n)
+}
+
+// ResetMasterStmt is a statement to delete all binary logs and reset the binary log index.
+// See https://dev.mysql.com/doc/refman/8.0/en/reset-master.html
+type ResetMasterStmt struct {
+	stmtNode
+
+	// To is the first binary log file index after reset, zero means unspecified.
+	To uint64
+}
+
+// Restore implements Node interface.
+func (n *ResetMasterStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("RESET MASTER")
+	if n.To > 0 {
+		ctx.WriteKeyWord(" TO ")
+		ctx.WritePlainf("%d", n.To)
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *ResetMasterStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*ResetMasterStmt)
+	return v.Leave(n)
+}
+
+// PurgeBinaryLogsStmt is a statement to delete binary logs.
+// See https://dev.mysql.com/doc/refman/8.0/en/purge-binary-logs.html
+type PurgeBinaryLogsStmt struct {
+	stmtNode
+
+	// Legacy is true for the PURGE MASTER LOGS spelling.
+	Legacy bool
+	// To is the log file name of PURGE BINARY LOGS TO.
+	To string
+	// Before is the datetime expression of PURGE BINARY LOGS BEFORE.
+	Before ExprNode
+}
+
+// Restore implements Node interface.
+func (n *PurgeBinaryLogsStmt) Restore(ctx *format.RestoreCtx) error {
+	if n.Legacy {
+		ctx.WriteKeyWord("PURGE MASTER LOGS ")
+	} else {
+		ctx.WriteKeyWord("PURGE BINARY LOGS ")
+	}
+	if n.Before != nil {
+		ctx.WriteKeyWord("BEFORE ")
+		if err := n.Before.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore PurgeBinaryLogsStmt.Before")
+		}
+		return nil
+	}
+	ctx.WriteKeyWord("TO ")
+	ctx.WriteString(n.To)
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *PurgeBinaryLogsStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*PurgeBinaryLogsStmt)
+	if n.Before != nil {
+		node, ok := n.Before.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Before = node.(ExprNode)
+	}
+	return v.Leave(n)
+}
+
 // SetRoleStmtType is the type for FLUSH statement.
 type SetRoleStmtType int
 
@@ -2188,6 +3056,95 @@ func (n *AdminStmt) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// CheckShardingTableItem is a set of items compared across the physical shards
+// of a sharding table.
+type CheckShardingTableItem int
+
+// CheckShardingTableItem flags, zero means CheckShardingTableSchema.
+const (
+	CheckShardingTableColumns CheckShardingTableItem = 1 << iota
+	CheckShardingTableIndexes
+	CheckShardingTableRowCount
+
+	// CheckShardingTableSchema compares both columns and indexes.
+	CheckShardingTableSchema = CheckShardingTableColumns | CheckShardingTableIndexes
+)
+
+var checkShardingTableItemNames = []struct {
+	item CheckShardingTableItem
+	name string
+}{
+	{CheckShardingTableColumns, "COLUMNS"},
+	{CheckShardingTableIndexes, "INDEXES"},
+	{CheckShardingTableRowCount, "ROW_COUNT"},
+}
+
+// CheckShardingTableStmt is a statement to verify that every physical shard of
+// the sharding tables has the same schema, and optionally the row counts.
+//
+//	CHECK SHARDING TABLE t [, ...] [WITH (COLUMNS | INDEXES | ROW_COUNT [, ...])]
+type CheckShardingTableStmt struct {
+	stmtNode
+
+	Tables []*TableName
+	Items  CheckShardingTableItem
+}
+
+// CheckItems returns the items to compare, CheckShardingTableSchema if none is specified.
+func (n *CheckShardingTableStmt) CheckItems() CheckShardingTableItem {
+	if n.Items == 0 {
+		return CheckShardingTableSchema
+	}
+	return n.Items
+}
+
+// Restore implements Node interface.
+func (n *CheckShardingTableStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("CHECK SHARDING TABLE ")
+	for i, v := range n.Tables {
+		if i != 0 {
+			ctx.WritePlain(", ")
+		}
+		if err := v.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore CheckShardingTableStmt.Tables[%d]", i)
+		}
+	}
+	if n.Items != 0 {
+		ctx.WriteKeyWord(" WITH ")
+		ctx.WritePlain("(")
+		first := true
+		for _, item := range checkShardingTableItemNames {
+			if n.Items&item.item == 0 {
+				continue
+			}
+			if !first {
+				ctx.WritePlain(", ")
+			}
+			ctx.WriteKeyWord(item.name)
+			first = false
+		}
+		ctx.WritePlain(")")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *CheckShardingTableStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CheckShardingTableStmt)
+	for i, val := range n.Tables {
+		node, ok := val.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Tables[i] = node.(*TableName)
+	}
+	return v.Leave(n)
+}
+
 // RoleOrPriv is a temporary structure to be further processed into auth.RoleIdentity or PrivElem
 type RoleOrPriv struct {
 	Symbols string      // hold undecided symbols
@@ -3358,6 +4315,12 @@ type TableOptimizerHint struct {
 	// - READ_FROM_STORAGE   => model.CIStr
 	// - USE_TOJA            => bool
 	// - NTH_PLAN            => int64
+	//
+	// Routing hints are used by the proxy to route the statement, e.g:
+	// select /*+ route_slave(2) */ * from t.
+	// - ROUTE_SLAVE         => ast.HintRouteSlave
+	// - SHARD               => ast.HintShard
+	// - TRACE               => string
 	HintData interface{}
 	// QBName is the default effective query block of this hint.
 	QBName  model.CIStr
@@ -3371,6 +4334,18 @@ type HintTimeRange struct {
 	To   string
 }
 
+// HintRouteSlave is the payload of `ROUTE_SLAVE` hint
+type HintRouteSlave struct {
+	// Weight is the minimal load balance weight of the chosen slave, zero means any slave.
+	Weight uint64
+}
+
+// HintShard is the payload of `SHARD` hint, the table is in `Tables`.
+type HintShard struct {
+	DBIndex    uint64
+	TableIndex uint64
+}
+
 // HintSetVar is the payload of `SET_VAR` hint
 type HintSetVar struct {
 	VarName string
@@ -3420,7 +4395,8 @@ func (n *TableOptimizerHint) Restore(ctx *format.RestoreCtx) error {
 	}
 	// Hints without args except query block.
 	switch n.HintName.L {
-	case "hash_agg", "stream_agg", "agg_to_cop", "read_consistent_replica", "qb_name", "ignore_plan_cache", "limit_to_cop":
+	case "hash_agg", "stream_agg", "agg_to_cop", "read_consistent_replica", "qb_name", "ignore_plan_cache", "limit_to_cop",
+		"route_master", "fullscan", "shadow":
 		ctx.WritePlain(")")
 		return nil
 	}
@@ -3498,6 +4474,16 @@ func (n *TableOptimizerHint) Restore(ctx *format.RestoreCtx) error {
 		ctx.WriteString(hintData.VarName)
 		ctx.WritePlain(", ")
 		ctx.WriteString(hintData.Value)
+	case "route_slave":
+		if hintData := n.HintData.(HintRouteSlave); hintData.Weight != 0 {
+			ctx.WritePlainf("%d", hintData.Weight)
+		}
+	case "shard":
+		hintData := n.HintData.(HintShard)
+		n.Tables[0].Restore(ctx)
+		ctx.WritePlainf(", %d, %d", hintData.DBIndex, hintData.TableIndex)
+	case "trace":
+		ctx.WriteString(n.HintData.(string))
 	}
 	ctx.WritePlain(")")
 	return nil
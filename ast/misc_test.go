@@ -46,6 +46,7 @@ func TestMiscVisitorCover(t *testing.T) {
 	valueExpr := ast.NewValueExpr(42, mysql.DefaultCharset, mysql.DefaultCollationName)
 	stmts := []ast.Node{
 		&ast.AdminStmt{},
+		&ast.CheckShardingTableStmt{Tables: []*ast.TableName{{}}},
 		&ast.AlterUserStmt{},
 		&ast.BeginStmt{},
 		&ast.BinlogStmt{},
@@ -55,9 +56,20 @@ func TestMiscVisitorCover(t *testing.T) {
 		&ast.DoStmt{},
 		&ast.ExecuteStmt{UsingVars: []ast.ExprNode{valueExpr}},
 		&ast.ExplainStmt{Stmt: &ast.ShowStmt{}},
+		&ast.ExplainShardingStmt{Stmt: &ast.SelectStmt{}},
 		&ast.GrantStmt{},
 		&ast.PrepareStmt{SQLVar: &ast.VariableExpr{Value: valueExpr}},
 		&ast.RollbackStmt{},
+		&ast.SavepointStmt{},
+		&ast.ReleaseSavepointStmt{},
+		&ast.XAStmt{},
+		&ast.ChangeReplicationSourceStmt{Options: []*ast.ReplicationSourceOption{{}, {Value: valueExpr}}},
+		&ast.StartReplicaStmt{},
+		&ast.StopReplicaStmt{},
+		&ast.ResetReplicaStmt{},
+		&ast.ResetMasterStmt{},
+		&ast.PurgeBinaryLogsStmt{Before: valueExpr},
+		&ast.ResetPersistStmt{},
 		&ast.SetPwdStmt{},
 		&ast.SetStmt{Variables: []*ast.VariableAssignment{
 			{
@@ -381,6 +393,15 @@ func TestTableOptimizerHintRestore(t *testing.T) {
 		{"SUBQUERY()", "SUBQUERY()"},
 		{"SUBQUERY(@subq1)", "SUBQUERY(@`subq1` )"},
 		{"SUBQUERY(@subq1 INTOEXISTS, MATERIALIZATION)", "SUBQUERY(@`subq1` INTOEXISTS, MATERIALIZATION)"},
+		// arana routing hints
+		{"ROUTE_MASTER()", "ROUTE_MASTER()"},
+		{"ROUTE_SLAVE()", "ROUTE_SLAVE()"},
+		{"ROUTE_SLAVE(3)", "ROUTE_SLAVE(3)"},
+		{"SHARD(db.t1, 0, 12)", "SHARD(`db`.`t1`, 0, 12)"},
+		{"FULLSCAN()", "FULLSCAN()"},
+		{"TRACE(abc)", "TRACE('abc')"},
+		{"TRACE('trace-1')", "TRACE('trace-1')"},
+		{"SHADOW()", "SHADOW()"},
 	}
 	extractNodeFunc := func(node ast.Node) ast.Node {
 		hints := node.(*ast.SelectStmt).TableHints
@@ -403,6 +424,26 @@ func TestChangeStmtRestore(t *testing.T) {
 	runNodeRestoreTest(t, testCases, "%s", extractNodeFunc)
 }
 
+func TestXAStmtXID(t *testing.T) {
+	p := parser.New()
+	stmt, err := p.ParseOneStmt("XA COMMIT X'6162', 'c', 3 ONE PHASE", "", "")
+	require.NoError(t, err)
+	xa := stmt.(*ast.XAStmt)
+	require.Equal(t, ast.XACommit, xa.Tp)
+	require.True(t, xa.OnePhase)
+	require.Equal(t, "ab", xa.XID.Gtrid.Value)
+	require.True(t, xa.XID.Gtrid.IsBinaryLiteral)
+	require.Equal(t, "c", xa.XID.Bqual.Value)
+	require.False(t, xa.XID.Bqual.IsBinaryLiteral)
+	require.Equal(t, uint64(3), xa.XID.FormatID)
+
+	stmt, err = p.ParseOneStmt("XA END 'g'", "", "")
+	require.NoError(t, err)
+	xa = stmt.(*ast.XAStmt)
+	require.Nil(t, xa.XID.Bqual)
+	require.Equal(t, ast.XIDDefaultFormatID, xa.XID.FormatID)
+}
+
 func TestBRIESecureText(t *testing.T) {
 	testCases := []struct {
 		input   string
@@ -427,6 +468,26 @@ func TestBRIESecureText(t *testing.T) {
 			input:   "backup database * to 'gcs://bucket/prefix?access-key=irrelevant&credentials-file=/home/user/secrets.txt'",
 			secured: `^\QBACKUP DATABASE * TO 'gcs://bucket/prefix?\E((access-key=irrelevant|credentials-file=/home/user/secrets\.txt)(&|'$)){2}`,
 		},
+		{
+			input:   "change replication source to source_host='h1', source_password='secret' for channel 'c'",
+			secured: `^\QCHANGE REPLICATION SOURCE TO SOURCE_HOST = 'h1', SOURCE_PASSWORD = 'xxxxxx' FOR CHANNEL 'c'\E$`,
+		},
+		{
+			input:   "start slave user='u' password='secret'",
+			secured: `^\QSTART SLAVE USER = 'u' PASSWORD = 'xxxxxx'\E$`,
+		},
+		{
+			input:   "alter tenant t1 add user 'arana' identified by '123456', drop user 'guest'",
+			secured: `^\QALTER TENANT ` + "`t1`" + ` ADD USER 'arana' IDENTIFIED BY 'xxxxxx', DROP USER 'guest'\E$`,
+		},
+		{
+			input:   "create node n0 (host = '127.0.0.1', password = '123456') from t1",
+			secured: `^\QCREATE NODE ` + "`n0`" + ` (HOST = '127.0.0.1', PASSWORD = 'xxxxxx') FROM ` + "`t1`" + `\E$`,
+		},
+		{
+			input:   "alter node n0 (password = '123456') from t1",
+			secured: `^\QALTER NODE ` + "`n0`" + ` (PASSWORD = 'xxxxxx') FROM ` + "`t1`" + `\E$`,
+		},
 	}
 
 	p := parser.New()
@@ -0,0 +1,1417 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"github.com/arana-db/parser/auth"
+	"github.com/arana-db/parser/format"
+	"github.com/arana-db/parser/model"
+	"github.com/arana-db/parser/types"
+	"github.com/pingcap/errors"
+)
+
+var (
+	_ DDLNode = &CreateProcedureStmt{}
+	_ DDLNode = &CreateFunctionStmt{}
+	_ DDLNode = &CreateLoadableFunctionStmt{}
+	_ DDLNode = &AlterRoutineStmt{}
+	_ DDLNode = &DropRoutineStmt{}
+
+	_ StmtNode = &CompoundStmt{}
+	_ StmtNode = &DeclareVarStmt{}
+	_ StmtNode = &DeclareConditionStmt{}
+	_ StmtNode = &DeclareCursorStmt{}
+	_ StmtNode = &DeclareHandlerStmt{}
+	_ StmtNode = &IfStmt{}
+	_ StmtNode = &CaseStmt{}
+	_ StmtNode = &LoopStmt{}
+	_ StmtNode = &WhileStmt{}
+	_ StmtNode = &RepeatStmt{}
+	_ StmtNode = &LeaveStmt{}
+	_ StmtNode = &IterateStmt{}
+	_ StmtNode = &OpenCursorStmt{}
+	_ StmtNode = &FetchCursorStmt{}
+	_ StmtNode = &CloseCursorStmt{}
+	_ StmtNode = &ReturnStmt{}
+	_ StmtNode = &SignalStmt{}
+	_ StmtNode = &ResignalStmt{}
+	_ StmtNode = &GetDiagnosticsStmt{}
+)
+
+// RoutineType is the type of a stored routine.
+type RoutineType int
+
+// Stored routine types.
+const (
+	RoutineProcedure RoutineType = iota + 1
+	RoutineFunction
+)
+
+// String implements fmt.Stringer interface.
+func (t RoutineType) String() string {
+	switch t {
+	case RoutineProcedure:
+		return "PROCEDURE"
+	case RoutineFunction:
+		return "FUNCTION"
+	}
+	return ""
+}
+
+// ProcedureParamMode is the direction of a stored procedure parameter.
+type ProcedureParamMode int
+
+// Stored procedure parameter modes.
+// Function parameters have no mode, and use ProcedureParamModeNone.
+const (
+	ProcedureParamModeNone ProcedureParamMode = iota
+	ProcedureParamIn
+	ProcedureParamOut
+	ProcedureParamInOut
+)
+
+// String implements fmt.Stringer interface.
+func (m ProcedureParamMode) String() string {
+	switch m {
+	case ProcedureParamIn:
+		return "IN"
+	case ProcedureParamOut:
+		return "OUT"
+	case ProcedureParamInOut:
+		return "INOUT"
+	}
+	return ""
+}
+
+// ProcedureParameter is a parameter of a stored procedure or function.
+type ProcedureParameter struct {
+	Mode ProcedureParamMode
+	Name model.CIStr
+	Tp   *types.FieldType
+}
+
+// Restore implements Node interface.
+func (n *ProcedureParameter) Restore(ctx *format.RestoreCtx) error {
+	if n.Mode != ProcedureParamModeNone {
+		ctx.WriteKeyWord(n.Mode.String())
+		ctx.WritePlain(" ")
+	}
+	ctx.WriteName(n.Name.O)
+	ctx.WritePlain(" ")
+	if err := n.Tp.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore ProcedureParameter.Tp")
+	}
+	return nil
+}
+
+// RoutineCharacteristicType is the type of a stored routine characteristic.
+type RoutineCharacteristicType int
+
+// Stored routine characteristic types.
+const (
+	RoutineCharacteristicComment RoutineCharacteristicType = iota + 1
+	RoutineCharacteristicLanguageSQL
+	RoutineCharacteristicDeterministic
+	RoutineCharacteristicNotDeterministic
+	RoutineCharacteristicContainsSQL
+	RoutineCharacteristicNoSQL
+	RoutineCharacteristicReadsSQLData
+	RoutineCharacteristicModifiesSQLData
+	RoutineCharacteristicSQLSecurity
+)
+
+// RoutineCharacteristic is a characteristic of a stored routine.
+// See https://dev.mysql.com/doc/refman/8.0/en/create-procedure.html
+type RoutineCharacteristic struct {
+	Tp       RoutineCharacteristicType
+	Comment  string
+	Security model.ViewSecurity
+}
+
+// Restore implements Node interface.
+func (n *RoutineCharacteristic) Restore(ctx *format.RestoreCtx) error {
+	switch n.Tp {
+	case RoutineCharacteristicComment:
+		ctx.WriteKeyWord("COMMENT ")
+		ctx.WriteString(n.Comment)
+	case RoutineCharacteristicLanguageSQL:
+		ctx.WriteKeyWord("LANGUAGE SQL")
+	case RoutineCharacteristicDeterministic:
+		ctx.WriteKeyWord("DETERMINISTIC")
+	case RoutineCharacteristicNotDeterministic:
+		ctx.WriteKeyWord("NOT DETERMINISTIC")
+	case RoutineCharacteristicContainsSQL:
+		ctx.WriteKeyWord("CONTAINS SQL")
+	case RoutineCharacteristicNoSQL:
+		ctx.WriteKeyWord("NO SQL")
+	case RoutineCharacteristicReadsSQLData:
+		ctx.WriteKeyWord("READS SQL DATA")
+	case RoutineCharacteristicModifiesSQLData:
+		ctx.WriteKeyWord("MODIFIES SQL DATA")
+	case RoutineCharacteristicSQLSecurity:
+		ctx.WriteKeyWord("SQL SECURITY ")
+		ctx.WriteKeyWord(n.Security.String())
+	default:
+		return errors.Errorf("invalid RoutineCharacteristicType: %d", n.Tp)
+	}
+	return nil
+}
+
+func restoreRoutineParameters(ctx *format.RestoreCtx, params []*ProcedureParameter) error {
+	ctx.WritePlain("(")
+	for i, param := range params {
+		if i > 0 {
+			ctx.WritePlain(",")
+		}
+		if err := param.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore Params[%d]", i)
+		}
+	}
+	ctx.WritePlain(")")
+	return nil
+}
+
+func restoreRoutineCharacteristics(ctx *format.RestoreCtx, characteristics []*RoutineCharacteristic) error {
+	for i, c := range characteristics {
+		ctx.WritePlain(" ")
+		if err := c.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore Characteristics[%d]", i)
+		}
+	}
+	return nil
+}
+
+// CreateProcedureStmt is a statement to create a stored procedure.
+// See https://dev.mysql.com/doc/refman/8.0/en/create-procedure.html
+type CreateProcedureStmt struct {
+	ddlNode
+
+	IfNotExists     bool
+	Definer         *auth.UserIdentity
+	Name            *TableName
+	Params          []*ProcedureParameter
+	Characteristics []*RoutineCharacteristic
+	Body            StmtNode
+}
+
+// Restore implements Node interface.
+func (n *CreateProcedureStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("CREATE ")
+	if err := restoreDefiner(ctx, n.Definer); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateProcedureStmt.Definer")
+	}
+	ctx.WriteKeyWord("PROCEDURE ")
+	if n.IfNotExists {
+		ctx.WriteKeyWord("IF NOT EXISTS ")
+	}
+	if err := n.Name.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateProcedureStmt.Name")
+	}
+	if err := restoreRoutineParameters(ctx, n.Params); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateProcedureStmt")
+	}
+	if err := restoreRoutineCharacteristics(ctx, n.Characteristics); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateProcedureStmt")
+	}
+	ctx.WritePlain(" ")
+	if err := n.Body.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateProcedureStmt.Body")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *CreateProcedureStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CreateProcedureStmt)
+	node, ok := n.Body.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Body = node.(StmtNode)
+	return v.Leave(n)
+}
+
+// CreateFunctionStmt is a statement to create a stored function.
+// See https://dev.mysql.com/doc/refman/8.0/en/create-procedure.html
+type CreateFunctionStmt struct {
+	ddlNode
+
+	IfNotExists     bool
+	Definer         *auth.UserIdentity
+	Name            *TableName
+	Params          []*ProcedureParameter
+	Returns         *types.FieldType
+	Characteristics []*RoutineCharacteristic
+	Body            StmtNode
+}
+
+// Restore implements Node interface.
+func (n *CreateFunctionStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("CREATE ")
+	if err := restoreDefiner(ctx, n.Definer); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateFunctionStmt.Definer")
+	}
+	ctx.WriteKeyWord("FUNCTION ")
+	if n.IfNotExists {
+		ctx.WriteKeyWord("IF NOT EXISTS ")
+	}
+	if err := n.Name.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateFunctionStmt.Name")
+	}
+	if err := restoreRoutineParameters(ctx, n.Params); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateFunctionStmt")
+	}
+	ctx.WriteKeyWord(" RETURNS ")
+	if err := n.Returns.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateFunctionStmt.Returns")
+	}
+	if err := restoreRoutineCharacteristics(ctx, n.Characteristics); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateFunctionStmt")
+	}
+	ctx.WritePlain(" ")
+	if err := n.Body.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateFunctionStmt.Body")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *CreateFunctionStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CreateFunctionStmt)
+	node, ok := n.Body.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Body = node.(StmtNode)
+	return v.Leave(n)
+}
+
+// LoadableFunctionReturnType is the return type of a loadable function.
+type LoadableFunctionReturnType int
+
+// Loadable function return types.
+const (
+	LoadableFunctionReturnString LoadableFunctionReturnType = iota + 1
+	LoadableFunctionReturnInteger
+	LoadableFunctionReturnReal
+	LoadableFunctionReturnDecimal
+)
+
+// String implements fmt.Stringer interface.
+func (t LoadableFunctionReturnType) String() string {
+	switch t {
+	case LoadableFunctionReturnString:
+		return "STRING"
+	case LoadableFunctionReturnInteger:
+		return "INTEGER"
+	case LoadableFunctionReturnReal:
+		return "REAL"
+	case LoadableFunctionReturnDecimal:
+		return "DECIMAL"
+	}
+	return ""
+}
+
+// CreateLoadableFunctionStmt is a statement to install a loadable function from a shared library.
+// See https://dev.mysql.com/doc/refman/8.0/en/create-function-loadable.html
+type CreateLoadableFunctionStmt struct {
+	ddlNode
+
+	Aggregate   bool
+	IfNotExists bool
+	Name        model.CIStr
+	Returns     LoadableFunctionReturnType
+	SOName      string
+}
+
+// Restore implements Node interface.
+func (n *CreateLoadableFunctionStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("CREATE ")
+	if n.Aggregate {
+		ctx.WriteKeyWord("AGGREGATE ")
+	}
+	ctx.WriteKeyWord("FUNCTION ")
+	if n.IfNotExists {
+		ctx.WriteKeyWord("IF NOT EXISTS ")
+	}
+	ctx.WriteName(n.Name.O)
+	ctx.WriteKeyWord(" RETURNS ")
+	ctx.WriteKeyWord(n.Returns.String())
+	ctx.WriteKeyWord(" SONAME ")
+	ctx.WriteString(n.SOName)
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *CreateLoadableFunctionStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CreateLoadableFunctionStmt)
+	return v.Leave(n)
+}
+
+// AlterRoutineStmt is a statement to change the characteristics of a stored procedure or function.
+// See https://dev.mysql.com/doc/refman/8.0/en/alter-procedure.html
+type AlterRoutineStmt struct {
+	ddlNode
+
+	Tp              RoutineType
+	Name            *TableName
+	Characteristics []*RoutineCharacteristic
+}
+
+// Restore implements Node interface.
+func (n *AlterRoutineStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("ALTER ")
+	ctx.WriteKeyWord(n.Tp.String())
+	ctx.WritePlain(" ")
+	if err := n.Name.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore AlterRoutineStmt.Name")
+	}
+	if err := restoreRoutineCharacteristics(ctx, n.Characteristics); err != nil {
+		return errors.Annotate(err, "An error occurred while restore AlterRoutineStmt")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *AlterRoutineStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*AlterRoutineStmt)
+	return v.Leave(n)
+}
+
+// DropRoutineStmt is a statement to drop a stored procedure or function.
+// See https://dev.mysql.com/doc/refman/8.0/en/drop-procedure.html
+type DropRoutineStmt struct {
+	ddlNode
+
+	Tp       RoutineType
+	IfExists bool
+	Name     *TableName
+}
+
+// Restore implements Node interface.
+func (n *DropRoutineStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("DROP ")
+	ctx.WriteKeyWord(n.Tp.String())
+	ctx.WritePlain(" ")
+	if n.IfExists {
+		ctx.WriteKeyWord("IF EXISTS ")
+	}
+	if err := n.Name.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore DropRoutineStmt.Name")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *DropRoutineStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*DropRoutineStmt)
+	return v.Leave(n)
+}
+
+// restoreStmtList restores a statement list of a compound statement,
+// every statement is terminated by a semicolon.
+func restoreStmtList(ctx *format.RestoreCtx, stmts []StmtNode) error {
+	for i, stmt := range stmts {
+		if err := stmt.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore Stmts[%d]", i)
+		}
+		ctx.WritePlain("; ")
+	}
+	return nil
+}
+
+// acceptStmtList visits a statement list of a compound statement.
+func acceptStmtList(v Visitor, stmts []StmtNode) bool {
+	for i, stmt := range stmts {
+		node, ok := stmt.Accept(v)
+		if !ok {
+			return false
+		}
+		stmts[i] = node.(StmtNode)
+	}
+	return true
+}
+
+func restoreBeginLabel(ctx *format.RestoreCtx, label model.CIStr) {
+	if label.L != "" {
+		ctx.WriteName(label.O)
+		ctx.WritePlain(": ")
+	}
+}
+
+func restoreEndLabel(ctx *format.RestoreCtx, label model.CIStr) {
+	if label.L != "" {
+		ctx.WritePlain(" ")
+		ctx.WriteName(label.O)
+	}
+}
+
+// CompoundStmt is a BEGIN ... END block of a stored program.
+// See https://dev.mysql.com/doc/refman/8.0/en/begin-end.html
+type CompoundStmt struct {
+	stmtNode
+
+	Label model.CIStr
+	// Stmts contains the local declarations followed by the statements of the block.
+	Stmts []StmtNode
+}
+
+// Restore implements Node interface.
+func (n *CompoundStmt) Restore(ctx *format.RestoreCtx) error {
+	restoreBeginLabel(ctx, n.Label)
+	ctx.WriteKeyWord("BEGIN ")
+	if err := restoreStmtList(ctx, n.Stmts); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CompoundStmt")
+	}
+	ctx.WriteKeyWord("END")
+	restoreEndLabel(ctx, n.Label)
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *CompoundStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CompoundStmt)
+	if !acceptStmtList(v, n.Stmts) {
+		return n, false
+	}
+	return v.Leave(n)
+}
+
+// DeclareVarStmt declares local variables of a stored program.
+// See https://dev.mysql.com/doc/refman/8.0/en/declare-local-variable.html
+type DeclareVarStmt struct {
+	stmtNode
+
+	Names   []model.CIStr
+	Tp      *types.FieldType
+	Default ExprNode
+}
+
+// Restore implements Node interface.
+func (n *DeclareVarStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("DECLARE ")
+	for i, name := range n.Names {
+		if i > 0 {
+			ctx.WritePlain(",")
+		}
+		ctx.WriteName(name.O)
+	}
+	ctx.WritePlain(" ")
+	if err := n.Tp.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore DeclareVarStmt.Tp")
+	}
+	if n.Default != nil {
+		ctx.WriteKeyWord(" DEFAULT ")
+		if err := n.Default.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore DeclareVarStmt.Default")
+		}
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *DeclareVarStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*DeclareVarStmt)
+	if n.Default != nil {
+		node, ok := n.Default.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Default = node.(ExprNode)
+	}
+	return v.Leave(n)
+}
+
+// ConditionValueType is the type of a condition value.
+type ConditionValueType int
+
+// Condition value types.
+const (
+	ConditionValueErrorCode ConditionValueType = iota + 1
+	ConditionValueSQLState
+	ConditionValueName
+	ConditionValueSQLWarning
+	ConditionValueNotFound
+	ConditionValueSQLException
+)
+
+// ConditionValue is a condition a handler is activated by, or a condition is declared for.
+// See https://dev.mysql.com/doc/refman/8.0/en/declare-handler.html
+type ConditionValue struct {
+	Tp        ConditionValueType
+	ErrorCode uint64
+	SQLState  string
+	Name      model.CIStr
+}
+
+// Restore implements Node interface.
+func (n *ConditionValue) Restore(ctx *format.RestoreCtx) error {
+	switch n.Tp {
+	case ConditionValueErrorCode:
+		ctx.WritePlainf("%d", n.ErrorCode)
+	case ConditionValueSQLState:
+		ctx.WriteKeyWord("SQLSTATE ")
+		ctx.WriteString(n.SQLState)
+	case ConditionValueName:
+		ctx.WriteName(n.Name.O)
+	case ConditionValueSQLWarning:
+		ctx.WriteKeyWord("SQLWARNING")
+	case ConditionValueNotFound:
+		ctx.WriteKeyWord("NOT FOUND")
+	case ConditionValueSQLException:
+		ctx.WriteKeyWord("SQLEXCEPTION")
+	default:
+		return errors.Errorf("invalid ConditionValueType: %d", n.Tp)
+	}
+	return nil
+}
+
+// DeclareConditionStmt declares a named error condition.
+// See https://dev.mysql.com/doc/refman/8.0/en/declare-condition.html
+type DeclareConditionStmt struct {
+	stmtNode
+
+	Name model.CIStr
+	// Value is either an error code or a SQLSTATE value.
+	Value *ConditionValue
+}
+
+// Restore implements Node interface.
+func (n *DeclareConditionStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("DECLARE ")
+	ctx.WriteName(n.Name.O)
+	ctx.WriteKeyWord(" CONDITION FOR ")
+	if err := n.Value.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore DeclareConditionStmt.Value")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *DeclareConditionStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*DeclareConditionStmt)
+	return v.Leave(n)
+}
+
+// DeclareCursorStmt declares a cursor.
+// See https://dev.mysql.com/doc/refman/8.0/en/declare-cursor.html
+type DeclareCursorStmt struct {
+	stmtNode
+
+	Name   model.CIStr
+	Select ResultSetNode
+}
+
+// Restore implements Node interface.
+func (n *DeclareCursorStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("DECLARE ")
+	ctx.WriteName(n.Name.O)
+	ctx.WriteKeyWord(" CURSOR FOR ")
+	if err := n.Select.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore DeclareCursorStmt.Select")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *DeclareCursorStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*DeclareCursorStmt)
+	node, ok := n.Select.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Select = node.(ResultSetNode)
+	return v.Leave(n)
+}
+
+// HandlerAction is the action a handler takes after its statement executes.
+type HandlerAction int
+
+// Handler actions.
+const (
+	HandlerContinue HandlerAction = iota + 1
+	HandlerExit
+	HandlerUndo
+)
+
+// String implements fmt.Stringer interface.
+func (a HandlerAction) String() string {
+	switch a {
+	case HandlerContinue:
+		return "CONTINUE"
+	case HandlerExit:
+		return "EXIT"
+	case HandlerUndo:
+		return "UNDO"
+	}
+	return ""
+}
+
+// DeclareHandlerStmt declares a handler for one or more conditions.
+// See https://dev.mysql.com/doc/refman/8.0/en/declare-handler.html
+type DeclareHandlerStmt struct {
+	stmtNode
+
+	Action     HandlerAction
+	Conditions []*ConditionValue
+	Body       StmtNode
+}
+
+// Restore implements Node interface.
+func (n *DeclareHandlerStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("DECLARE ")
+	ctx.WriteKeyWord(n.Action.String())
+	ctx.WriteKeyWord(" HANDLER FOR ")
+	for i, cond := range n.Conditions {
+		if i > 0 {
+			ctx.WritePlain(",")
+		}
+		if err := cond.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore DeclareHandlerStmt.Conditions[%d]", i)
+		}
+	}
+	ctx.WritePlain(" ")
+	if err := n.Body.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore DeclareHandlerStmt.Body")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *DeclareHandlerStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*DeclareHandlerStmt)
+	node, ok := n.Body.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Body = node.(StmtNode)
+	return v.Leave(n)
+}
+
+// IfBranch is an IF or ELSEIF branch of an IF statement.
+type IfBranch struct {
+	Cond  ExprNode
+	Stmts []StmtNode
+}
+
+// IfStmt is the IF statement of stored programs.
+// See https://dev.mysql.com/doc/refman/8.0/en/if.html
+type IfStmt struct {
+	stmtNode
+
+	// Branches contains the IF branch followed by the ELSEIF branches.
+	Branches []*IfBranch
+	// Else is nil when there is no ELSE branch.
+	Else []StmtNode
+}
+
+// Restore implements Node interface.
+func (n *IfStmt) Restore(ctx *format.RestoreCtx) error {
+	for i, branch := range n.Branches {
+		if i == 0 {
+			ctx.WriteKeyWord("IF ")
+		} else {
+			ctx.WriteKeyWord("ELSEIF ")
+		}
+		if err := branch.Cond.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore IfStmt.Branches[%d].Cond", i)
+		}
+		ctx.WriteKeyWord(" THEN ")
+		if err := restoreStmtList(ctx, branch.Stmts); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore IfStmt.Branches[%d]", i)
+		}
+	}
+	if n.Else != nil {
+		ctx.WriteKeyWord("ELSE ")
+		if err := restoreStmtList(ctx, n.Else); err != nil {
+			return errors.Annotate(err, "An error occurred while restore IfStmt.Else")
+		}
+	}
+	ctx.WriteKeyWord("END IF")
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *IfStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*IfStmt)
+	for _, branch := range n.Branches {
+		node, ok := branch.Cond.Accept(v)
+		if !ok {
+			return n, false
+		}
+		branch.Cond = node.(ExprNode)
+		if !acceptStmtList(v, branch.Stmts) {
+			return n, false
+		}
+	}
+	if !acceptStmtList(v, n.Else) {
+		return n, false
+	}
+	return v.Leave(n)
+}
+
+// CaseStmtWhen is a WHEN branch of a CASE statement.
+type CaseStmtWhen struct {
+	Expr  ExprNode
+	Stmts []StmtNode
+}
+
+// CaseStmt is the CASE statement of stored programs.
+// See https://dev.mysql.com/doc/refman/8.0/en/case.html
+type CaseStmt struct {
+	stmtNode
+
+	// Value is the compare value expression, it is nil for the searched CASE statement.
+	Value ExprNode
+	Whens []*CaseStmtWhen
+	// Else is nil when there is no ELSE branch.
+	Else []StmtNode
+}
+
+// Restore implements Node interface.
+func (n *CaseStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("CASE ")
+	if n.Value != nil {
+		if err := n.Value.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore CaseStmt.Value")
+		}
+		ctx.WritePlain(" ")
+	}
+	for i, when := range n.Whens {
+		ctx.WriteKeyWord("WHEN ")
+		if err := when.Expr.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore CaseStmt.Whens[%d].Expr", i)
+		}
+		ctx.WriteKeyWord(" THEN ")
+		if err := restoreStmtList(ctx, when.Stmts); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore CaseStmt.Whens[%d]", i)
+		}
+	}
+	if n.Else != nil {
+		ctx.WriteKeyWord("ELSE ")
+		if err := restoreStmtList(ctx, n.Else); err != nil {
+			return errors.Annotate(err, "An error occurred while restore CaseStmt.Else")
+		}
+	}
+	ctx.WriteKeyWord("END CASE")
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *CaseStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CaseStmt)
+	if n.Value != nil {
+		node, ok := n.Value.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Value = node.(ExprNode)
+	}
+	for _, when := range n.Whens {
+		node, ok := when.Expr.Accept(v)
+		if !ok {
+			return n, false
+		}
+		when.Expr = node.(ExprNode)
+		if !acceptStmtList(v, when.Stmts) {
+			return n, false
+		}
+	}
+	if !acceptStmtList(v, n.Else) {
+		return n, false
+	}
+	return v.Leave(n)
+}
+
+// LoopStmt is the LOOP statement of stored programs.
+// See https://dev.mysql.com/doc/refman/8.0/en/loop.html
+type LoopStmt struct {
+	stmtNode
+
+	Label model.CIStr
+	Stmts []StmtNode
+}
+
+// Restore implements Node interface.
+func (n *LoopStmt) Restore(ctx *format.RestoreCtx) error {
+	restoreBeginLabel(ctx, n.Label)
+	ctx.WriteKeyWord("LOOP ")
+	if err := restoreStmtList(ctx, n.Stmts); err != nil {
+		return errors.Annotate(err, "An error occurred while restore LoopStmt")
+	}
+	ctx.WriteKeyWord("END LOOP")
+	restoreEndLabel(ctx, n.Label)
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *LoopStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*LoopStmt)
+	if !acceptStmtList(v, n.Stmts) {
+		return n, false
+	}
+	return v.Leave(n)
+}
+
+// WhileStmt is the WHILE statement of stored programs.
+// See https://dev.mysql.com/doc/refman/8.0/en/while.html
+type WhileStmt struct {
+	stmtNode
+
+	Label model.CIStr
+	Cond  ExprNode
+	Stmts []StmtNode
+}
+
+// Restore implements Node interface.
+func (n *WhileStmt) Restore(ctx *format.RestoreCtx) error {
+	restoreBeginLabel(ctx, n.Label)
+	ctx.WriteKeyWord("WHILE ")
+	if err := n.Cond.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore WhileStmt.Cond")
+	}
+	ctx.WriteKeyWord(" DO ")
+	if err := restoreStmtList(ctx, n.Stmts); err != nil {
+		return errors.Annotate(err, "An error occurred while restore WhileStmt")
+	}
+	ctx.WriteKeyWord("END WHILE")
+	restoreEndLabel(ctx, n.Label)
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *WhileStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*WhileStmt)
+	node, ok := n.Cond.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Cond = node.(ExprNode)
+	if !acceptStmtList(v, n.Stmts) {
+		return n, false
+	}
+	return v.Leave(n)
+}
+
+// RepeatStmt is the REPEAT statement of stored programs.
+// See https://dev.mysql.com/doc/refman/8.0/en/repeat.html
+type RepeatStmt struct {
+	stmtNode
+
+	Label model.CIStr
+	Stmts []StmtNode
+	Until ExprNode
+}
+
+// Restore implements Node interface.
+func (n *RepeatStmt) Restore(ctx *format.RestoreCtx) error {
+	restoreBeginLabel(ctx, n.Label)
+	ctx.WriteKeyWord("REPEAT ")
+	if err := restoreStmtList(ctx, n.Stmts); err != nil {
+		return errors.Annotate(err, "An error occurred while restore RepeatStmt")
+	}
+	ctx.WriteKeyWord("UNTIL ")
+	if err := n.Until.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore RepeatStmt.Until")
+	}
+	ctx.WriteKeyWord(" END REPEAT")
+	restoreEndLabel(ctx, n.Label)
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *RepeatStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*RepeatStmt)
+	if !acceptStmtList(v, n.Stmts) {
+		return n, false
+	}
+	node, ok := n.Until.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Until = node.(ExprNode)
+	return v.Leave(n)
+}
+
+// LeaveStmt exits the flow control construct that has the given label.
+// See https://dev.mysql.com/doc/refman/8.0/en/leave.html
+type LeaveStmt struct {
+	stmtNode
+
+	Label model.CIStr
+}
+
+// Restore implements Node interface.
+func (n *LeaveStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("LEAVE ")
+	ctx.WriteName(n.Label.O)
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *LeaveStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*LeaveStmt)
+	return v.Leave(n)
+}
+
+// IterateStmt starts the loop that has the given label again.
+// See https://dev.mysql.com/doc/refman/8.0/en/iterate.html
+type IterateStmt struct {
+	stmtNode
+
+	Label model.CIStr
+}
+
+// Restore implements Node interface.
+func (n *IterateStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("ITERATE ")
+	ctx.WriteName(n.Label.O)
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *IterateStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*IterateStmt)
+	return v.Leave(n)
+}
+
+// OpenCursorStmt opens a previously declared cursor.
+// See https://dev.mysql.com/doc/refman/8.0/en/open.html
+type OpenCursorStmt struct {
+	stmtNode
+
+	Name model.CIStr
+}
+
+// Restore implements Node interface.
+func (n *OpenCursorStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("OPEN ")
+	ctx.WriteName(n.Name.O)
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *OpenCursorStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*OpenCursorStmt)
+	return v.Leave(n)
+}
+
+// FetchCursorStmt fetches the next row of a cursor into local variables.
+// See https://dev.mysql.com/doc/refman/8.0/en/fetch.html
+type FetchCursorStmt struct {
+	stmtNode
+
+	Name model.CIStr
+	Into []model.CIStr
+}
+
+// Restore implements Node interface.
+func (n *FetchCursorStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("FETCH ")
+	ctx.WriteName(n.Name.O)
+	ctx.WriteKeyWord(" INTO ")
+	for i, name := range n.Into {
+		if i > 0 {
+			ctx.WritePlain(",")
+		}
+		ctx.WriteName(name.O)
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *FetchCursorStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*FetchCursorStmt)
+	return v.Leave(n)
+}
+
+// CloseCursorStmt closes a previously opened cursor.
+// See https://dev.mysql.com/doc/refman/8.0/en/close.html
+type CloseCursorStmt struct {
+	stmtNode
+
+	Name model.CIStr
+}
+
+// Restore implements Node interface.
+func (n *CloseCursorStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("CLOSE ")
+	ctx.WriteName(n.Name.O)
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *CloseCursorStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CloseCursorStmt)
+	return v.Leave(n)
+}
+
+// ReturnStmt terminates the execution of a stored function and returns a value.
+// See https://dev.mysql.com/doc/refman/8.0/en/return.html
+type ReturnStmt struct {
+	stmtNode
+
+	Expr ExprNode
+}
+
+// Restore implements Node interface.
+func (n *ReturnStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("RETURN ")
+	if err := n.Expr.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore ReturnStmt.Expr")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *ReturnStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*ReturnStmt)
+	node, ok := n.Expr.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Expr = node.(ExprNode)
+	return v.Leave(n)
+}
+
+// DiagnosticsItemName is the name of a statement or condition information item in the diagnostics area.
+// See https://dev.mysql.com/doc/refman/8.0/en/diagnostics-area.html
+type DiagnosticsItemName int
+
+// Diagnostics information item names.
+const (
+	DiagnosticsNumber DiagnosticsItemName = iota + 1
+	DiagnosticsRowCount
+	DiagnosticsClassOrigin
+	DiagnosticsSubclassOrigin
+	DiagnosticsReturnedSQLState
+	DiagnosticsMessageText
+	DiagnosticsMySQLErrno
+	DiagnosticsConstraintCatalog
+	DiagnosticsConstraintSchema
+	DiagnosticsConstraintName
+	DiagnosticsCatalogName
+	DiagnosticsSchemaName
+	DiagnosticsTableName
+	DiagnosticsColumnName
+	DiagnosticsCursorName
+)
+
+// String implements fmt.Stringer interface.
+func (n DiagnosticsItemName) String() string {
+	switch n {
+	case DiagnosticsNumber:
+		return "NUMBER"
+	case DiagnosticsRowCount:
+		return "ROW_COUNT"
+	case DiagnosticsClassOrigin:
+		return "CLASS_ORIGIN"
+	case DiagnosticsSubclassOrigin:
+		return "SUBCLASS_ORIGIN"
+	case DiagnosticsReturnedSQLState:
+		return "RETURNED_SQLSTATE"
+	case DiagnosticsMessageText:
+		return "MESSAGE_TEXT"
+	case DiagnosticsMySQLErrno:
+		return "MYSQL_ERRNO"
+	case DiagnosticsConstraintCatalog:
+		return "CONSTRAINT_CATALOG"
+	case DiagnosticsConstraintSchema:
+		return "CONSTRAINT_SCHEMA"
+	case DiagnosticsConstraintName:
+		return "CONSTRAINT_NAME"
+	case DiagnosticsCatalogName:
+		return "CATALOG_NAME"
+	case DiagnosticsSchemaName:
+		return "SCHEMA_NAME"
+	case DiagnosticsTableName:
+		return "TABLE_NAME"
+	case DiagnosticsColumnName:
+		return "COLUMN_NAME"
+	case DiagnosticsCursorName:
+		return "CURSOR_NAME"
+	}
+	return ""
+}
+
+// SignalInfoItem is a `name = value` item of the SET clause of SIGNAL and RESIGNAL.
+type SignalInfoItem struct {
+	Name  DiagnosticsItemName
+	Value ExprNode
+}
+
+func restoreSignal(ctx *format.RestoreCtx, condition *ConditionValue, items []*SignalInfoItem) error {
+	if condition != nil {
+		ctx.WritePlain(" ")
+		if err := condition.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore Condition")
+		}
+	}
+	if len(items) > 0 {
+		ctx.WriteKeyWord(" SET ")
+	}
+	for i, item := range items {
+		if i > 0 {
+			ctx.WritePlain(", ")
+		}
+		ctx.WriteKeyWord(item.Name.String())
+		ctx.WritePlain(" = ")
+		if err := item.Value.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore Items[%d].Value", i)
+		}
+	}
+	return nil
+}
+
+func acceptSignalInfoItems(v Visitor, items []*SignalInfoItem) bool {
+	for _, item := range items {
+		node, ok := item.Value.Accept(v)
+		if !ok {
+			return false
+		}
+		item.Value = node.(ExprNode)
+	}
+	return true
+}
+
+// SignalStmt is a statement to raise an error condition.
+// See https://dev.mysql.com/doc/refman/8.0/en/signal.html
+type SignalStmt struct {
+	stmtNode
+
+	// Condition is either a SQLSTATE value or a condition name.
+	Condition *ConditionValue
+	Items     []*SignalInfoItem
+}
+
+// Restore implements Node interface.
+func (n *SignalStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("SIGNAL")
+	if err := restoreSignal(ctx, n.Condition, n.Items); err != nil {
+		return errors.Annotate(err, "An error occurred while restore SignalStmt")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *SignalStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*SignalStmt)
+	if !acceptSignalInfoItems(v, n.Items) {
+		return n, false
+	}
+	return v.Leave(n)
+}
+
+// ResignalStmt is a statement to pass on the error condition a handler is handling.
+// See https://dev.mysql.com/doc/refman/8.0/en/resignal.html
+type ResignalStmt struct {
+	stmtNode
+
+	// Condition is nil when the original condition is passed on.
+	Condition *ConditionValue
+	Items     []*SignalInfoItem
+}
+
+// Restore implements Node interface.
+func (n *ResignalStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("RESIGNAL")
+	if err := restoreSignal(ctx, n.Condition, n.Items); err != nil {
+		return errors.Annotate(err, "An error occurred while restore ResignalStmt")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *ResignalStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*ResignalStmt)
+	if !acceptSignalInfoItems(v, n.Items) {
+		return n, false
+	}
+	return v.Leave(n)
+}
+
+// DiagnosticsArea is the diagnostics area GET DIAGNOSTICS reads from.
+type DiagnosticsArea int
+
+// Diagnostics areas, DiagnosticsAreaNone means the current diagnostics area is read
+// without the CURRENT keyword.
+const (
+	DiagnosticsAreaNone DiagnosticsArea = iota
+	DiagnosticsAreaCurrent
+	DiagnosticsAreaStacked
+)
+
+// DiagnosticsItem is a `target = name` item of GET DIAGNOSTICS.
+type DiagnosticsItem struct {
+	// Target is a *VariableExpr for user variables, or a *ColumnNameExpr for local variables.
+	Target ExprNode
+	Name   DiagnosticsItemName
+}
+
+// GetDiagnosticsStmt is a statement to retrieve information from the diagnostics area.
+// See https://dev.mysql.com/doc/refman/8.0/en/get-diagnostics.html
+type GetDiagnosticsStmt struct {
+	stmtNode
+
+	Area DiagnosticsArea
+	// Condition is the condition number, it is nil when statement information is retrieved.
+	Condition ExprNode
+	Items     []*DiagnosticsItem
+}
+
+// Restore implements Node interface.
+func (n *GetDiagnosticsStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("GET ")
+	switch n.Area {
+	case DiagnosticsAreaCurrent:
+		ctx.WriteKeyWord("CURRENT ")
+	case DiagnosticsAreaStacked:
+		ctx.WriteKeyWord("STACKED ")
+	}
+	ctx.WriteKeyWord("DIAGNOSTICS ")
+	if n.Condition != nil {
+		ctx.WriteKeyWord("CONDITION ")
+		if err := n.Condition.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore GetDiagnosticsStmt.Condition")
+		}
+		ctx.WritePlain(" ")
+	}
+	for i, item := range n.Items {
+		if i > 0 {
+			ctx.WritePlain(", ")
+		}
+		if err := item.Target.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore GetDiagnosticsStmt.Items[%d].Target", i)
+		}
+		ctx.WritePlain(" = ")
+		ctx.WriteKeyWord(item.Name.String())
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *GetDiagnosticsStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*GetDiagnosticsStmt)
+	if n.Condition != nil {
+		node, ok := n.Condition.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Condition = node.(ExprNode)
+	}
+	for _, item := range n.Items {
+		node, ok := item.Target.Accept(v)
+		if !ok {
+			return n, false
+		}
+		item.Target = node.(ExprNode)
+	}
+	return v.Leave(n)
+}
@@ -0,0 +1,99 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	. "github.com/arana-db/parser/ast"
+)
+
+func TestProcedureVisitorCover(t *testing.T) {
+	ce := &checkExpr{}
+	set := func() StmtNode {
+		return &SetStmt{Variables: []*VariableAssignment{{Value: ce}}}
+	}
+
+	stmts := []struct {
+		node             Node
+		expectedEnterCnt int
+		expectedLeaveCnt int
+	}{
+		{&CreateProcedureStmt{Body: set()}, 1, 1},
+		{&CreateFunctionStmt{Body: &ReturnStmt{Expr: ce}}, 1, 1},
+		{&CreateLoadableFunctionStmt{}, 0, 0},
+		{&AlterRoutineStmt{}, 0, 0},
+		{&DropRoutineStmt{}, 0, 0},
+		{&CompoundStmt{Stmts: []StmtNode{&DeclareVarStmt{Default: ce}, set()}}, 2, 2},
+		{&DeclareConditionStmt{}, 0, 0},
+		{&DeclareCursorStmt{Select: &SelectStmt{Where: ce}}, 1, 1},
+		{&DeclareHandlerStmt{Body: set()}, 1, 1},
+		{&IfStmt{Branches: []*IfBranch{{Cond: ce, Stmts: []StmtNode{set()}}, {Cond: ce}}, Else: []StmtNode{set()}}, 4, 4},
+		{&CaseStmt{Value: ce, Whens: []*CaseStmtWhen{{Expr: ce, Stmts: []StmtNode{set()}}}, Else: []StmtNode{set()}}, 4, 4},
+		{&LoopStmt{Stmts: []StmtNode{set(), set()}}, 2, 2},
+		{&WhileStmt{Cond: ce, Stmts: []StmtNode{set()}}, 2, 2},
+		{&RepeatStmt{Stmts: []StmtNode{set()}, Until: ce}, 2, 2},
+		{&LeaveStmt{}, 0, 0},
+		{&IterateStmt{}, 0, 0},
+		{&OpenCursorStmt{}, 0, 0},
+		{&FetchCursorStmt{}, 0, 0},
+		{&CloseCursorStmt{}, 0, 0},
+		{&ReturnStmt{Expr: ce}, 1, 1},
+		{&SignalStmt{Items: []*SignalInfoItem{{Value: ce}, {Value: ce}}}, 2, 2},
+		{&ResignalStmt{}, 0, 0},
+		{&GetDiagnosticsStmt{Condition: ce, Items: []*DiagnosticsItem{{Target: ce}}}, 2, 2},
+	}
+
+	for _, v := range stmts {
+		ce.reset()
+		v.node.Accept(checkVisitor{})
+		require.Equal(t, v.expectedEnterCnt, ce.enterCnt)
+		require.Equal(t, v.expectedLeaveCnt, ce.leaveCnt)
+		v.node.Accept(visitor1{})
+	}
+}
+
+func TestProcedureBodyRestore(t *testing.T) {
+	testCases := []NodeRestoreTestCase{
+		{"begin end", "BEGIN END"},
+		{"l: begin declare a int default 1; set a = a + 1; end l", "`l`: BEGIN DECLARE `a` INT DEFAULT 1; SET `a`=`a`+1; END `l`"},
+		{"begin declare c condition for sqlstate value '42000'; declare exit handler for c, 1051 begin end; end", "BEGIN DECLARE `c` CONDITION FOR SQLSTATE '42000'; DECLARE EXIT HANDLER FOR `c`,1051 BEGIN END; END"},
+		{"if a then select 1; elseif b then select 2; else select 3; end if", "IF `a` THEN SELECT 1; ELSEIF `b` THEN SELECT 2; ELSE SELECT 3; END IF"},
+		{"case a when 1 then select 1; end case", "CASE `a` WHEN 1 THEN SELECT 1; END CASE"},
+		{"l: loop leave l; end loop", "`l`: LOOP LEAVE `l`; END LOOP `l`"},
+		{"while a do iterate l; end while", "WHILE `a` DO ITERATE `l`; END WHILE"},
+		{"repeat select 1; until a end repeat", "REPEAT SELECT 1; UNTIL `a` END REPEAT"},
+	}
+	extractNodeFunc := func(node Node) Node {
+		return node.(*CreateProcedureStmt).Body
+	}
+	runNodeRestoreTest(t, testCases, "CREATE PROCEDURE p() %s", extractNodeFunc)
+}
+
+func TestSignalRestore(t *testing.T) {
+	testCases := []NodeRestoreTestCase{
+		{"signal sqlstate value '45000'", "SIGNAL SQLSTATE '45000'"},
+		{"signal c set message_text = @m, mysql_errno = 1", "SIGNAL `c` SET MESSAGE_TEXT = @`m`, MYSQL_ERRNO = 1"},
+		{"resignal", "RESIGNAL"},
+		{"resignal set table_name = t", "RESIGNAL SET TABLE_NAME = `t`"},
+		{"get diagnostics @n = number", "GET DIAGNOSTICS @`n` = NUMBER"},
+		{"get stacked diagnostics condition 1 m = message_text, s = returned_sqlstate", "GET STACKED DIAGNOSTICS CONDITION 1 `m` = MESSAGE_TEXT, `s` = RETURNED_SQLSTATE"},
+	}
+	extractNodeFunc := func(node Node) Node {
+		return node.(*CreateProcedureStmt).Body.(*CompoundStmt).Stmts[0]
+	}
+	runNodeRestoreTest(t, testCases, "CREATE PROCEDURE p() BEGIN %s; END", extractNodeFunc)
+}
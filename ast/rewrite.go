@@ -0,0 +1,216 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"github.com/pingcap/errors"
+
+	"github.com/arana-db/parser/model"
+)
+
+// KeyGenerator generates the value of the auto-increment shard key for one row,
+// e.g. a ValueExpr holding a global ID or a NEXTVAL(seq) function call.
+type KeyGenerator func() (ExprNode, error)
+
+// InjectGlobalID fills the auto-increment shard key column of an INSERT or REPLACE
+// statement with the values generated by gen. The column is added to the statement
+// if it is missing, and a NULL or DEFAULT value of the column is replaced.
+//
+//	INSERT INTO t (c) VALUES (1), (2)        => INSERT INTO t (c, id) VALUES (1, gen()), (2, gen())
+//	INSERT INTO t (id, c) VALUES (NULL, 1)   => INSERT INTO t (id, c) VALUES (gen(), 1)
+//	INSERT INTO t SET c = 1                  => INSERT INTO t SET c = 1, id = gen()
+//
+// The rows of `INSERT ... VALUES ROW(...)` are handled the same way as the VALUES lists.
+//
+// An error is returned if the values can't be injected: the values of INSERT ... SELECT
+// are produced by the SELECT, and the position of the column is unknown in VALUES
+// without a column list. A SELECT giving NULL to the column is an error as well, the
+// shards would generate conflicting values of their own.
+func InjectGlobalID(stmt *InsertStmt, column model.CIStr, gen KeyGenerator) error {
+	switch {
+	case stmt.Select != nil:
+		if sel, ok := stmt.Select.(*SelectStmt); ok && sel.Kind == SelectStmtKindValues {
+			return injectGlobalIDToRows(stmt, sel.Lists, column, gen)
+		}
+		idx := columnIndex(stmt.Columns, column)
+		if idx < 0 {
+			return errors.Errorf("can't generate values of column %s for INSERT ... SELECT, the rows are produced by the SELECT, select the column explicitly", column.O)
+		}
+		if selectsNullOrDefault(stmt.Select, idx) {
+			return errors.Errorf("can't generate values of column %s for INSERT ... SELECT, the SELECT gives NULL to the column, select the generated values explicitly", column.O)
+		}
+		// The values are given by the SELECT explicitly.
+		return nil
+	case stmt.Setlist != nil:
+		return injectGlobalIDToSetlist(stmt, column, gen)
+	default:
+		return injectGlobalIDToLists(stmt, column, gen)
+	}
+}
+
+func injectGlobalIDToSetlist(stmt *InsertStmt, column model.CIStr, gen KeyGenerator) error {
+	for _, assignment := range stmt.Setlist {
+		if assignment.Column.Name.L != column.L {
+			continue
+		}
+		if !isNullOrDefault(assignment.Expr) {
+			return nil
+		}
+		expr, err := gen()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		assignment.Expr = expr
+		return nil
+	}
+	expr, err := gen()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	stmt.Setlist = append(stmt.Setlist, &Assignment{Column: &ColumnName{Name: column}, Expr: expr})
+	return nil
+}
+
+func injectGlobalIDToLists(stmt *InsertStmt, column model.CIStr, gen KeyGenerator) error {
+	lists, err := injectGlobalIDToValues(stmt, stmt.Lists, column, gen)
+	if err != nil {
+		return err
+	}
+	stmt.Lists = lists
+	return nil
+}
+
+// injectGlobalIDToRows injects the values to the rows of `INSERT ... VALUES ROW(...)`.
+func injectGlobalIDToRows(stmt *InsertStmt, rows []*RowExpr, column model.CIStr, gen KeyGenerator) error {
+	lists := make([][]ExprNode, len(rows))
+	for i, row := range rows {
+		lists[i] = row.Values
+	}
+	lists, err := injectGlobalIDToValues(stmt, lists, column, gen)
+	if err != nil {
+		return err
+	}
+	for i, row := range rows {
+		row.Values = lists[i]
+	}
+	return nil
+}
+
+func injectGlobalIDToValues(stmt *InsertStmt, lists [][]ExprNode, column model.CIStr, gen KeyGenerator) ([][]ExprNode, error) {
+	idx := columnIndex(stmt.Columns, column)
+	if len(stmt.Columns) == 0 {
+		// `INSERT INTO t VALUES ()` uses the default values of all the columns.
+		for _, list := range lists {
+			if len(list) != 0 {
+				return nil, errors.Errorf("can't generate values of column %s for INSERT without column list, the position of the column is unknown", column.O)
+			}
+		}
+	}
+	for i, list := range lists {
+		if idx < 0 {
+			if len(list) != len(stmt.Columns) {
+				return nil, errors.Errorf("column count doesn't match value count at row %d", i+1)
+			}
+			continue
+		}
+		if idx >= len(list) {
+			return nil, errors.Errorf("column count doesn't match value count at row %d", i+1)
+		}
+	}
+
+	// Generate all the values before rewriting, the statement is unchanged on error.
+	exprs := make([]ExprNode, len(lists))
+	for i, list := range lists {
+		if idx >= 0 && !isNullOrDefault(list[idx]) {
+			continue
+		}
+		expr, err := gen()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		exprs[i] = expr
+	}
+
+	if idx < 0 {
+		stmt.Columns = append(stmt.Columns, &ColumnName{Name: column})
+	}
+	for i, expr := range exprs {
+		switch {
+		case idx < 0:
+			lists[i] = append(lists[i], expr)
+		case expr != nil:
+			lists[i][idx] = expr
+		}
+	}
+	return lists, nil
+}
+
+func columnIndex(columns []*ColumnName, column model.CIStr) int {
+	for i, col := range columns {
+		if col.Name.L == column.L {
+			return i
+		}
+	}
+	return -1
+}
+
+// selectsNullOrDefault checks whether the field at idx of any SELECT in node is
+// NULL or DEFAULT. The position is unknown after a wildcard, such a field is
+// regarded as given explicitly.
+func selectsNullOrDefault(node Node, idx int) bool {
+	switch x := node.(type) {
+	case *SelectStmt:
+		if x.Kind == SelectStmtKindValues {
+			for _, row := range x.Lists {
+				if idx < len(row.Values) && isNullOrDefault(row.Values[idx]) {
+					return true
+				}
+			}
+			return false
+		}
+		if x.Fields == nil || idx >= len(x.Fields.Fields) {
+			return false
+		}
+		for _, field := range x.Fields.Fields[:idx+1] {
+			if field.WildCard != nil {
+				return false
+			}
+		}
+		return isNullOrDefault(x.Fields.Fields[idx].Expr)
+	case *SetOprStmt:
+		return selectsNullOrDefault(x.SelectList, idx)
+	case *SetOprSelectList:
+		for _, sel := range x.Selects {
+			if selectsNullOrDefault(sel, idx) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isNullOrDefault checks whether the value is NULL or DEFAULT, the database
+// generates the auto-increment value for both of them.
+func isNullOrDefault(expr ExprNode) bool {
+	switch x := expr.(type) {
+	case *DefaultExpr:
+		return x.Name == nil
+	case ParamMarkerExpr:
+		// The value of a param marker is unknown until it is bound.
+		return false
+	case ValueExpr:
+		return x.GetValue() == nil
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/arana-db/parser"
+	. "github.com/arana-db/parser/ast"
+	. "github.com/arana-db/parser/format"
+	"github.com/arana-db/parser/model"
+	"github.com/arana-db/parser/mysql"
+	"github.com/pingcap/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInjectGlobalID(t *testing.T) {
+	testCases := []struct {
+		src    string
+		expect string
+		err    string
+	}{
+		{"insert into t (c) values (1), (2)", "INSERT INTO `t` (`c`,`id`) VALUES (1,100),(2,101)", ""},
+		{"insert into t (ID, c) values (null, 1), (5, 2), (default, 3)", "INSERT INTO `t` (`ID`,`c`) VALUES (100,1),(5,2),(101,3)", ""},
+		{"insert into t (id, c) values (?, ?)", "INSERT INTO `t` (`id`,`c`) VALUES (?,?)", ""},
+		{"insert into t (c) values (?)", "INSERT INTO `t` (`c`,`id`) VALUES (?,100)", ""},
+		{"replace into t (t.c) values (1)", "REPLACE INTO `t` (`t`.`c`,`id`) VALUES (1,100)", ""},
+		{"insert into t values (), ()", "INSERT INTO `t` (`id`) VALUES (100),(101)", ""},
+		{"insert into t set c = 1", "INSERT INTO `t` SET `c`=1,`id`=100", ""},
+		{"insert into t set id = null, c = 1", "INSERT INTO `t` SET `id`=100,`c`=1", ""},
+		{"insert into t set id = 3, c = 1", "INSERT INTO `t` SET `id`=3,`c`=1", ""},
+		{"insert into t (c, id) select c, id from t2", "INSERT INTO `t` (`c`,`id`) SELECT `c`,`id` FROM `t2`", ""},
+		{"insert into t (c, id) select c, null from t2", "", "gives NULL"},
+		{"insert into t (c, id) select c, 1 from t2 union select c, null from t3", "", "gives NULL"},
+		{"insert into t (id, c) select *, 1 from t2", "INSERT INTO `t` (`id`,`c`) SELECT *,1 FROM `t2`", ""},
+		{"insert into t (c) values row(1), row(2)", "INSERT INTO `t` (`c`,`id`) VALUES ROW(1,100), ROW(2,101)", ""},
+		{"insert into t (c, id) values row(1, null), row(2, 7)", "INSERT INTO `t` (`c`,`id`) VALUES ROW(1,100), ROW(2,7)", ""},
+		{"insert into t (c) values row(1) as new on duplicate key update c = new.c", "INSERT INTO `t` (`c`,`id`) VALUES ROW(1,100) AS `new` ON DUPLICATE KEY UPDATE `c`=`new`.`c`", ""},
+		{"insert into t values row(1, 2)", "", "without column list"},
+		{"insert into t (c) values row(1), row(1, 2)", "", "row 2"},
+		{"insert into t (c) select c from t2", "", "INSERT ... SELECT"},
+		{"insert into t select * from t2", "", "INSERT ... SELECT"},
+		{"insert into t values (1, 2)", "", "without column list"},
+		{"insert into t (c) values (1), (1, 2)", "", "row 2"},
+		{"insert into t (c, id) values (1, null), (2)", "", "row 2"},
+	}
+
+	p := parser.New()
+	for _, tc := range testCases {
+		stmt, err := p.ParseOneStmt(tc.src, "", "")
+		require.NoError(t, err, tc.src)
+		next := int64(100)
+		err = InjectGlobalID(stmt.(*InsertStmt), model.NewCIStr("id"), func() (ExprNode, error) {
+			next++
+			return NewValueExpr(next-1, mysql.DefaultCharset, mysql.DefaultCollationName), nil
+		})
+		if tc.err != "" {
+			require.Error(t, err, tc.src)
+			require.Contains(t, err.Error(), tc.err, tc.src)
+			continue
+		}
+		require.NoError(t, err, tc.src)
+		var sb strings.Builder
+		require.NoError(t, stmt.Restore(NewRestoreCtx(DefaultRestoreFlags, &sb)))
+		require.Equal(t, tc.expect, sb.String(), tc.src)
+	}
+
+	stmt, err := p.ParseOneStmt("insert into t (c) values (1)", "", "")
+	require.NoError(t, err)
+	err = InjectGlobalID(stmt.(*InsertStmt), model.NewCIStr("id"), func() (ExprNode, error) {
+		return nil, errors.New("sequence exhausted")
+	})
+	require.EqualError(t, err, "sequence exhausted")
+	require.Len(t, stmt.(*InsertStmt).Columns, 1)
+}
@@ -0,0 +1,755 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/pingcap/errors"
+
+	"github.com/arana-db/parser/format"
+	"github.com/arana-db/parser/opcode"
+)
+
+// ShardBound is a bound of ShardInterval, a nil Value means the interval is unbounded.
+type ShardBound struct {
+	// Value is a ValueExpr or a ParamMarkerExpr.
+	Value     ExprNode
+	Inclusive bool
+}
+
+// ShardInterval is an interval of the values of a shard key column.
+type ShardInterval struct {
+	Low  ShardBound
+	High ShardBound
+}
+
+// IsPoint checks whether the interval contains only one value.
+func (i ShardInterval) IsPoint() bool {
+	if i.Low.Value == nil || i.High.Value == nil || !i.Low.Inclusive || !i.High.Inclusive {
+		return false
+	}
+	cmp, ok := compareShardValues(i.Low.Value, i.High.Value)
+	return ok && cmp == 0
+}
+
+// String implements fmt.Stringer interface.
+func (i ShardInterval) String() string {
+	var sb strings.Builder
+	ctx := format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)
+	writeValue := func(v ExprNode, unbounded string) {
+		if v == nil {
+			sb.WriteString(unbounded)
+		} else if err := v.Restore(ctx); err != nil {
+			sb.WriteString("?")
+		}
+	}
+	if i.Low.Inclusive {
+		sb.WriteString("[")
+	} else {
+		sb.WriteString("(")
+	}
+	writeValue(i.Low.Value, "-inf")
+	sb.WriteString(", ")
+	writeValue(i.High.Value, "+inf")
+	if i.High.Inclusive {
+		sb.WriteString("]")
+	} else {
+		sb.WriteString(")")
+	}
+	return sb.String()
+}
+
+// ShardValueSet is the set of values a shard key column is constrained to, it is
+// a union of intervals. The set may be a superset of the actual values, but it
+// never misses one, so it is safe to route a statement by it.
+type ShardValueSet struct {
+	// Full means the column is not constrained.
+	Full bool
+	// Intervals is the union of intervals if the set is not full,
+	// the set is empty if there is no interval.
+	Intervals []ShardInterval
+}
+
+// IsEmpty checks whether no value satisfies the conditions.
+func (s *ShardValueSet) IsEmpty() bool {
+	return !s.Full && len(s.Intervals) == 0
+}
+
+// String implements fmt.Stringer interface.
+func (s *ShardValueSet) String() string {
+	if s.Full {
+		return "(-inf, +inf)"
+	}
+	if len(s.Intervals) == 0 {
+		return "empty"
+	}
+	strs := make([]string, 0, len(s.Intervals))
+	for _, i := range s.Intervals {
+		strs = append(strs, i.String())
+	}
+	return strings.Join(strs, ", ")
+}
+
+// ShardConditions maps the alias of a table, or the name of the table if it has
+// no alias, to the value sets of its shard key columns. The names are in lower case.
+type ShardConditions map[string]map[string]*ShardValueSet
+
+// ExtractShardConditions extracts the values and the value ranges the shard key columns
+// are constrained to by the WHERE clause and the inner join conditions of a SELECT, UPDATE
+// or DELETE statement. shardKeys maps a table name to its shard key columns.
+//
+// The constraints of a condition are:
+//   - `=`, `IN`, `BETWEEN`, `<`, `<=`, `>` and `>=` between a column and values,
+//     the values are literals or param markers.
+//   - the union of the constraints of its operands for OR.
+//   - the intersection of the constraints of its operands for AND.
+//   - the constraints of the columns equal to each other, for `col = col` in the WHERE
+//     clause or the ON clause of inner joins.
+//
+// The other conditions, such as NOT, functions and subqueries, don't constrain the columns.
+// Only integers and floats are compared to merge the intervals, the intervals of other values
+// and param markers are kept as they are, since the order depends on the collation, the
+// precision and the parameters.
+func ExtractShardConditions(stmt StmtNode, shardKeys map[string][]string) (ShardConditions, error) {
+	var (
+		refs  *TableRefsClause
+		where ExprNode
+	)
+	switch x := stmt.(type) {
+	case *SelectStmt:
+		refs, where = x.From, x.Where
+	case *UpdateStmt:
+		refs, where = x.TableRefs, x.Where
+	case *DeleteStmt:
+		refs, where = x.TableRefs, x.Where
+	default:
+		return nil, errors.Errorf("can't extract shard conditions from %T", stmt)
+	}
+
+	keys := make(map[string][]string, len(shardKeys))
+	for tbl, cols := range shardKeys {
+		lowerCols := make([]string, 0, len(cols))
+		for _, col := range cols {
+			lowerCols = append(lowerCols, strings.ToLower(col))
+		}
+		keys[strings.ToLower(tbl)] = lowerCols
+	}
+
+	e := &shardConditionExtractor{
+		tables:    make(map[string]string),
+		shardKeys: keys,
+	}
+	conds := splitAndConditions(where, nil)
+	if refs != nil && refs.TableRefs != nil {
+		conds = e.collectTables(refs.TableRefs, conds)
+	}
+
+	sets := make(shardColumnSets)
+	for _, cond := range conds {
+		sets = sets.intersect(e.extract(cond))
+	}
+	sets = e.propagateEqualities(conds, sets)
+
+	result := make(ShardConditions)
+	for alias, tbl := range e.tables {
+		cols, ok := keys[tbl]
+		if !ok {
+			continue
+		}
+		tblSets := make(map[string]*ShardValueSet, len(cols))
+		for _, col := range cols {
+			if set, ok := sets[shardColumn{alias, col}]; ok {
+				tblSets[col] = set
+			} else {
+				tblSets[col] = &ShardValueSet{Full: true}
+			}
+		}
+		result[alias] = tblSets
+	}
+	return result, nil
+}
+
+type shardColumn struct {
+	alias  string
+	column string
+}
+
+// shardColumnSets is the value sets of the constrained columns, the columns not
+// in it are not constrained.
+type shardColumnSets map[shardColumn]*ShardValueSet
+
+func (s shardColumnSets) intersect(other shardColumnSets) shardColumnSets {
+	if s == nil {
+		s = make(shardColumnSets, len(other))
+	}
+	for col, set := range other {
+		if origin, ok := s[col]; ok {
+			s[col] = intersectShardValueSets(origin, set)
+		} else {
+			s[col] = set
+		}
+	}
+	return s
+}
+
+func (s shardColumnSets) union(other shardColumnSets) shardColumnSets {
+	result := make(shardColumnSets)
+	for col, set := range s {
+		if otherSet, ok := other[col]; ok {
+			result[col] = unionShardValueSets(set, otherSet)
+		}
+	}
+	return result
+}
+
+type shardConditionExtractor struct {
+	// tables maps the alias of a table to the name of the table.
+	tables    map[string]string
+	shardKeys map[string][]string
+}
+
+// collectTables collects the tables in the join tree, and appends the ON conditions
+// of the inner joins to conds.
+func (e *shardConditionExtractor) collectTables(node ResultSetNode, conds []ExprNode) []ExprNode {
+	switch x := node.(type) {
+	case *Join:
+		if x.Left != nil {
+			conds = e.collectTables(x.Left, conds)
+		}
+		if x.Right != nil {
+			conds = e.collectTables(x.Right, conds)
+		}
+		// The ON conditions of outer joins don't filter the rows of the outer table.
+		if x.Tp == CrossJoin && x.On != nil {
+			conds = splitAndConditions(x.On.Expr, conds)
+		}
+	case *TableSource:
+		if tbl, ok := x.Source.(*TableName); ok {
+			alias := x.AsName.L
+			if alias == "" {
+				alias = tbl.Name.L
+			}
+			e.tables[alias] = tbl.Name.L
+		} else if join, ok := x.Source.(*Join); ok {
+			conds = e.collectTables(join, conds)
+		}
+	}
+	return conds
+}
+
+// resolveColumn finds the table of the column, an unqualified column belongs to the only
+// table, or the only table having it as a shard key.
+func (e *shardConditionExtractor) resolveColumn(expr ExprNode) (shardColumn, bool) {
+	col, ok := unwrapParentheses(expr).(*ColumnNameExpr)
+	if !ok {
+		return shardColumn{}, false
+	}
+	name := col.Name
+	if name.Table.L != "" {
+		if _, ok := e.tables[name.Table.L]; !ok {
+			return shardColumn{}, false
+		}
+		return shardColumn{name.Table.L, name.Name.L}, true
+	}
+	if len(e.tables) == 1 {
+		for alias := range e.tables {
+			return shardColumn{alias, name.Name.L}, true
+		}
+	}
+	var (
+		result shardColumn
+		found  bool
+	)
+	for alias, tbl := range e.tables {
+		for _, key := range e.shardKeys[tbl] {
+			if key != name.Name.L {
+				continue
+			}
+			if found {
+				return shardColumn{}, false
+			}
+			result, found = shardColumn{alias, name.Name.L}, true
+		}
+	}
+	return result, found
+}
+
+func (e *shardConditionExtractor) extract(expr ExprNode) shardColumnSets {
+	switch x := unwrapParentheses(expr).(type) {
+	case *BinaryOperationExpr:
+		switch x.Op {
+		case opcode.LogicAnd:
+			return e.extract(x.L).intersect(e.extract(x.R))
+		case opcode.LogicOr:
+			return e.extract(x.L).union(e.extract(x.R))
+		case opcode.EQ, opcode.LT, opcode.LE, opcode.GT, opcode.GE:
+			return e.extractComparison(x)
+		}
+	case *PatternInExpr:
+		if x.Not || x.Sel != nil {
+			break
+		}
+		col, ok := e.resolveColumn(x.Expr)
+		if !ok {
+			break
+		}
+		// Collect all the points and normalize them once, the lists may be long.
+		set := &ShardValueSet{Intervals: make([]ShardInterval, 0, len(x.List))}
+		for _, item := range x.List {
+			v, ok := shardValue(item)
+			if !ok {
+				return nil
+			}
+			if !isNullValue(v) {
+				set.Intervals = append(set.Intervals, pointShardValueSet(v).Intervals...)
+			}
+		}
+		return shardColumnSets{col: normalizeShardValueSet(set)}
+	case *BetweenExpr:
+		if x.Not {
+			break
+		}
+		col, ok := e.resolveColumn(x.Expr)
+		if !ok {
+			break
+		}
+		left, ok1 := shardValue(x.Left)
+		right, ok2 := shardValue(x.Right)
+		if !ok1 || !ok2 {
+			break
+		}
+		if isNullValue(left) || isNullValue(right) {
+			return shardColumnSets{col: &ShardValueSet{}}
+		}
+		set := &ShardValueSet{Intervals: []ShardInterval{{
+			Low:  ShardBound{Value: left, Inclusive: true},
+			High: ShardBound{Value: right, Inclusive: true},
+		}}}
+		return shardColumnSets{col: normalizeShardValueSet(set)}
+	}
+	return nil
+}
+
+func (e *shardConditionExtractor) extractComparison(expr *BinaryOperationExpr) shardColumnSets {
+	op := expr.Op
+	col, ok := e.resolveColumn(expr.L)
+	v, ok1 := shardValue(expr.R)
+	if !ok || !ok1 {
+		// Try `value op column`.
+		col, ok = e.resolveColumn(expr.R)
+		v, ok1 = shardValue(expr.L)
+		if !ok || !ok1 {
+			return nil
+		}
+		switch op {
+		case opcode.LT:
+			op = opcode.GT
+		case opcode.LE:
+			op = opcode.GE
+		case opcode.GT:
+			op = opcode.LT
+		case opcode.GE:
+			op = opcode.LE
+		}
+	}
+	if isNullValue(v) {
+		// Comparing to NULL is never true.
+		return shardColumnSets{col: &ShardValueSet{}}
+	}
+
+	var interval ShardInterval
+	switch op {
+	case opcode.EQ:
+		return shardColumnSets{col: pointShardValueSet(v)}
+	case opcode.LT, opcode.LE:
+		interval.High = ShardBound{Value: v, Inclusive: op == opcode.LE}
+	case opcode.GT, opcode.GE:
+		interval.Low = ShardBound{Value: v, Inclusive: op == opcode.GE}
+	}
+	return shardColumnSets{col: &ShardValueSet{Intervals: []ShardInterval{interval}}}
+}
+
+// propagateEqualities makes the columns equal to each other have the same value set.
+func (e *shardConditionExtractor) propagateEqualities(conds []ExprNode, sets shardColumnSets) shardColumnSets {
+	parents := make(map[shardColumn]shardColumn)
+	var find func(col shardColumn) shardColumn
+	find = func(col shardColumn) shardColumn {
+		parent, ok := parents[col]
+		if !ok || parent == col {
+			return col
+		}
+		root := find(parent)
+		parents[col] = root
+		return root
+	}
+	for _, cond := range conds {
+		expr, ok := unwrapParentheses(cond).(*BinaryOperationExpr)
+		if !ok || expr.Op != opcode.EQ {
+			continue
+		}
+		l, ok1 := e.resolveColumn(expr.L)
+		r, ok2 := e.resolveColumn(expr.R)
+		if !ok1 || !ok2 {
+			continue
+		}
+		if rootL, rootR := find(l), find(r); rootL != rootR {
+			parents[rootL] = rootR
+		}
+	}
+	if len(parents) == 0 {
+		return sets
+	}
+
+	classes := make(map[shardColumn][]shardColumn)
+	for col := range parents {
+		root := find(col)
+		if _, ok := parents[root]; !ok {
+			parents[root] = root
+			classes[root] = append(classes[root], root)
+		}
+		if col != root {
+			classes[root] = append(classes[root], col)
+		}
+	}
+	for _, cols := range classes {
+		var set *ShardValueSet
+		for _, col := range cols {
+			if s, ok := sets[col]; ok {
+				if set == nil {
+					set = s
+				} else {
+					set = intersectShardValueSets(set, s)
+				}
+			}
+		}
+		if set == nil {
+			continue
+		}
+		for _, col := range cols {
+			sets[col] = set
+		}
+	}
+	return sets
+}
+
+func splitAndConditions(expr ExprNode, conds []ExprNode) []ExprNode {
+	if expr == nil {
+		return conds
+	}
+	if x, ok := unwrapParentheses(expr).(*BinaryOperationExpr); ok && x.Op == opcode.LogicAnd {
+		conds = splitAndConditions(x.L, conds)
+		return splitAndConditions(x.R, conds)
+	}
+	return append(conds, expr)
+}
+
+func unwrapParentheses(expr ExprNode) ExprNode {
+	for {
+		p, ok := expr.(*ParenthesesExpr)
+		if !ok {
+			return expr
+		}
+		expr = p.Expr
+	}
+}
+
+// shardValue checks whether the expression is a literal or a param marker, a numeric
+// literal with unary minus, such as `-1`, is folded into a negative literal.
+func shardValue(expr ExprNode) (ExprNode, bool) {
+	expr = unwrapParentheses(expr)
+	switch x := expr.(type) {
+	case ValueExpr:
+		return expr, true
+	case *UnaryOperationExpr:
+		if x.Op != opcode.Minus {
+			break
+		}
+		v, ok := shardValue(x.V)
+		if !ok {
+			break
+		}
+		if _, ok := v.(ParamMarkerExpr); ok {
+			break
+		}
+		neg, ok := negateShardValue(v.(ValueExpr).GetValue())
+		if !ok {
+			break
+		}
+		tp := v.GetType()
+		return NewValueExpr(neg, tp.Charset, tp.Collate), true
+	}
+	return nil, false
+}
+
+// negateShardValue negates a numeric value, it returns false if the value is not
+// a number or the result is out of range.
+func negateShardValue(v interface{}) (interface{}, bool) {
+	switch x := v.(type) {
+	case int64:
+		if x == math.MinInt64 {
+			return uint64(1 << 63), true
+		}
+		return -x, true
+	case uint64:
+		if x > 1<<63 {
+			return nil, false
+		}
+		// -x wraps around, the conversion gives the negative value, 1<<63 becomes math.MinInt64.
+		return int64(-x), true
+	case float64:
+		return -x, true
+	}
+	return nil, false
+}
+
+func isNullValue(expr ExprNode) bool {
+	if _, ok := expr.(ParamMarkerExpr); ok {
+		return false
+	}
+	return expr.(ValueExpr).GetValue() == nil
+}
+
+func pointShardValueSet(v ExprNode) *ShardValueSet {
+	return &ShardValueSet{Intervals: []ShardInterval{{
+		Low:  ShardBound{Value: v, Inclusive: true},
+		High: ShardBound{Value: v, Inclusive: true},
+	}}}
+}
+
+// compareShardValues compares two values, it returns false if they are not comparable.
+func compareShardValues(a, b ExprNode) (int, bool) {
+	if a == b {
+		return 0, true
+	}
+	if _, ok := a.(ParamMarkerExpr); ok {
+		return 0, false
+	}
+	if _, ok := b.(ParamMarkerExpr); ok {
+		return 0, false
+	}
+	va, ok1 := a.(ValueExpr)
+	vb, ok2 := b.(ValueExpr)
+	if !ok1 || !ok2 {
+		return 0, false
+	}
+	switch x := va.GetValue().(type) {
+	case int64:
+		switch y := vb.GetValue().(type) {
+		case int64:
+			return compareInt64(x, y), true
+		case uint64:
+			if x < 0 {
+				return -1, true
+			}
+			return compareUint64(uint64(x), y), true
+		}
+	case uint64:
+		switch y := vb.GetValue().(type) {
+		case int64:
+			if y < 0 {
+				return 1, true
+			}
+			return compareUint64(x, uint64(y)), true
+		case uint64:
+			return compareUint64(x, y), true
+		}
+	case float64:
+		if y, ok := vb.GetValue().(float64); ok {
+			return compareFloat64(x, y), true
+		}
+	}
+	return 0, false
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareUint64(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareFloat64(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// compareShardBounds compares two bounds of the same side, an unbounded low bound is
+// less than any other low bound, and an unbounded high bound is greater than any other
+// high bound. An exclusive low bound is greater than an inclusive one on the same value.
+func compareShardBounds(a, b ShardBound, isLow bool) (int, bool) {
+	unbounded := -1
+	if !isLow {
+		unbounded = 1
+	}
+	switch {
+	case a.Value == nil && b.Value == nil:
+		return 0, true
+	case a.Value == nil:
+		return unbounded, true
+	case b.Value == nil:
+		return -unbounded, true
+	}
+	cmp, ok := compareShardValues(a.Value, b.Value)
+	if !ok || cmp != 0 || a.Inclusive == b.Inclusive {
+		return cmp, ok
+	}
+	if a.Inclusive {
+		return unbounded, true
+	}
+	return -unbounded, true
+}
+
+// intersectShardIntervals returns the intersection of two intervals, it returns false
+// if the bounds are not comparable.
+func intersectShardIntervals(a, b ShardInterval) (result ShardInterval, empty bool, ok bool) {
+	cmp, ok := compareShardBounds(a.Low, b.Low, true)
+	if !ok {
+		return result, false, false
+	}
+	result.Low = a.Low
+	if cmp < 0 {
+		result.Low = b.Low
+	}
+	cmp, ok = compareShardBounds(a.High, b.High, false)
+	if !ok {
+		return result, false, false
+	}
+	result.High = a.High
+	if cmp > 0 {
+		result.High = b.High
+	}
+	if result.Low.Value == nil || result.High.Value == nil {
+		return result, false, true
+	}
+	cmp, ok = compareShardValues(result.Low.Value, result.High.Value)
+	if !ok {
+		return result, false, false
+	}
+	empty = cmp > 0 || (cmp == 0 && !(result.Low.Inclusive && result.High.Inclusive))
+	return result, empty, true
+}
+
+func intersectShardValueSets(a, b *ShardValueSet) *ShardValueSet {
+	if a.Full {
+		return b
+	}
+	if b.Full {
+		return a
+	}
+	result := &ShardValueSet{}
+	for _, x := range a.Intervals {
+		for _, y := range b.Intervals {
+			i, empty, ok := intersectShardIntervals(x, y)
+			if !ok {
+				// Any of the sets is a superset of the intersection, use the smaller one.
+				if len(b.Intervals) < len(a.Intervals) {
+					return b
+				}
+				return a
+			}
+			if !empty {
+				result.Intervals = append(result.Intervals, i)
+			}
+		}
+	}
+	return normalizeShardValueSet(result)
+}
+
+func unionShardValueSets(a, b *ShardValueSet) *ShardValueSet {
+	if a.Full || b.Full {
+		return &ShardValueSet{Full: true}
+	}
+	intervals := make([]ShardInterval, 0, len(a.Intervals)+len(b.Intervals))
+	intervals = append(intervals, a.Intervals...)
+	intervals = append(intervals, b.Intervals...)
+	return normalizeShardValueSet(&ShardValueSet{Intervals: intervals})
+}
+
+// normalizeShardValueSet sorts the intervals and merges the overlapped ones, the
+// intervals are kept as they are if they are not comparable.
+func normalizeShardValueSet(s *ShardValueSet) *ShardValueSet {
+	intervals := make([]ShardInterval, 0, len(s.Intervals))
+	for _, i := range s.Intervals {
+		if _, empty, ok := intersectShardIntervals(i, i); !ok || !empty {
+			intervals = append(intervals, i)
+		}
+	}
+	comparable := true
+	sort.SliceStable(intervals, func(i, j int) bool {
+		cmp, ok := compareShardBounds(intervals[i].Low, intervals[j].Low, true)
+		comparable = comparable && ok
+		return ok && cmp < 0
+	})
+	if !comparable {
+		return &ShardValueSet{Intervals: s.Intervals}
+	}
+	merged := intervals[:0]
+	for _, i := range intervals {
+		if len(merged) == 0 {
+			merged = append(merged, i)
+			continue
+		}
+		last := &merged[len(merged)-1]
+		overlapped, ok := shardIntervalsConnected(*last, i)
+		if !ok {
+			return &ShardValueSet{Intervals: s.Intervals}
+		}
+		if !overlapped {
+			merged = append(merged, i)
+			continue
+		}
+		cmp, ok := compareShardBounds(last.High, i.High, false)
+		if !ok {
+			return &ShardValueSet{Intervals: s.Intervals}
+		}
+		if cmp < 0 {
+			last.High = i.High
+		}
+	}
+	return &ShardValueSet{Intervals: merged}
+}
+
+// shardIntervalsConnected checks whether the union of two intervals is an interval,
+// the low bound of a is not greater than the low bound of b.
+func shardIntervalsConnected(a, b ShardInterval) (bool, bool) {
+	if a.High.Value == nil || b.Low.Value == nil {
+		return true, true
+	}
+	cmp, ok := compareShardValues(a.High.Value, b.Low.Value)
+	if !ok {
+		return false, false
+	}
+	return cmp > 0 || (cmp == 0 && (a.High.Inclusive || b.Low.Inclusive)), true
+}
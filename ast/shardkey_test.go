@@ -0,0 +1,124 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/arana-db/parser"
+	. "github.com/arana-db/parser/ast"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractShardConditions(t *testing.T) {
+	shardKeys := map[string][]string{
+		"t":     {"uid"},
+		"Order": {"UID", "oid"},
+	}
+	testCases := []struct {
+		src    string
+		expect map[string]map[string]string
+	}{
+		{"select * from t where uid = 1", map[string]map[string]string{"t": {"uid": "[1, 1]"}}},
+		{"select * from t where 1 = uid and c = 2", map[string]map[string]string{"t": {"uid": "[1, 1]"}}},
+		{"select * from t where uid in (3, 1, 2, null)", map[string]map[string]string{"t": {"uid": "[1, 1], [2, 2], [3, 3]"}}},
+		{"select * from t where uid between 1 and 10", map[string]map[string]string{"t": {"uid": "[1, 10]"}}},
+		{"select * from t where uid > 1 and uid <= 10", map[string]map[string]string{"t": {"uid": "(1, 10]"}}},
+		{"select * from t where 5 < uid", map[string]map[string]string{"t": {"uid": "(5, +inf)"}}},
+		{"select * from t where uid < 1 or uid >= 10", map[string]map[string]string{"t": {"uid": "(-inf, 1), [10, +inf)"}}},
+		{"select * from t where uid < 5 or uid > 3", map[string]map[string]string{"t": {"uid": "(-inf, +inf)"}}},
+		{"select * from t where uid = 1 or uid = 2 and c = 3", map[string]map[string]string{"t": {"uid": "[1, 1], [2, 2]"}}},
+		{"select * from t where uid = 1 or c = 3", map[string]map[string]string{"t": {"uid": "(-inf, +inf)"}}},
+		{"select * from t where (uid = 1 or uid = 2) and uid in (2, 3)", map[string]map[string]string{"t": {"uid": "[2, 2]"}}},
+		{"select * from t where uid = 1 and uid = 2", map[string]map[string]string{"t": {"uid": "empty"}}},
+		{"select * from t where uid = null", map[string]map[string]string{"t": {"uid": "empty"}}},
+		{"select * from t where uid = ?", map[string]map[string]string{"t": {"uid": "[?, ?]"}}},
+		{"select * from t where uid in (?, ?)", map[string]map[string]string{"t": {"uid": "[?, ?], [?, ?]"}}},
+		{"select * from t where uid = 'a' or uid = 'b'", map[string]map[string]string{"t": {"uid": "[_UTF8MB4'a', _UTF8MB4'a'], [_UTF8MB4'b', _UTF8MB4'b']"}}},
+		{"select * from t where uid != 1 or not uid = 2", map[string]map[string]string{"t": {"uid": "(-inf, +inf)"}}},
+		{"select * from t where uid not in (1, 2) and uid not between 1 and 2", map[string]map[string]string{"t": {"uid": "(-inf, +inf)"}}},
+		{"select * from t where uid in (select uid from t2) and abs(uid) = 1", map[string]map[string]string{"t": {"uid": "(-inf, +inf)"}}},
+		{"select * from t where uid = -1", map[string]map[string]string{"t": {"uid": "[-1, -1]"}}},
+		{"select * from t where uid between -10 and -(1)", map[string]map[string]string{"t": {"uid": "[-10, -1]"}}},
+		{"select * from t where uid = -1 or uid = 5", map[string]map[string]string{"t": {"uid": "[-1, -1], [5, 5]"}}},
+		{"select * from t where uid in (-2, 1, -3, - -4)", map[string]map[string]string{"t": {"uid": "[-3, -3], [-2, -2], [1, 1], [4, 4]"}}},
+		{"select * from t where uid > -1.5e0 and uid < -0.5e0", map[string]map[string]string{"t": {"uid": "(-1.5e+00, -5e-01)"}}},
+		{"select * from t where uid >= -9223372036854775808 and uid <= -9223372036854775807", map[string]map[string]string{"t": {"uid": "[-9223372036854775808, -9223372036854775807]"}}},
+		{"select * from t where uid = -9223372036854775809", map[string]map[string]string{"t": {"uid": "(-inf, +inf)"}}},
+		{"select * from t where uid = -?", map[string]map[string]string{"t": {"uid": "(-inf, +inf)"}}},
+		{"select * from t where uid = -'1'", map[string]map[string]string{"t": {"uid": "(-inf, +inf)"}}},
+		{"select * from t", map[string]map[string]string{"t": {"uid": "(-inf, +inf)"}}},
+		{"select * from t a, `order` b where a.uid = b.uid and b.uid in (1, 2) and oid = 3", map[string]map[string]string{
+			"a": {"uid": "[1, 1], [2, 2]"},
+			"b": {"uid": "[1, 1], [2, 2]", "oid": "[3, 3]"},
+		}},
+		{"select * from t join `order` on t.uid = `order`.uid where t.uid > 10", map[string]map[string]string{
+			"t":     {"uid": "(10, +inf)"},
+			"order": {"uid": "(10, +inf)", "oid": "(-inf, +inf)"},
+		}},
+		{"select * from t left join `order` o on t.uid = o.uid and o.uid = 1", map[string]map[string]string{
+			"t": {"uid": "(-inf, +inf)"},
+			"o": {"uid": "(-inf, +inf)", "oid": "(-inf, +inf)"},
+		}},
+		{"select * from t, `order` where uid = 1", map[string]map[string]string{
+			"t":     {"uid": "(-inf, +inf)"},
+			"order": {"uid": "(-inf, +inf)", "oid": "(-inf, +inf)"},
+		}},
+		{"update t set c = 1 where uid in (1, 2) or uid between 2 and 4", map[string]map[string]string{"t": {"uid": "[1, 1], [2, 4]"}}},
+		{"delete from t where t.uid >= 1 and t.uid < 3", map[string]map[string]string{"t": {"uid": "[1, 3)"}}},
+		{"delete from t where uid >= 1.5 and uid < 2.5", map[string]map[string]string{"t": {"uid": "[1.5, +inf)"}}},
+		{"delete from t2 where uid = 1", map[string]map[string]string{}},
+	}
+
+	p := parser.New()
+	for _, tc := range testCases {
+		stmt, err := p.ParseOneStmt(tc.src, "", "")
+		require.NoError(t, err, tc.src)
+		conds, err := ExtractShardConditions(stmt, shardKeys)
+		require.NoError(t, err, tc.src)
+		actual := make(map[string]map[string]string, len(conds))
+		for alias, sets := range conds {
+			actual[alias] = make(map[string]string, len(sets))
+			for col, set := range sets {
+				actual[alias][col] = set.String()
+			}
+		}
+		require.Equal(t, tc.expect, actual, tc.src)
+	}
+
+	// A long IN list is normalized once.
+	values := make([]string, 20000)
+	for i := range values {
+		values[i] = strconv.Itoa(len(values) - i)
+	}
+	stmt, err := p.ParseOneStmt("select * from t where uid in ("+strings.Join(values, ",")+")", "", "")
+	require.NoError(t, err)
+	conds, err := ExtractShardConditions(stmt, shardKeys)
+	require.NoError(t, err)
+	require.Len(t, conds["t"]["uid"].Intervals, len(values))
+	require.Equal(t, "[1, 1]", conds["t"]["uid"].Intervals[0].String())
+
+	stmt, err = p.ParseOneStmt("insert into t values (1)", "", "")
+	require.NoError(t, err)
+	_, err = ExtractShardConditions(stmt, shardKeys)
+	require.Error(t, err)
+
+	stmt, err = p.ParseOneStmt("select * from t where uid = 1", "", "")
+	require.NoError(t, err)
+	conds, err = ExtractShardConditions(stmt, shardKeys)
+	require.NoError(t, err)
+	require.True(t, conds["t"]["uid"].Intervals[0].IsPoint())
+}
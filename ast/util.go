@@ -13,7 +13,11 @@
 
 package ast
 
-import "math"
+import (
+	"math"
+
+	"github.com/arana-db/parser/model"
+)
 
 // UnspecifiedSize is unspecified size.
 const (
@@ -25,9 +29,11 @@ func IsReadOnly(node Node) bool {
 	switch st := node.(type) {
 	case *SelectStmt:
 		if st.LockInfo != nil {
-			switch st.LockInfo.LockType {
-			case SelectLockForUpdate, SelectLockForUpdateNoWait, SelectLockForUpdateWaitN:
-				return false
+			for _, clause := range st.LockInfo.Clauses() {
+				switch clause.LockType {
+				case SelectLockForUpdate, SelectLockForUpdateNoWait, SelectLockForUpdateWaitN, SelectLockForUpdateSkipLocked:
+					return false
+				}
 			}
 		}
 
@@ -60,6 +66,22 @@ func IsReadOnly(node Node) bool {
 	}
 }
 
+// IsReplicationStmt checks whether the input node is a statement which controls or inspects replication,
+// such as CHANGE REPLICATION SOURCE, START REPLICA or SHOW BINLOG EVENTS.
+func IsReplicationStmt(node Node) bool {
+	switch st := node.(type) {
+	case *ChangeReplicationSourceStmt, *StartReplicaStmt, *StopReplicaStmt, *ResetReplicaStmt,
+		*ResetMasterStmt, *PurgeBinaryLogsStmt, *BinlogStmt:
+		return true
+	case *ShowStmt:
+		switch st.Tp {
+		case ShowMasterStatus, ShowReplicaStatus, ShowBinaryLogs, ShowBinlogEvents, ShowRelaylogEvents:
+			return true
+		}
+	}
+	return false
+}
+
 // readOnlyChecker checks whether a query's ast is readonly, if it satisfied
 // 1. selectstmt;
 // 2. need not to set var;
@@ -85,3 +107,73 @@ func (checker *readOnlyChecker) Enter(in Node) (out Node, skipChildren bool) {
 func (checker *readOnlyChecker) Leave(in Node) (out Node, ok bool) {
 	return in, checker.readOnly
 }
+
+// outerTableRefCollector collects the table qualifiers of the columns referenced
+// by a derived table which are not defined inside it. A qualifier is resolved
+// against the tables of its own query block and the enclosing ones, the
+// qualifiers not resolved by the outermost block are the outer references.
+type outerTableRefCollector struct {
+	scopes []*tableRefScope
+	refs   []model.CIStr
+	// outer is the indexes of the outer references in refs.
+	outer []int
+}
+
+// tableRefScope is the tables defined by a query block, and the indexes of the
+// references in it which are not resolved yet.
+type tableRefScope struct {
+	defined map[string]struct{}
+	pending []int
+}
+
+// Enter implements Visitor interface.
+func (c *outerTableRefCollector) Enter(in Node) (out Node, skipChildren bool) {
+	switch in.(type) {
+	case *SelectStmt, *SetOprStmt:
+		c.scopes = append(c.scopes, &tableRefScope{defined: make(map[string]struct{})})
+		return in, false
+	}
+	if len(c.scopes) == 0 {
+		return in, false
+	}
+	scope := c.scopes[len(c.scopes)-1]
+	switch node := in.(type) {
+	case *TableSource:
+		if node.AsName.L != "" {
+			scope.defined[node.AsName.L] = struct{}{}
+		} else if tn, ok := node.Source.(*TableName); ok {
+			scope.defined[tn.Name.L] = struct{}{}
+		}
+	case *WithClause:
+		for _, cte := range node.CTEs {
+			scope.defined[cte.Name.L] = struct{}{}
+		}
+	case *ColumnName:
+		if node.Table.L != "" {
+			scope.pending = append(scope.pending, len(c.refs))
+			c.refs = append(c.refs, node.Table)
+		}
+	}
+	return in, false
+}
+
+// Leave implements Visitor interface.
+func (c *outerTableRefCollector) Leave(in Node) (out Node, ok bool) {
+	switch in.(type) {
+	case *SelectStmt, *SetOprStmt:
+		scope := c.scopes[len(c.scopes)-1]
+		c.scopes = c.scopes[:len(c.scopes)-1]
+		for _, idx := range scope.pending {
+			if _, ok := scope.defined[c.refs[idx].L]; ok {
+				continue
+			}
+			if len(c.scopes) > 0 {
+				parent := c.scopes[len(c.scopes)-1]
+				parent.pending = append(parent.pending, idx)
+			} else {
+				c.outer = append(c.outer, idx)
+			}
+		}
+	}
+	return in, true
+}
@@ -74,6 +74,32 @@ func TestCacheable(t *testing.T) {
 	require.True(t, IsReadOnly(stmt))
 }
 
+func TestIsReplicationStmt(t *testing.T) {
+	p := parser.New()
+	for _, sql := range []string{
+		"CHANGE REPLICATION SOURCE TO SOURCE_HOST='h'",
+		"START SLAVE",
+		"STOP REPLICA SQL_THREAD",
+		"RESET REPLICA ALL",
+		"RESET MASTER",
+		"PURGE BINARY LOGS TO 'b.1'",
+		"SHOW BINARY LOGS",
+		"SHOW BINLOG EVENTS",
+		"SHOW RELAYLOG EVENTS FOR CHANNEL 'c1'",
+		"SHOW MASTER STATUS",
+		"SHOW REPLICA STATUS",
+	} {
+		stmt, err := p.ParseOneStmt(sql, "", "")
+		require.NoError(t, err, sql)
+		require.True(t, IsReplicationStmt(stmt), sql)
+	}
+	for _, sql := range []string{"SHOW TABLES", "SELECT 1", "CHANGE PUMP TO NODE_STATE ='paused' FOR NODE_ID '127.0.0.1:9090'"} {
+		stmt, err := p.ParseOneStmt(sql, "", "")
+		require.NoError(t, err, sql)
+		require.False(t, IsReplicationStmt(stmt), sql)
+	}
+}
+
 func TestUnionReadOnly(t *testing.T) {
 	selectReadOnly := &SelectStmt{}
 	selectForUpdate := &SelectStmt{
@@ -104,6 +130,14 @@ func TestUnionReadOnly(t *testing.T) {
 
 	setOprStmt.SelectList.Selects = []Node{selectReadOnly, selectForUpdate, selectForUpdateNoWait}
 	require.False(t, IsReadOnly(setOprStmt))
+
+	selectForShareThenUpdate := &SelectStmt{
+		LockInfo: &SelectLockInfo{
+			LockType: SelectLockForShare,
+			Others:   []*SelectLockInfo{{LockType: SelectLockForUpdateSkipLocked}},
+		},
+	}
+	require.False(t, IsReadOnly(selectForShareThenUpdate))
 }
 
 // CleanNodeText set the text of node and all child node empty.
@@ -118,6 +118,14 @@ import (
 	hintLimitToCop            "LIMIT_TO_COP"
 	hintForceIndex            "FORCE_INDEX"
 
+	/* Arana hint names */
+	hintRouteMaster "ROUTE_MASTER"
+	hintRouteSlave  "ROUTE_SLAVE"
+	hintShard       "SHARD"
+	hintFullScan    "FULLSCAN"
+	hintTrace       "TRACE"
+	hintShadow      "SHADOW"
+
 	/* Other keywords */
 	hintOLAP            "OLAP"
 	hintOLTP            "OLTP"
@@ -143,6 +151,7 @@ import (
 	SubqueryOptimizerHintName
 	BooleanHintName                      "name of hints which take a boolean input"
 	NullaryHintName                      "name of hints which take no input"
+	RoutingHintName                      "name of routing hints which take no input"
 	SubqueryStrategy
 	Value                                "the value in the SET_VAR() hint"
 	HintQueryType                        "query type in optimizer hint (OLAP or OLTP)"
@@ -325,6 +334,44 @@ TableOptimizerHintOpt:
 			HintData: model.NewCIStr($4),
 		}
 	}
+|	RoutingHintName '(' ')'
+	{
+		$$ = &ast.TableOptimizerHint{
+			HintName: model.NewCIStr($1),
+		}
+	}
+|	"ROUTE_SLAVE" '(' ')'
+	{
+		$$ = &ast.TableOptimizerHint{
+			HintName: model.NewCIStr($1),
+			HintData: ast.HintRouteSlave{},
+		}
+	}
+|	"ROUTE_SLAVE" '(' hintIntLit ')'
+	{
+		$$ = &ast.TableOptimizerHint{
+			HintName: model.NewCIStr($1),
+			HintData: ast.HintRouteSlave{Weight: $3},
+		}
+	}
+|	"SHARD" '(' HintTable ',' hintIntLit ',' hintIntLit ')'
+	{
+		$$ = &ast.TableOptimizerHint{
+			HintName: model.NewCIStr($1),
+			Tables:   []ast.HintTable{$3},
+			HintData: ast.HintShard{
+				DBIndex:    $5,
+				TableIndex: $7,
+			},
+		}
+	}
+|	"TRACE" '(' Value ')'
+	{
+		$$ = &ast.TableOptimizerHint{
+			HintName: model.NewCIStr($1),
+			HintData: $3,
+		}
+	}
 
 StorageOptimizerHintOpt:
 	"READ_FROM_STORAGE" '(' QueryBlockOpt HintStorageTypeAndTableList ')'
@@ -598,6 +645,11 @@ NullaryHintName:
 |	"READ_CONSISTENT_REPLICA"
 |	"IGNORE_PLAN_CACHE"
 
+RoutingHintName:
+	"ROUTE_MASTER"
+|	"FULLSCAN"
+|	"SHADOW"
+
 HintQueryType:
 	"OLAP"
 |	"OLTP"
@@ -673,6 +725,13 @@ Identifier:
 |	"USE_CASCADES"
 |	"NTH_PLAN"
 |	"FORCE_INDEX"
+/* Arana hint names */
+|	"ROUTE_MASTER"
+|	"ROUTE_SLAVE"
+|	"SHARD"
+|	"FULLSCAN"
+|	"TRACE"
+|	"SHADOW"
 /* other keywords */
 |	"OLAP"
 |	"OLTP"
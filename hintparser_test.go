@@ -1417,6 +1417,43 @@ func TestParseHint(t *testing.T) {
 				},
 			},
 		},
+		// Arana routing hints
+		{
+			input: "ROUTE_MASTER() route_slave(), ROUTE_SLAVE(3) FULLSCAN() SHADOW()",
+			output: []*ast.TableOptimizerHint{
+				{HintName: model.NewCIStr("ROUTE_MASTER")},
+				{HintName: model.NewCIStr("route_slave"), HintData: ast.HintRouteSlave{}},
+				{HintName: model.NewCIStr("ROUTE_SLAVE"), HintData: ast.HintRouteSlave{Weight: 3}},
+				{HintName: model.NewCIStr("FULLSCAN")},
+				{HintName: model.NewCIStr("SHADOW")},
+			},
+		},
+		{
+			input: "SHARD(t, 0, 12) SHARD(db.t, 1, 2) TRACE('abc-1') TRACE(42) TRACE(xid)",
+			output: []*ast.TableOptimizerHint{
+				{
+					HintName: model.NewCIStr("SHARD"),
+					Tables:   []ast.HintTable{{TableName: model.NewCIStr("t")}},
+					HintData: ast.HintShard{DBIndex: 0, TableIndex: 12},
+				},
+				{
+					HintName: model.NewCIStr("SHARD"),
+					Tables:   []ast.HintTable{{DBName: model.NewCIStr("db"), TableName: model.NewCIStr("t")}},
+					HintData: ast.HintShard{DBIndex: 1, TableIndex: 2},
+				},
+				{HintName: model.NewCIStr("TRACE"), HintData: "abc-1"},
+				{HintName: model.NewCIStr("TRACE"), HintData: "42"},
+				{HintName: model.NewCIStr("TRACE"), HintData: "xid"},
+			},
+		},
+		{
+			input: "SHARD(t, 1)",
+			errs:  []string{`Optimizer hint syntax error at line 1 `},
+		},
+		{
+			input: "ROUTE_MASTER(@qb1)",
+			errs:  []string{`Optimizer hint syntax error at line 1 `},
+		},
 	}
 
 	for _, tc := range testCases {
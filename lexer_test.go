@@ -53,7 +53,7 @@ type testLiteralValue struct {
 
 func TestSingleCharOther(t *testing.T) {
 	table := []testCaseItem{
-		{"AT", identifier},
+		{"AT", at},
 		{"?", paramMarker},
 		{"PLACEHOLDER", identifier},
 		{"=", eq},
@@ -78,10 +78,13 @@ func TestAtLeadingIdentifier(t *testing.T) {
 		{"@@global.test", doubleAtIdentifier},
 		{"@@session.test", doubleAtIdentifier},
 		{"@@local.test", doubleAtIdentifier},
+		{"@@persist.test", doubleAtIdentifier},
+		{"@@persist_only.test", doubleAtIdentifier},
 		{"@@test", doubleAtIdentifier},
 		{"@@global.`test`", doubleAtIdentifier},
 		{"@@session.`test`", doubleAtIdentifier},
 		{"@@local.`test`", doubleAtIdentifier},
+		{"@@persist.`test`", doubleAtIdentifier},
 		{"@@`test`", doubleAtIdentifier},
 	}
 	runTest(t, table)
@@ -139,677 +139,860 @@ func isInTokenMap(target string) bool {
 // tokenMap is a map of known identifiers to the parser token ID.
 // Please try to keep the map in alphabetical order.
 var tokenMap = map[string]int{
-	"ACCOUNT":                  account,
-	"ACTION":                   action,
-	"ADD":                      add,
-	"ADDDATE":                  addDate,
-	"ADMIN":                    admin,
-	"ADVISE":                   advise,
-	"AFTER":                    after,
-	"AGAINST":                  against,
-	"AGO":                      ago,
-	"ALGORITHM":                algorithm,
-	"ALL":                      all,
-	"ALTER":                    alter,
-	"ALWAYS":                   always,
-	"ANALYZE":                  analyze,
-	"AND":                      and,
-	"ANY":                      any,
-	"APPROX_COUNT_DISTINCT":    approxCountDistinct,
-	"APPROX_PERCENTILE":        approxPercentile,
-	"AS":                       as,
-	"ASC":                      asc,
-	"ASCII":                    ascii,
-	"ATTRIBUTES":               attributes,
-	"STATS_OPTIONS":            statsOptions,
-	"STATS_SAMPLE_RATE":        statsSampleRate,
-	"STATS_COL_CHOICE":         statsColChoice,
-	"STATS_COL_LIST":           statsColList,
-	"AUTO_ID_CACHE":            autoIdCache,
-	"AUTO_INCREMENT":           autoIncrement,
-	"AUTO_RANDOM":              autoRandom,
-	"AUTO_RANDOM_BASE":         autoRandomBase,
-	"AVG_ROW_LENGTH":           avgRowLength,
-	"AVG":                      avg,
-	"BACKEND":                  backend,
-	"BACKUP":                   backup,
-	"BACKUPS":                  backups,
-	"BEGIN":                    begin,
-	"BETWEEN":                  between,
-	"BERNOULLI":                bernoulli,
-	"BIGINT":                   bigIntType,
-	"BINARY":                   binaryType,
-	"BINDING":                  binding,
-	"BINDINGS":                 bindings,
-	"BINLOG":                   binlog,
-	"BIT_AND":                  bitAnd,
-	"BIT_OR":                   bitOr,
-	"BIT_XOR":                  bitXor,
-	"BIT":                      bitType,
-	"BLOB":                     blobType,
-	"BLOCK":                    block,
-	"BOOL":                     boolType,
-	"BOOLEAN":                  booleanType,
-	"BOTH":                     both,
-	"BOUND":                    bound,
-	"BRIEF":                    briefType,
-	"BTREE":                    btree,
-	"BUCKETS":                  buckets,
-	"BUILTINS":                 builtins,
-	"BY":                       by,
-	"BYTE":                     byteType,
-	"CACHE":                    cache,
-	"CALL":                     call,
-	"CANCEL":                   cancel,
-	"CAPTURE":                  capture,
-	"CARDINALITY":              cardinality,
-	"CASCADE":                  cascade,
-	"CASCADED":                 cascaded,
-	"CASE":                     caseKwd,
-	"CAST":                     cast,
-	"CAUSAL":                   causal,
-	"CHAIN":                    chain,
-	"CHANGE":                   change,
-	"CHAR":                     charType,
-	"CHARACTER":                character,
-	"CHARSET":                  charsetKwd,
-	"CHECK":                    check,
-	"CHECKPOINT":               checkpoint,
-	"CHECKSUM":                 checksum,
-	"CIPHER":                   cipher,
-	"CLEANUP":                  cleanup,
-	"CLIENT":                   client,
-	"CLIENT_ERRORS_SUMMARY":    clientErrorsSummary,
-	"CLUSTERED":                clustered,
-	"CMSKETCH":                 cmSketch,
-	"COALESCE":                 coalesce,
-	"COLLATE":                  collate,
-	"COLLATION":                collation,
-	"COLUMN_FORMAT":            columnFormat,
-	"COLUMN_STATS_USAGE":       columnStatsUsage,
-	"COLUMN":                   column,
-	"COLUMNS":                  columns,
-	"COMMENT":                  comment,
-	"COMMIT":                   commit,
-	"COMMITTED":                committed,
-	"COMPACT":                  compact,
-	"COMPRESSED":               compressed,
-	"COMPRESSION":              compression,
-	"CONCURRENCY":              concurrency,
-	"CONFIG":                   config,
-	"CONNECTION":               connection,
-	"CONSISTENCY":              consistency,
-	"CONSISTENT":               consistent,
-	"CONSTRAINT":               constraint,
-	"CONSTRAINTS":              constraints,
-	"CONTEXT":                  context,
-	"CONVERT":                  convert,
-	"COPY":                     copyKwd,
-	"CORRELATION":              correlation,
-	"CPU":                      cpu,
-	"CREATE":                   create,
-	"CROSS":                    cross,
-	"CSV_BACKSLASH_ESCAPE":     csvBackslashEscape,
-	"CSV_DELIMITER":            csvDelimiter,
-	"CSV_HEADER":               csvHeader,
-	"CSV_NOT_NULL":             csvNotNull,
-	"CSV_NULL":                 csvNull,
-	"CSV_SEPARATOR":            csvSeparator,
-	"CSV_TRIM_LAST_SEPARATORS": csvTrimLastSeparators,
-	"CURRENT_DATE":             currentDate,
-	"CURRENT_ROLE":             currentRole,
-	"CURRENT_TIME":             currentTime,
-	"CURRENT_TIMESTAMP":        currentTs,
-	"CURRENT_USER":             currentUser,
-	"CURRENT":                  current,
-	"CURTIME":                  curTime,
-	"CYCLE":                    cycle,
-	"DATA":                     data,
-	"DATABASE":                 database,
-	"DATABASES":                databases,
-	"DATE_ADD":                 dateAdd,
-	"DATE_SUB":                 dateSub,
-	"DATE":                     dateType,
-	"DATETIME":                 datetimeType,
-	"DAY_HOUR":                 dayHour,
-	"DAY_MICROSECOND":          dayMicrosecond,
-	"DAY_MINUTE":               dayMinute,
-	"DAY_SECOND":               daySecond,
-	"DAY":                      day,
-	"DDL":                      ddl,
-	"DEALLOCATE":               deallocate,
-	"DEC":                      decimalType,
-	"DECIMAL":                  decimalType,
-	"DEFAULT":                  defaultKwd,
-	"DEFINER":                  definer,
-	"DELAY_KEY_WRITE":          delayKeyWrite,
-	"DELAYED":                  delayed,
-	"DELETE":                   deleteKwd,
-	"DEPENDENCY":               dependency,
-	"DEPTH":                    depth,
-	"DESC":                     desc,
-	"DESCRIBE":                 describe,
-	"DIRECTORY":                directory,
-	"DISABLE":                  disable,
-	"DISCARD":                  discard,
-	"DISK":                     disk,
-	"DISTINCT":                 distinct,
-	"DISTINCTROW":              distinct,
-	"DIV":                      div,
-	"DO":                       do,
-	"DOT":                      dotType,
-	"DOUBLE":                   doubleType,
-	"DRAINER":                  drainer,
-	"DROP":                     drop,
-	"DUAL":                     dual,
-	"DUMP":                     dump,
-	"DUPLICATE":                duplicate,
-	"DYNAMIC":                  dynamic,
-	"ELSE":                     elseKwd,
-	"ENABLE":                   enable,
-	"ENCLOSED":                 enclosed,
-	"ENCRYPTION":               encryption,
-	"END":                      end,
-	"ENFORCED":                 enforced,
-	"ENGINE":                   engine,
-	"ENGINES":                  engines,
-	"ENUM":                     enum,
-	"ERROR":                    errorKwd,
-	"ERRORS":                   identSQLErrors,
-	"ESCAPE":                   escape,
-	"ESCAPED":                  escaped,
-	"EVENT":                    event,
-	"EVENTS":                   events,
-	"EVOLVE":                   evolve,
-	"EXACT":                    exact,
-	"EXCEPT":                   except,
-	"EXCHANGE":                 exchange,
-	"EXCLUSIVE":                exclusive,
-	"EXECUTE":                  execute,
-	"EXISTS":                   exists,
-	"EXPANSION":                expansion,
-	"EXPIRE":                   expire,
-	"EXPLAIN":                  explain,
-	"EXPR_PUSHDOWN_BLACKLIST":  exprPushdownBlacklist,
-	"EXTENDED":                 extended,
-	"EXTRACT":                  extract,
-	"FALSE":                    falseKwd,
-	"FAULTS":                   faultsSym,
-	"FETCH":                    fetch,
-	"FIELDS":                   fields,
-	"FILE":                     file,
-	"FIRST":                    first,
-	"FIXED":                    fixed,
-	"FLASHBACK":                flashback,
-	"FLOAT":                    floatType,
-	"FLUSH":                    flush,
-	"FOLLOWER":                 follower,
-	"FOLLOWERS":                followers,
-	"FOLLOWER_CONSTRAINTS":     followerConstraints,
-	"FOLLOWING":                following,
-	"FOR":                      forKwd,
-	"FORCE":                    force,
-	"FOREIGN":                  foreign,
-	"FORMAT":                   format,
-	"FROM":                     from,
-	"FULL":                     full,
-	"FULLTEXT":                 fulltext,
-	"FUNCTION":                 function,
-	"GENERAL":                  general,
-	"GENERATED":                generated,
-	"GET_FORMAT":               getFormat,
-	"GLOBAL":                   global,
-	"GRANT":                    grant,
-	"GRANTS":                   grants,
-	"GROUP_CONCAT":             groupConcat,
-	"GROUP":                    group,
-	"HASH":                     hash,
-	"HAVING":                   having,
-	"HELP":                     help,
-	"HIGH_PRIORITY":            highPriority,
-	"HISTORY":                  history,
-	"HISTOGRAM":                histogram,
-	"HOSTS":                    hosts,
-	"HOUR_MICROSECOND":         hourMicrosecond,
-	"HOUR_MINUTE":              hourMinute,
-	"HOUR_SECOND":              hourSecond,
-	"HOUR":                     hour,
-	"IDENTIFIED":               identified,
-	"IF":                       ifKwd,
-	"IGNORE":                   ignore,
-	"IMPORT":                   importKwd,
-	"IMPORTS":                  imports,
-	"IN":                       in,
-	"INCREMENT":                increment,
-	"INCREMENTAL":              incremental,
-	"INDEX":                    index,
-	"INDEXES":                  indexes,
-	"INFILE":                   infile,
-	"INNER":                    inner,
-	"INPLACE":                  inplace,
-	"INSERT_METHOD":            insertMethod,
-	"INSERT":                   insert,
-	"INSTANCE":                 instance,
-	"INSTANT":                  instant,
-	"INT":                      intType,
-	"INT1":                     int1Type,
-	"INT2":                     int2Type,
-	"INT3":                     int3Type,
-	"INT4":                     int4Type,
-	"INT8":                     int8Type,
-	"INTEGER":                  integerType,
-	"INTERNAL":                 internal,
-	"INTERSECT":                intersect,
-	"INTERVAL":                 interval,
-	"INTO":                     into,
-	"INVISIBLE":                invisible,
-	"INVOKER":                  invoker,
-	"IO":                       io,
-	"IPC":                      ipc,
-	"IS":                       is,
-	"ISOLATION":                isolation,
-	"ISSUER":                   issuer,
-	"JOB":                      job,
-	"JOBS":                     jobs,
-	"JOIN":                     join,
-	"JSON_ARRAYAGG":            jsonArrayagg,
-	"JSON_OBJECTAGG":           jsonObjectAgg,
-	"JSON":                     jsonType,
-	"KEY_BLOCK_SIZE":           keyBlockSize,
-	"KEY":                      key,
-	"KEYS":                     keys,
-	"KILL":                     kill,
-	"LABELS":                   labels,
-	"LANGUAGE":                 language,
-	"LAST_BACKUP":              lastBackup,
-	"LAST":                     last,
-	"LASTVAL":                  lastval,
-	"LEADER":                   leader,
-	"LEADER_CONSTRAINTS":       leaderConstraints,
-	"LEADING":                  leading,
-	"LEARNER":                  learner,
-	"LEARNER_CONSTRAINTS":      learnerConstraints,
-	"LEARNERS":                 learners,
-	"LEFT":                     left,
-	"LESS":                     less,
-	"LEVEL":                    level,
-	"LIKE":                     like,
-	"LIMIT":                    limit,
-	"LINEAR":                   linear,
-	"LINES":                    lines,
-	"LIST":                     list,
-	"LOAD":                     load,
-	"LOCAL":                    local,
-	"LOCALTIME":                localTime,
-	"LOCALTIMESTAMP":           localTs,
-	"LOCATION":                 location,
-	"LOCK":                     lock,
-	"LOCKED":                   locked,
-	"LOGS":                     logs,
-	"LONG":                     long,
-	"LONGBLOB":                 longblobType,
-	"LONGTEXT":                 longtextType,
-	"LOW_PRIORITY":             lowPriority,
-	"MASTER":                   master,
-	"MATCH":                    match,
-	"MAX_CONNECTIONS_PER_HOUR": maxConnectionsPerHour,
-	"MAX_IDXNUM":               max_idxnum,
-	"MAX_MINUTES":              max_minutes,
-	"MAX_QUERIES_PER_HOUR":     maxQueriesPerHour,
-	"MAX_ROWS":                 maxRows,
-	"MAX_UPDATES_PER_HOUR":     maxUpdatesPerHour,
-	"MAX_USER_CONNECTIONS":     maxUserConnections,
-	"MAX":                      max,
-	"MAXVALUE":                 maxValue,
-	"MB":                       mb,
-	"MEDIUMBLOB":               mediumblobType,
-	"MEDIUMINT":                mediumIntType,
-	"MEDIUMTEXT":               mediumtextType,
-	"MEMORY":                   memory,
-	"MERGE":                    merge,
-	"MICROSECOND":              microsecond,
-	"MIN_ROWS":                 minRows,
-	"MIN":                      min,
-	"MINUTE_MICROSECOND":       minuteMicrosecond,
-	"MINUTE_SECOND":            minuteSecond,
-	"MINUTE":                   minute,
-	"MINVALUE":                 minValue,
-	"MOD":                      mod,
-	"MODE":                     mode,
-	"MODIFY":                   modify,
-	"MONTH":                    month,
-	"NAMES":                    names,
-	"NATIONAL":                 national,
-	"NATURAL":                  natural,
-	"NCHAR":                    ncharType,
-	"NEVER":                    never,
-	"NEXT_ROW_ID":              next_row_id,
-	"NEXT":                     next,
-	"NEXTVAL":                  nextval,
-	"NO_WRITE_TO_BINLOG":       noWriteToBinLog,
-	"NO":                       no,
-	"NOCACHE":                  nocache,
-	"NOCYCLE":                  nocycle,
-	"NODE_ID":                  nodeID,
-	"NODE_STATE":               nodeState,
-	"NODEGROUP":                nodegroup,
-	"NOMAXVALUE":               nomaxvalue,
-	"NOMINVALUE":               nominvalue,
-	"NONCLUSTERED":             nonclustered,
-	"NONE":                     none,
-	"NOT":                      not,
-	"NOW":                      now,
-	"NOWAIT":                   nowait,
-	"NULL":                     null,
-	"NULLS":                    nulls,
-	"NUMERIC":                  numericType,
-	"NVARCHAR":                 nvarcharType,
-	"OF":                       of,
-	"OFF":                      off,
-	"OFFSET":                   offset,
-	"ON_DUPLICATE":             onDuplicate,
-	"ON":                       on,
-	"ONLINE":                   online,
-	"ONLY":                     only,
-	"OPEN":                     open,
-	"OPT_RULE_BLACKLIST":       optRuleBlacklist,
-	"OPTIMISTIC":               optimistic,
-	"OPTIMIZE":                 optimize,
-	"OPTION":                   option,
-	"OPTIONAL":                 optional,
-	"OPTIONALLY":               optionally,
-	"OR":                       or,
-	"ORDER":                    order,
-	"OUTER":                    outer,
-	"OUTFILE":                  outfile,
-	"PACK_KEYS":                packKeys,
-	"PAGE":                     pageSym,
-	"PARSER":                   parser,
-	"PARTIAL":                  partial,
-	"PARTITION":                partition,
-	"PARTITIONING":             partitioning,
-	"PARTITIONS":               partitions,
-	"DBPARTITION":              dbpartition,
-	"DBPARTITIONS":             dbpartitions,
-	"PASSWORD":                 password,
-	"PERCENT":                  percent,
-	"PER_DB":                   per_db,
-	"PER_TABLE":                per_table,
-	"PESSIMISTIC":              pessimistic,
-	"PLACEMENT":                placement,
-	"PLAN":                     plan,
-	"PLAN_CACHE":               planCache,
-	"PLUGINS":                  plugins,
-	"POLICY":                   policy,
-	"POSITION":                 position,
-	"PRE_SPLIT_REGIONS":        preSplitRegions,
-	"PRECEDING":                preceding,
-	"PREDICATE":                predicate,
-	"PRECISION":                precisionType,
-	"PREPARE":                  prepare,
-	"PRESERVE":                 preserve,
-	"PRIMARY":                  primary,
-	"PRIMARY_REGION":           primaryRegion,
-	"PRIVILEGES":               privileges,
-	"PROCEDURE":                procedure,
-	"PROCESS":                  process,
-	"PROCESSLIST":              processlist,
-	"PROFILE":                  profile,
-	"PROFILES":                 profiles,
-	"PROXY":                    proxy,
-	"PUMP":                     pump,
-	"PURGE":                    purge,
-	"QUARTER":                  quarter,
-	"QUERIES":                  queries,
-	"QUERY":                    query,
-	"QUICK":                    quick,
-	"RANGE":                    rangeKwd,
-	"RATE_LIMIT":               rateLimit,
-	"READ":                     read,
-	"REAL":                     realType,
-	"REBUILD":                  rebuild,
-	"RECENT":                   recent,
-	"RECOVER":                  recover,
-	"RECURSIVE":                recursive,
-	"REDUNDANT":                redundant,
-	"REFERENCES":               references,
-	"REGEXP":                   regexpKwd,
-	"REGION":                   region,
-	"REGIONS":                  regions,
-	"RELEASE":                  release,
-	"RELOAD":                   reload,
-	"REMOVE":                   remove,
-	"RENAME":                   rename,
-	"REORGANIZE":               reorganize,
-	"REPAIR":                   repair,
-	"REPEAT":                   repeat,
-	"REPEATABLE":               repeatable,
-	"REPLACE":                  replace,
-	"REPLAYER":                 replayer,
-	"REPLICA":                  replica,
-	"REPLICAS":                 replicas,
-	"REPLICATION":              replication,
-	"REQUIRE":                  require,
-	"REQUIRED":                 required,
-	"RESET":                    reset,
-	"RESPECT":                  respect,
-	"RESTART":                  restart,
-	"RESTORE":                  restore,
-	"RESTORES":                 restores,
-	"RESTRICT":                 restrict,
-	"REVERSE":                  reverse,
-	"REVOKE":                   revoke,
-	"RIGHT":                    right,
-	"RLIKE":                    rlike,
-	"ROLE":                     role,
-	"ROLLBACK":                 rollback,
-	"ROUTINE":                  routine,
-	"ROW_COUNT":                rowCount,
-	"ROW_FORMAT":               rowFormat,
-	"ROW":                      row,
-	"ROWS":                     rows,
-	"RTREE":                    rtree,
-	"RESUME":                   resume,
-	"RULES":                    rules,
-	"RUNNING":                  running,
-	"S3":                       s3,
-	"SAMPLES":                  samples,
-	"SAMPLERATE":               sampleRate,
-	"SAN":                      san,
-	"SCHEDULE":                 schedule,
-	"SCHEMA":                   database,
-	"SCHEMAS":                  databases,
-	"SECOND_MICROSECOND":       secondMicrosecond,
-	"SECOND":                   second,
-	"SECONDARY_ENGINE":         secondaryEngine,
-	"SECONDARY_LOAD":           secondaryLoad,
-	"SECONDARY_UNLOAD":         secondaryUnload,
-	"SECURITY":                 security,
-	"SELECT":                   selectKwd,
-	"SEND_CREDENTIALS_TO_TIKV": sendCredentialsToTiKV,
-	"SEPARATOR":                separator,
-	"SEQUENCE":                 sequence,
-	"TOPOLOGY":                 topology,
-	"TABLERULES":               tableRules,
-	"TABLE_RULES":              tableRules,
-	"NODES":                    nodes,
-	"SERIAL":                   serial,
-	"SERIALIZABLE":             serializable,
-	"SESSION":                  session,
-	"SET":                      set,
-	"SETVAL":                   setval,
-	"SHARD_ROW_ID_BITS":        shardRowIDBits,
-	"SHARE":                    share,
-	"SHARED":                   shared,
-	"SHARDING":                 sharding,
-	"SHOW":                     show,
-	"SHUTDOWN":                 shutdown,
-	"SIGNED":                   signed,
-	"SIMPLE":                   simple,
-	"SKIP":                     skip,
-	"SKIP_SCHEMA_FILES":        skipSchemaFiles,
-	"SLAVE":                    slave,
-	"SLOW":                     slow,
-	"SMALLINT":                 smallIntType,
-	"SNAPSHOT":                 snapshot,
-	"SOME":                     some,
-	"SOURCE":                   source,
-	"SPATIAL":                  spatial,
-	"SPLIT":                    split,
-	"SQL_BIG_RESULT":           sqlBigResult,
-	"SQL_BUFFER_RESULT":        sqlBufferResult,
-	"SQL_CACHE":                sqlCache,
-	"SQL_CALC_FOUND_ROWS":      sqlCalcFoundRows,
-	"SQL_NO_CACHE":             sqlNoCache,
-	"SQL_SMALL_RESULT":         sqlSmallResult,
-	"SQL_TSI_DAY":              sqlTsiDay,
-	"SQL_TSI_HOUR":             sqlTsiHour,
-	"SQL_TSI_MINUTE":           sqlTsiMinute,
-	"SQL_TSI_MONTH":            sqlTsiMonth,
-	"SQL_TSI_QUARTER":          sqlTsiQuarter,
-	"SQL_TSI_SECOND":           sqlTsiSecond,
-	"SQL_TSI_WEEK":             sqlTsiWeek,
-	"SQL_TSI_YEAR":             sqlTsiYear,
-	"SQL":                      sql,
-	"SSL":                      ssl,
-	"STALENESS":                staleness,
-	"START":                    start,
-	"STARTING":                 starting,
-	"STATISTICS":               statistics,
-	"STATS_AUTO_RECALC":        statsAutoRecalc,
-	"STATS_BUCKETS":            statsBuckets,
-	"STATS_EXTENDED":           statsExtended,
-	"STATS_HEALTHY":            statsHealthy,
-	"STATS_HISTOGRAMS":         statsHistograms,
-	"STATS_TOPN":               statsTopN,
-	"STATS_META":               statsMeta,
-	"HISTOGRAMS_IN_FLIGHT":     histogramsInFlight,
-	"STATS_PERSISTENT":         statsPersistent,
-	"STATS_SAMPLE_PAGES":       statsSamplePages,
-	"STATS":                    stats,
-	"STATUS":                   status,
-	"STD":                      stddevPop,
-	"STDDEV_POP":               stddevPop,
-	"STDDEV_SAMP":              stddevSamp,
-	"STDDEV":                   stddevPop,
-	"STOP":                     stop,
-	"STORAGE":                  storage,
-	"STORED":                   stored,
-	"STRAIGHT_JOIN":            straightJoin,
-	"STRICT":                   strict,
-	"STRICT_FORMAT":            strictFormat,
-	"STRONG":                   strong,
-	"SUBDATE":                  subDate,
-	"SUBJECT":                  subject,
-	"SUBPARTITION":             subpartition,
-	"SUBPARTITIONS":            subpartitions,
-	"TBPARTITION":              tbpartition,
-	"TBPARTITIONS":             tbpartitions,
-	"SUBSTR":                   substring,
-	"SUBSTRING":                substring,
-	"SUM":                      sum,
-	"SUPER":                    super,
-	"SWAPS":                    swaps,
-	"SWITCHES":                 switchesSym,
-	"SYSTEM":                   system,
-	"SYSTEM_TIME":              systemTime,
-	"TARGET":                   target,
-	"TABLE_CHECKSUM":           tableChecksum,
-	"TABLE":                    tableKwd,
-	"TABLES":                   tables,
-	"TABLESAMPLE":              tableSample,
-	"TABLESPACE":               tablespace,
-	"TELEMETRY":                telemetry,
-	"TELEMETRY_ID":             telemetryID,
-	"TEMPORARY":                temporary,
-	"TEMPTABLE":                temptable,
-	"TERMINATED":               terminated,
-	"TEXT":                     textType,
-	"THAN":                     than,
-	"THEN":                     then,
-	"TIDB":                     tidb,
-	"TIFLASH":                  tiFlash,
-	"TIKV_IMPORTER":            tikvImporter,
-	"TIME":                     timeType,
-	"TIMESTAMP":                timestampType,
-	"TIMESTAMPADD":             timestampAdd,
-	"TIMESTAMPDIFF":            timestampDiff,
-	"TINYBLOB":                 tinyblobType,
-	"TINYINT":                  tinyIntType,
-	"TINYTEXT":                 tinytextType,
-	"TLS":                      tls,
-	"TO":                       to,
-	"TOKUDB_DEFAULT":           tokudbDefault,
-	"TOKUDB_FAST":              tokudbFast,
-	"TOKUDB_LZMA":              tokudbLzma,
-	"TOKUDB_QUICKLZ":           tokudbQuickLZ,
-	"TOKUDB_SMALL":             tokudbSmall,
-	"TOKUDB_SNAPPY":            tokudbSnappy,
-	"TOKUDB_UNCOMPRESSED":      tokudbUncompressed,
-	"TOKUDB_ZLIB":              tokudbZlib,
-	"TOP":                      top,
-	"TOPN":                     topn,
-	"TRACE":                    trace,
-	"TRADITIONAL":              traditional,
-	"TRAILING":                 trailing,
-	"TRANSACTION":              transaction,
-	"TRIGGER":                  trigger,
-	"TRIGGERS":                 triggers,
-	"TRIM":                     trim,
-	"TRUE":                     trueKwd,
-	"TRUNCATE":                 truncate,
-	"TYPE":                     tp,
-	"UNBOUNDED":                unbounded,
-	"UNCOMMITTED":              uncommitted,
-	"UNDEFINED":                undefined,
-	"UNICODE":                  unicodeSym,
-	"UNION":                    union,
-	"UNIQUE":                   unique,
-	"UNKNOWN":                  unknown,
-	"UNLOCK":                   unlock,
-	"UNSIGNED":                 unsigned,
-	"UPDATE":                   update,
-	"USAGE":                    usage,
-	"USE":                      use,
-	"USER":                     user,
-	"USERS":                    users,
-	"USING":                    using,
-	"UTC_DATE":                 utcDate,
-	"UTC_TIME":                 utcTime,
-	"UTC_TIMESTAMP":            utcTimestamp,
-	"VALIDATION":               validation,
-	"VALUE":                    value,
-	"VALUES":                   values,
-	"VAR_POP":                  varPop,
-	"VAR_SAMP":                 varSamp,
-	"VARBINARY":                varbinaryType,
-	"VARCHAR":                  varcharType,
-	"VARCHARACTER":             varcharacter,
-	"VARIABLES":                variables,
-	"VARIANCE":                 varPop,
-	"VARYING":                  varying,
-	"VERBOSE":                  verboseType,
-	"VOTER":                    voter,
-	"VOTER_CONSTRAINTS":        voterConstraints,
-	"VOTERS":                   voters,
-	"VIEW":                     view,
-	"VIRTUAL":                  virtual,
-	"VISIBLE":                  visible,
-	"WARNINGS":                 warnings,
-	"WEEK":                     week,
-	"WEIGHT_STRING":            weightString,
-	"WHEN":                     when,
-	"WHERE":                    where,
-	"WIDTH":                    width,
-	"WITH":                     with,
-	"WITHOUT":                  without,
-	"WRITE":                    write,
-	"X509":                     x509,
-	"XOR":                      xor,
-	"YEAR_MONTH":               yearMonth,
-	"YEAR":                     yearType,
-	"ZEROFILL":                 zerofill,
-	"WAIT":                     wait,
+	"ACCOUNT":                                account,
+	"ACTION":                                 action,
+	"ADD":                                    add,
+	"ADDDATE":                                addDate,
+	"ADMIN":                                  admin,
+	"ADVISE":                                 advise,
+	"AFTER":                                  after,
+	"AGAINST":                                against,
+	"AGGREGATE":                              aggregate,
+	"AGO":                                    ago,
+	"ALGORITHM":                              algorithm,
+	"ALL":                                    all,
+	"ALTER":                                  alter,
+	"ALWAYS":                                 always,
+	"ANALYZE":                                analyze,
+	"AND":                                    and,
+	"ANY":                                    any,
+	"APPROX_COUNT_DISTINCT":                  approxCountDistinct,
+	"APPROX_PERCENTILE":                      approxPercentile,
+	"ARRAY":                                  array,
+	"AS":                                     as,
+	"ASC":                                    asc,
+	"ASCII":                                  ascii,
+	"ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS": assignGTIDSToAnonymousTransactions,
+	"AT":                                     at,
+	"ATTRIBUTES":                             attributes,
+	"STATS_OPTIONS":                          statsOptions,
+	"STATS_SAMPLE_RATE":                      statsSampleRate,
+	"STATS_COL_CHOICE":                       statsColChoice,
+	"STATS_COL_LIST":                         statsColList,
+	"AUTO_ID_CACHE":                          autoIdCache,
+	"AUTO_INCREMENT":                         autoIncrement,
+	"AUTO_RANDOM":                            autoRandom,
+	"AUTO_RANDOM_BASE":                       autoRandomBase,
+	"AVG_ROW_LENGTH":                         avgRowLength,
+	"AVG":                                    avg,
+	"BACKEND":                                backend,
+	"BACKUP":                                 backup,
+	"BACKUPS":                                backups,
+	"BEFORE":                                 before,
+	"BEGIN":                                  begin,
+	"BETWEEN":                                between,
+	"BERNOULLI":                              bernoulli,
+	"BIGINT":                                 bigIntType,
+	"BINARY":                                 binaryType,
+	"BINDING":                                binding,
+	"BINDINGS":                               bindings,
+	"BINLOG":                                 binlog,
+	"BIT_AND":                                bitAnd,
+	"BIT_OR":                                 bitOr,
+	"BIT_XOR":                                bitXor,
+	"BIT":                                    bitType,
+	"BLOB":                                   blobType,
+	"BLOCK":                                  block,
+	"BOOL":                                   boolType,
+	"BOOLEAN":                                booleanType,
+	"BOTH":                                   both,
+	"BOUND":                                  bound,
+	"BRIEF":                                  briefType,
+	"BTREE":                                  btree,
+	"BUCKETS":                                buckets,
+	"BUILTINS":                               builtins,
+	"BY":                                     by,
+	"BYTE":                                   byteType,
+	"CACHE":                                  cache,
+	"CALL":                                   call,
+	"CANCEL":                                 cancel,
+	"CAPTURE":                                capture,
+	"CARDINALITY":                            cardinality,
+	"CASCADE":                                cascade,
+	"CASCADED":                               cascaded,
+	"CASE":                                   caseKwd,
+	"CAST":                                   cast,
+	"CATALOG_NAME":                           catalogName,
+	"CAUSAL":                                 causal,
+	"CHAIN":                                  chain,
+	"CHANGE":                                 change,
+	"CHANNEL":                                channel,
+	"CHAR":                                   charType,
+	"CHARACTER":                              character,
+	"CHARSET":                                charsetKwd,
+	"CHECK":                                  check,
+	"CHECKPOINT":                             checkpoint,
+	"CHECKSUM":                               checksum,
+	"CIPHER":                                 cipher,
+	"CLASS_ORIGIN":                           classOrigin,
+	"CLEANUP":                                cleanup,
+	"CLIENT":                                 client,
+	"CLIENT_ERRORS_SUMMARY":                  clientErrorsSummary,
+	"CLOSE":                                  closeKwd,
+	"CLUSTERED":                              clustered,
+	"CMSKETCH":                               cmSketch,
+	"COALESCE":                               coalesce,
+	"COLLATE":                                collate,
+	"COLLATION":                              collation,
+	"COLUMN_FORMAT":                          columnFormat,
+	"COLUMN_NAME":                            columnName,
+	"COLUMN_STATS_USAGE":                     columnStatsUsage,
+	"COLUMN":                                 column,
+	"COLUMNS":                                columns,
+	"COMMENT":                                comment,
+	"COMMIT":                                 commit,
+	"COMMITTED":                              committed,
+	"COMPACT":                                compact,
+	"COMPLETION":                             completion,
+	"COMPRESSED":                             compressed,
+	"COMPRESSION":                            compression,
+	"CONCURRENCY":                            concurrency,
+	"CONDITION":                              condition,
+	"CONFIG":                                 config,
+	"CONNECTION":                             connection,
+	"CONSISTENCY":                            consistency,
+	"CONSISTENT":                             consistent,
+	"CONSTRAINT":                             constraint,
+	"CONSTRAINTS":                            constraints,
+	"CONSTRAINT_CATALOG":                     constraintCatalog,
+	"CONSTRAINT_NAME":                        constraintName,
+	"CONSTRAINT_SCHEMA":                      constraintSchema,
+	"CONTAINS":                               contains,
+	"CONTEXT":                                context,
+	"CONTINUE":                               continueKwd,
+	"CONVERT":                                convert,
+	"COPY":                                   copyKwd,
+	"CORRELATION":                            correlation,
+	"CPU":                                    cpu,
+	"CREATE":                                 create,
+	"CROSS":                                  cross,
+	"CSV_BACKSLASH_ESCAPE":                   csvBackslashEscape,
+	"CSV_DELIMITER":                          csvDelimiter,
+	"CSV_HEADER":                             csvHeader,
+	"CSV_NOT_NULL":                           csvNotNull,
+	"CSV_NULL":                               csvNull,
+	"CSV_SEPARATOR":                          csvSeparator,
+	"CSV_TRIM_LAST_SEPARATORS":               csvTrimLastSeparators,
+	"CURRENT_DATE":                           currentDate,
+	"CURRENT_ROLE":                           currentRole,
+	"CURRENT_TIME":                           currentTime,
+	"CURRENT_TIMESTAMP":                      currentTs,
+	"CURRENT_USER":                           currentUser,
+	"CURSOR":                                 cursor,
+	"CURSOR_NAME":                            cursorName,
+	"CURRENT":                                current,
+	"CURTIME":                                curTime,
+	"CYCLE":                                  cycle,
+	"DATA":                                   data,
+	"DATABASE":                               database,
+	"DATABASES":                              databases,
+	"DATABASE_ALGORITHM":                     databaseAlgorithm,
+	"DATE_ADD":                               dateAdd,
+	"DATE_SUB":                               dateSub,
+	"DATE":                                   dateType,
+	"DATETIME":                               datetimeType,
+	"DAY_HOUR":                               dayHour,
+	"DAY_MICROSECOND":                        dayMicrosecond,
+	"DAY_MINUTE":                             dayMinute,
+	"DAY_SECOND":                             daySecond,
+	"DB":                                     db,
+	"DAY":                                    day,
+	"DDL":                                    ddl,
+	"DEALLOCATE":                             deallocate,
+	"DEC":                                    decimalType,
+	"DECIMAL":                                decimalType,
+	"DECLARE":                                declare,
+	"DEFAULT":                                defaultKwd,
+	"DEFAULT_AUTH":                           defaultAuth,
+	"DEFINER":                                definer,
+	"DELAY_KEY_WRITE":                        delayKeyWrite,
+	"DELAYED":                                delayed,
+	"DELETE":                                 deleteKwd,
+	"DEPENDENCY":                             dependency,
+	"DEPTH":                                  depth,
+	"DESC":                                   desc,
+	"DESCRIBE":                               describe,
+	"DETERMINISTIC":                          deterministic,
+	"DIAGNOSTICS":                            diagnostics,
+	"DIRECTORY":                              directory,
+	"DISABLE":                                disable,
+	"DISCARD":                                discard,
+	"DISK":                                   disk,
+	"DISTINCT":                               distinct,
+	"DISTINCTROW":                            distinct,
+	"DIV":                                    div,
+	"DO":                                     do,
+	"DOT":                                    dotType,
+	"DOUBLE":                                 doubleType,
+	"DRAINER":                                drainer,
+	"DROP":                                   drop,
+	"DUAL":                                   dual,
+	"DUMP":                                   dump,
+	"DUPLICATE":                              duplicate,
+	"DYNAMIC":                                dynamic,
+	"EACH":                                   each,
+	"ELSE":                                   elseKwd,
+	"ELSEIF":                                 elseIfKwd,
+	"EMPTY":                                  emptyKwd,
+	"ENABLE":                                 enable,
+	"ENCLOSED":                               enclosed,
+	"ENCRYPTION":                             encryption,
+	"END":                                    end,
+	"ENDS":                                   ends,
+	"ENFORCED":                               enforced,
+	"ENGINE":                                 engine,
+	"ENGINES":                                engines,
+	"ENUM":                                   enum,
+	"ERROR":                                  errorKwd,
+	"ERRORS":                                 identSQLErrors,
+	"ESCAPE":                                 escape,
+	"ESCAPED":                                escaped,
+	"EVENT":                                  event,
+	"EVENTS":                                 events,
+	"EVERY":                                  every,
+	"EVOLVE":                                 evolve,
+	"EXACT":                                  exact,
+	"EXCEPT":                                 except,
+	"EXCHANGE":                               exchange,
+	"EXCLUSIVE":                              exclusive,
+	"EXECUTE":                                execute,
+	"EXISTS":                                 exists,
+	"EXIT":                                   exit,
+	"EXPANSION":                              expansion,
+	"EXPIRE":                                 expire,
+	"EXPLAIN":                                explain,
+	"EXPR_PUSHDOWN_BLACKLIST":                exprPushdownBlacklist,
+	"EXTENDED":                               extended,
+	"EXTRACT":                                extract,
+	"FALSE":                                  falseKwd,
+	"FAULTS":                                 faultsSym,
+	"FETCH":                                  fetch,
+	"FIELDS":                                 fields,
+	"FILE":                                   file,
+	"FIRST":                                  first,
+	"FIXED":                                  fixed,
+	"FLASHBACK":                              flashback,
+	"FLOAT":                                  floatType,
+	"FLUSH":                                  flush,
+	"FOLLOWER":                               follower,
+	"FOLLOWERS":                              followers,
+	"FOLLOWER_CONSTRAINTS":                   followerConstraints,
+	"FOLLOWING":                              following,
+	"FOLLOWS":                                follows,
+	"FOR":                                    forKwd,
+	"FORCE":                                  force,
+	"FOREIGN":                                foreign,
+	"FORMAT":                                 format,
+	"FOUND":                                  found,
+	"FROM":                                   from,
+	"FULL":                                   full,
+	"FULLTEXT":                               fulltext,
+	"FUNCTION":                               function,
+	"GENERAL":                                general,
+	"GENERATED":                              generated,
+	"GEOMCOLLECTION":                         geomCollection,
+	"GEOMETRY":                               geometryType,
+	"GEOMETRYCOLLECTION":                     geometryCollection,
+	"GET":                                    get,
+	"GET_FORMAT":                             getFormat,
+	"GET_MASTER_PUBLIC_KEY":                  getMasterPublicKey,
+	"GET_SOURCE_PUBLIC_KEY":                  getSourcePublicKey,
+	"GLOBAL":                                 global,
+	"GRANT":                                  grant,
+	"GRANTS":                                 grants,
+	"GROUP_CONCAT":                           groupConcat,
+	"GTID_ONLY":                              gtidOnly,
+	"HANDLER":                                handler,
+	"GROUP":                                  group,
+	"HASH":                                   hash,
+	"HAVING":                                 having,
+	"HELP":                                   help,
+	"HIGH_PRIORITY":                          highPriority,
+	"HINT":                                   hint,
+	"HISTORY":                                history,
+	"HOST":                                   host,
+	"HISTOGRAM":                              histogram,
+	"HOSTS":                                  hosts,
+	"HOUR_MICROSECOND":                       hourMicrosecond,
+	"HOUR_MINUTE":                            hourMinute,
+	"HOUR_SECOND":                            hourSecond,
+	"HOUR":                                   hour,
+	"IDENTIFIED":                             identified,
+	"IF":                                     ifKwd,
+	"IGNORE":                                 ignore,
+	"IGNORE_SERVER_IDS":                      ignoreServerIDS,
+	"IMPORT":                                 importKwd,
+	"IMPORTS":                                imports,
+	"IN":                                     in,
+	"INCREMENT":                              increment,
+	"INCREMENTAL":                            incremental,
+	"INDEX":                                  index,
+	"INDEXES":                                indexes,
+	"INFILE":                                 infile,
+	"INNER":                                  inner,
+	"INOUT":                                  inout,
+	"INPLACE":                                inplace,
+	"INSERT_METHOD":                          insertMethod,
+	"INSERT":                                 insert,
+	"INSTANCE":                               instance,
+	"INSTANT":                                instant,
+	"INT":                                    intType,
+	"INT1":                                   int1Type,
+	"INT2":                                   int2Type,
+	"INT3":                                   int3Type,
+	"INT4":                                   int4Type,
+	"INT8":                                   int8Type,
+	"INTEGER":                                integerType,
+	"INTERNAL":                               internal,
+	"INTERSECT":                              intersect,
+	"INTERVAL":                               interval,
+	"INTO":                                   into,
+	"INVISIBLE":                              invisible,
+	"INVOKER":                                invoker,
+	"IO":                                     io,
+	"IO_THREAD":                              ioThread,
+	"IPC":                                    ipc,
+	"IS":                                     is,
+	"ISOLATION":                              isolation,
+	"ISSUER":                                 issuer,
+	"ITERATE":                                iterate,
+	"JOB":                                    job,
+	"JOBS":                                   jobs,
+	"JOIN":                                   join,
+	"JSON_ARRAYAGG":                          jsonArrayagg,
+	"JSON_OBJECTAGG":                         jsonObjectAgg,
+	"JSON_TABLE":                             jsonTable,
+	"JSON":                                   jsonType,
+	"KEY_BLOCK_SIZE":                         keyBlockSize,
+	"KEY_GENERATOR":                          keyGenerator,
+	"KEY":                                    key,
+	"KEYS":                                   keys,
+	"KILL":                                   kill,
+	"LABELS":                                 labels,
+	"LANGUAGE":                               language,
+	"LAST_BACKUP":                            lastBackup,
+	"LATERAL":                                lateral,
+	"LAST":                                   last,
+	"LASTVAL":                                lastval,
+	"LEADER":                                 leader,
+	"LEADER_CONSTRAINTS":                     leaderConstraints,
+	"LEADING":                                leading,
+	"LEARNER":                                learner,
+	"LEARNER_CONSTRAINTS":                    learnerConstraints,
+	"LEAVE":                                  leave,
+	"LEARNERS":                               learners,
+	"LEFT":                                   left,
+	"LESS":                                   less,
+	"LEVEL":                                  level,
+	"LIKE":                                   like,
+	"LIMIT":                                  limit,
+	"LINEAR":                                 linear,
+	"LINES":                                  lines,
+	"LINESTRING":                             linestring,
+	"LIST":                                   list,
+	"LOAD":                                   load,
+	"LOAD_BALANCE":                           loadBalance,
+	"LOCAL":                                  local,
+	"LOCALTIME":                              localTime,
+	"LOCALTIMESTAMP":                         localTs,
+	"LOCATION":                               location,
+	"LOCK":                                   lock,
+	"LOCKED":                                 locked,
+	"LOGS":                                   logs,
+	"LONG":                                   long,
+	"LONGBLOB":                               longblobType,
+	"LONGTEXT":                               longtextType,
+	"LOOP":                                   loop,
+	"LOW_PRIORITY":                           lowPriority,
+	"MASTER":                                 master,
+	"MASTER_AUTO_POSITION":                   masterAutoPosition,
+	"MASTER_BIND":                            masterBind,
+	"MASTER_COMPRESSION_ALGORITHMS":          masterCompressionAlgorithms,
+	"MASTER_CONNECT_RETRY":                   masterConnectRetry,
+	"MASTER_DELAY":                           masterDelay,
+	"MASTER_HEARTBEAT_PERIOD":                masterHeartbeatPeriod,
+	"MASTER_HOST":                            masterHost,
+	"MASTER_LOG_FILE":                        masterLogFile,
+	"MASTER_LOG_POS":                         masterLogPos,
+	"MASTER_PASSWORD":                        masterPassword,
+	"MASTER_PORT":                            masterPort,
+	"MASTER_PUBLIC_KEY_PATH":                 masterPublicKeyPath,
+	"MASTER_RETRY_COUNT":                     masterRetryCount,
+	"MASTER_SSL":                             masterSSL,
+	"MASTER_SSL_CA":                          masterSSLCA,
+	"MASTER_SSL_CAPATH":                      masterSSLCAPATH,
+	"MASTER_SSL_CERT":                        masterSSLCert,
+	"MASTER_SSL_CIPHER":                      masterSSLCipher,
+	"MASTER_SSL_CRL":                         masterSSLCRL,
+	"MASTER_SSL_CRLPATH":                     masterSSLCRLPATH,
+	"MASTER_SSL_KEY":                         masterSSLKey,
+	"MASTER_SSL_VERIFY_SERVER_CERT":          masterSSLVerifyServerCert,
+	"MASTER_TLS_CIPHERSUITES":                masterTLSCiphersuites,
+	"MASTER_TLS_VERSION":                     masterTLSVersion,
+	"MASTER_USER":                            masterUser,
+	"MASTER_ZSTD_COMPRESSION_LEVEL":          masterZstdCompressionLevel,
+	"MATCH":                                  match,
+	"MAX_CONNECTIONS_PER_HOUR":               maxConnectionsPerHour,
+	"MAX_IDXNUM":                             max_idxnum,
+	"MAX_MINUTES":                            max_minutes,
+	"MAX_QUERIES_PER_HOUR":                   maxQueriesPerHour,
+	"MAX_ROWS":                               maxRows,
+	"MAX_UPDATES_PER_HOUR":                   maxUpdatesPerHour,
+	"MAX_USER_CONNECTIONS":                   maxUserConnections,
+	"MAX":                                    max,
+	"MAXVALUE":                               maxValue,
+	"MB":                                     mb,
+	"MEDIUMBLOB":                             mediumblobType,
+	"MEDIUMINT":                              mediumIntType,
+	"MEDIUMTEXT":                             mediumtextType,
+	"MEMBER":                                 member,
+	"MEMORY":                                 memory,
+	"MERGE":                                  merge,
+	"MESSAGE_TEXT":                           messageText,
+	"MICROSECOND":                            microsecond,
+	"MIGRATE":                                migrate,
+	"MIN_ROWS":                               minRows,
+	"MIN":                                    min,
+	"MINUTE_MICROSECOND":                     minuteMicrosecond,
+	"MINUTE_SECOND":                          minuteSecond,
+	"MINUTE":                                 minute,
+	"MINVALUE":                               minValue,
+	"MOD":                                    mod,
+	"MODE":                                   mode,
+	"MODIFIES":                               modifies,
+	"MODIFY":                                 modify,
+	"MONTH":                                  month,
+	"MULTILINESTRING":                        multilinestring,
+	"MULTIPOINT":                             multipoint,
+	"MULTIPOLYGON":                           multipolygon,
+	"MYSQL_ERRNO":                            mysqlErrno,
+	"NAMES":                                  names,
+	"NATIONAL":                               national,
+	"NATURAL":                                natural,
+	"NCHAR":                                  ncharType,
+	"NESTED":                                 nested,
+	"NETWORK_NAMESPACE":                      networkNamespace,
+	"NEVER":                                  never,
+	"NEXT_ROW_ID":                            next_row_id,
+	"NEXT":                                   next,
+	"NEXTVAL":                                nextval,
+	"NO_WRITE_TO_BINLOG":                     noWriteToBinLog,
+	"NO":                                     no,
+	"NOCACHE":                                nocache,
+	"NOCYCLE":                                nocycle,
+	"NODE":                                   node,
+	"NODE_ID":                                nodeID,
+	"NODE_STATE":                             nodeState,
+	"NODEGROUP":                              nodegroup,
+	"NOMAXVALUE":                             nomaxvalue,
+	"NOMINVALUE":                             nominvalue,
+	"NONCLUSTERED":                           nonclustered,
+	"NONE":                                   none,
+	"NOT":                                    not,
+	"NOW":                                    now,
+	"NOWAIT":                                 nowait,
+	"NULL":                                   null,
+	"NULLS":                                  nulls,
+	"NUMBER":                                 number,
+	"NUMERIC":                                numericType,
+	"NVARCHAR":                               nvarcharType,
+	"OF":                                     of,
+	"OFF":                                    off,
+	"OFFSET":                                 offset,
+	"ON_DUPLICATE":                           onDuplicate,
+	"ON":                                     on,
+	"ONE":                                    one,
+	"ONLINE":                                 online,
+	"ONLY":                                   only,
+	"OPEN":                                   open,
+	"OPT_RULE_BLACKLIST":                     optRuleBlacklist,
+	"OPTIMISTIC":                             optimistic,
+	"OPTIMIZE":                               optimize,
+	"OPTION":                                 option,
+	"OPTIONAL":                               optional,
+	"OPTIONALLY":                             optionally,
+	"OR":                                     or,
+	"ORDER":                                  order,
+	"ORDINALITY":                             ordinality,
+	"OUT":                                    out,
+	"OUTER":                                  outer,
+	"OUTFILE":                                outfile,
+	"PACK_KEYS":                              packKeys,
+	"PAGE":                                   pageSym,
+	"PARSER":                                 parser,
+	"PARTIAL":                                partial,
+	"PARTITION":                              partition,
+	"PARTITIONING":                           partitioning,
+	"PARTITIONS":                             partitions,
+	"DBPARTITION":                            dbpartition,
+	"DBPARTITIONS":                           dbpartitions,
+	"PASSWORD":                               password,
+	"PATH":                                   pathKwd,
+	"PERCENT":                                percent,
+	"PERSIST":                                persist,
+	"PERSIST_ONLY":                           persistOnly,
+	"PER_DB":                                 per_db,
+	"PER_TABLE":                              per_table,
+	"PESSIMISTIC":                            pessimistic,
+	"PHASE":                                  phase,
+	"PLACEMENT":                              placement,
+	"PLAN":                                   plan,
+	"PLAN_CACHE":                             planCache,
+	"PLUGINS":                                plugins,
+	"PLUGIN_DIR":                             pluginDir,
+	"POINT":                                  point,
+	"POLICY":                                 policy,
+	"POLYGON":                                polygon,
+	"PORT":                                   port,
+	"POSITION":                               position,
+	"PRECEDES":                               precedes,
+	"PRE_SPLIT_REGIONS":                      preSplitRegions,
+	"PRECEDING":                              preceding,
+	"PREDICATE":                              predicate,
+	"PRECISION":                              precisionType,
+	"PREPARE":                                prepare,
+	"PRESERVE":                               preserve,
+	"PREVIEW":                                preview,
+	"PRIMARY":                                primary,
+	"PRIMARY_REGION":                         primaryRegion,
+	"PRIVILEGES":                             privileges,
+	"PRIVILEGE_CHECKS_USER":                  privilegeChecksUser,
+	"PROCEDURE":                              procedure,
+	"PROCESS":                                process,
+	"PROCESSLIST":                            processlist,
+	"PROFILE":                                profile,
+	"PROFILES":                               profiles,
+	"PROPERTIES":                             properties,
+	"PROXY":                                  proxy,
+	"PUMP":                                   pump,
+	"PURGE":                                  purge,
+	"QUARTER":                                quarter,
+	"QUERIES":                                queries,
+	"QUERY":                                  query,
+	"QUICK":                                  quick,
+	"RANGE":                                  rangeKwd,
+	"RATE_LIMIT":                             rateLimit,
+	"READ":                                   read,
+	"READS":                                  reads,
+	"REAL":                                   realType,
+	"REBUILD":                                rebuild,
+	"RECENT":                                 recent,
+	"RECOVER":                                recover,
+	"RECURSIVE":                              recursive,
+	"REDUNDANT":                              redundant,
+	"REFERENCES":                             references,
+	"REGEXP":                                 regexpKwd,
+	"REGION":                                 region,
+	"REGIONS":                                regions,
+	"RELAYLOG":                               relaylog,
+	"RELAY_LOG_FILE":                         relayLogFile,
+	"RELAY_LOG_POS":                          relayLogPos,
+	"RELAY_THREAD":                           relayThread,
+	"RELEASE":                                release,
+	"RELOAD":                                 reload,
+	"REMOVE":                                 remove,
+	"RENAME":                                 rename,
+	"REORGANIZE":                             reorganize,
+	"REPAIR":                                 repair,
+	"REPEAT":                                 repeat,
+	"REPEATABLE":                             repeatable,
+	"REPLACE":                                replace,
+	"REPLAYER":                               replayer,
+	"REPLICA":                                replica,
+	"REPLICAS":                               replicas,
+	"REPLICATION":                            replication,
+	"REQUIRE":                                require,
+	"REQUIRED":                               required,
+	"REQUIRE_ROW_FORMAT":                     requireRowFormat,
+	"REQUIRE_TABLE_PRIMARY_KEY_CHECK":        requireTablePrimaryKeyCheck,
+	"RESET":                                  reset,
+	"RESIGNAL":                               resignal,
+	"RESPECT":                                respect,
+	"RESTART":                                restart,
+	"RESTORE":                                restore,
+	"RESTORES":                               restores,
+	"RESTRICT":                               restrict,
+	"REVERSE":                                reverse,
+	"REVOKE":                                 revoke,
+	"RIGHT":                                  right,
+	"RLIKE":                                  rlike,
+	"ROLE":                                   role,
+	"ROLLBACK":                               rollback,
+	"ROUTINE":                                routine,
+	"ROW_COUNT":                              rowCount,
+	"ROW_FORMAT":                             rowFormat,
+	"ROW":                                    row,
+	"ROWS":                                   rows,
+	"RTREE":                                  rtree,
+	"RULE":                                   rule,
+	"RESUME":                                 resume,
+	"RETURN":                                 returnKwd,
+	"RETURNED_SQLSTATE":                      returnedSQLState,
+	"RETURNS":                                returns,
+	"RULES":                                  rules,
+	"RUNNING":                                running,
+	"S3":                                     s3,
+	"SAMPLES":                                samples,
+	"SAMPLERATE":                             sampleRate,
+	"SAN":                                    san,
+	"SAVEPOINT":                              savepoint,
+	"SCHEDULE":                               schedule,
+	"SCHEMA":                                 database,
+	"SCHEMAS":                                databases,
+	"SCHEMA_NAME":                            schemaName,
+	"SECOND_MICROSECOND":                     secondMicrosecond,
+	"SECOND":                                 second,
+	"SECONDARY_ENGINE":                       secondaryEngine,
+	"SECONDARY_LOAD":                         secondaryLoad,
+	"SECONDARY_UNLOAD":                       secondaryUnload,
+	"SECURITY":                               security,
+	"SEGMENT":                                segment,
+	"SELECT":                                 selectKwd,
+	"SEND_CREDENTIALS_TO_TIKV":               sendCredentialsToTiKV,
+	"SEPARATOR":                              separator,
+	"SEQUENCE":                               sequence,
+	"SEQUENCES":                              sequences,
+	"TOPOLOGY":                               topology,
+	"TABLERULES":                             tableRules,
+	"TABLE_RULES":                            tableRules,
+	"NODES":                                  nodes,
+	"SERIAL":                                 serial,
+	"SERIALIZABLE":                           serializable,
+	"SESSION":                                session,
+	"SET":                                    set,
+	"SETVAL":                                 setval,
+	"SHADOW":                                 shadow,
+	"SHADOW_GROUP":                           shadowGroup,
+	"SHARD_ROW_ID_BITS":                      shardRowIDBits,
+	"SHARE":                                  share,
+	"SHARED":                                 shared,
+	"SHARDING":                               sharding,
+	"SHARDING_COLUMNS":                       shardingColumns,
+	"SHOW":                                   show,
+	"SHUTDOWN":                               shutdown,
+	"SIGNAL":                                 signal,
+	"SIGNED":                                 signed,
+	"SIMPLE":                                 simple,
+	"SKIP":                                   skip,
+	"SKIP_SCHEMA_FILES":                      skipSchemaFiles,
+	"SLAVE":                                  slave,
+	"SLOW":                                   slow,
+	"SMALLINT":                               smallIntType,
+	"SNAPSHOT":                               snapshot,
+	"SNOWFLAKE":                              snowflake,
+	"SOME":                                   some,
+	"SONAME":                                 soname,
+	"SOURCE":                                 source,
+	"SOURCE_AUTO_POSITION":                   sourceAutoPosition,
+	"SOURCE_BIND":                            sourceBind,
+	"SOURCE_COMPRESSION_ALGORITHMS":          sourceCompressionAlgorithms,
+	"SOURCE_CONNECTION_AUTO_FAILOVER":        sourceConnectionAutoFailover,
+	"SOURCE_CONNECT_RETRY":                   sourceConnectRetry,
+	"SOURCE_DELAY":                           sourceDelay,
+	"SOURCE_HEARTBEAT_PERIOD":                sourceHeartbeatPeriod,
+	"SOURCE_HOST":                            sourceHost,
+	"SOURCE_LOG_FILE":                        sourceLogFile,
+	"SOURCE_LOG_POS":                         sourceLogPos,
+	"SOURCE_PASSWORD":                        sourcePassword,
+	"SOURCE_PORT":                            sourcePort,
+	"SOURCE_PUBLIC_KEY_PATH":                 sourcePublicKeyPath,
+	"SOURCE_RETRY_COUNT":                     sourceRetryCount,
+	"SOURCE_SSL":                             sourceSSL,
+	"SOURCE_SSL_CA":                          sourceSSLCA,
+	"SOURCE_SSL_CAPATH":                      sourceSSLCAPATH,
+	"SOURCE_SSL_CERT":                        sourceSSLCert,
+	"SOURCE_SSL_CIPHER":                      sourceSSLCipher,
+	"SOURCE_SSL_CRL":                         sourceSSLCRL,
+	"SOURCE_SSL_CRLPATH":                     sourceSSLCRLPATH,
+	"SOURCE_SSL_KEY":                         sourceSSLKey,
+	"SOURCE_SSL_VERIFY_SERVER_CERT":          sourceSSLVerifyServerCert,
+	"SOURCE_TLS_CIPHERSUITES":                sourceTLSCiphersuites,
+	"SOURCE_TLS_VERSION":                     sourceTLSVersion,
+	"SOURCE_USER":                            sourceUser,
+	"SOURCE_ZSTD_COMPRESSION_LEVEL":          sourceZstdCompressionLevel,
+	"SPATIAL":                                spatial,
+	"SPLIT":                                  split,
+	"SQL_BIG_RESULT":                         sqlBigResult,
+	"SQL_BUFFER_RESULT":                      sqlBufferResult,
+	"SQL_CACHE":                              sqlCache,
+	"SQL_CALC_FOUND_ROWS":                    sqlCalcFoundRows,
+	"SQL_NO_CACHE":                           sqlNoCache,
+	"SQL_SMALL_RESULT":                       sqlSmallResult,
+	"SQL_THREAD":                             sqlThread,
+	"SQL_TSI_DAY":                            sqlTsiDay,
+	"SQL_TSI_HOUR":                           sqlTsiHour,
+	"SQL_TSI_MINUTE":                         sqlTsiMinute,
+	"SQL_TSI_MONTH":                          sqlTsiMonth,
+	"SQL_TSI_QUARTER":                        sqlTsiQuarter,
+	"SQL_TSI_SECOND":                         sqlTsiSecond,
+	"SQL_TSI_WEEK":                           sqlTsiWeek,
+	"SQL_TSI_YEAR":                           sqlTsiYear,
+	"SRID":                                   srid,
+	"SQL":                                    sql,
+	"SQLEXCEPTION":                           sqlexception,
+	"SQLSTATE":                               sqlstate,
+	"SQLWARNING":                             sqlwarning,
+	"SQL_AFTER_GTIDS":                        sqlAfterGTIDS,
+	"SQL_AFTER_MTS_GAPS":                     sqlAfterMTSGaps,
+	"SQL_BEFORE_GTIDS":                       sqlBeforeGTIDS,
+	"SSL":                                    ssl,
+	"STACKED":                                stacked,
+	"STALENESS":                              staleness,
+	"START":                                  start,
+	"STARTING":                               starting,
+	"STARTS":                                 starts,
+	"STATISTICS":                             statistics,
+	"STATS_AUTO_RECALC":                      statsAutoRecalc,
+	"STATS_BUCKETS":                          statsBuckets,
+	"STATS_EXTENDED":                         statsExtended,
+	"STATS_HEALTHY":                          statsHealthy,
+	"STATS_HISTOGRAMS":                       statsHistograms,
+	"STATS_TOPN":                             statsTopN,
+	"STATS_META":                             statsMeta,
+	"HISTOGRAMS_IN_FLIGHT":                   histogramsInFlight,
+	"STATS_PERSISTENT":                       statsPersistent,
+	"STATS_SAMPLE_PAGES":                     statsSamplePages,
+	"STATS":                                  stats,
+	"STATUS":                                 status,
+	"STD":                                    stddevPop,
+	"STDDEV_POP":                             stddevPop,
+	"STDDEV_SAMP":                            stddevSamp,
+	"STEP":                                   step,
+	"STDDEV":                                 stddevPop,
+	"STOP":                                   stop,
+	"STORAGE":                                storage,
+	"STORED":                                 stored,
+	"STRAIGHT_JOIN":                          straightJoin,
+	"STRICT":                                 strict,
+	"STRICT_FORMAT":                          strictFormat,
+	"STRING":                                 stringType,
+	"STRONG":                                 strong,
+	"SUBCLASS_ORIGIN":                        subclassOrigin,
+	"SUBDATE":                                subDate,
+	"SUBJECT":                                subject,
+	"SUBPARTITION":                           subpartition,
+	"SUBPARTITIONS":                          subpartitions,
+	"TBPARTITION":                            tbpartition,
+	"TBPARTITIONS":                           tbpartitions,
+	"SUBSTR":                                 substring,
+	"SUBSTRING":                              substring,
+	"SUM":                                    sum,
+	"SUPER":                                  super,
+	"SUSPEND":                                suspend,
+	"SWAPS":                                  swaps,
+	"SWITCHES":                               switchesSym,
+	"SYSTEM":                                 system,
+	"SYSTEM_TIME":                            systemTime,
+	"TARGET":                                 target,
+	"TABLE_CHECKSUM":                         tableChecksum,
+	"TABLE_NAME":                             tableName,
+	"TABLE":                                  tableKwd,
+	"TABLES":                                 tables,
+	"TABLESAMPLE":                            tableSample,
+	"TABLESPACE":                             tablespace,
+	"TABLE_ALGORITHM":                        tableAlgorithm,
+	"TELEMETRY":                              telemetry,
+	"TELEMETRY_ID":                           telemetryID,
+	"TEMPORARY":                              temporary,
+	"TEMPTABLE":                              temptable,
+	"TENANT":                                 tenant,
+	"TERMINATED":                             terminated,
+	"TEXT":                                   textType,
+	"THAN":                                   than,
+	"THEN":                                   then,
+	"TIDB":                                   tidb,
+	"TIFLASH":                                tiFlash,
+	"TIKV_IMPORTER":                          tikvImporter,
+	"TIME":                                   timeType,
+	"TIMESTAMP":                              timestampType,
+	"TIMESTAMPADD":                           timestampAdd,
+	"TIMESTAMPDIFF":                          timestampDiff,
+	"TINYBLOB":                               tinyblobType,
+	"TINYINT":                                tinyIntType,
+	"TINYTEXT":                               tinytextType,
+	"TLS":                                    tls,
+	"TO":                                     to,
+	"TOKUDB_DEFAULT":                         tokudbDefault,
+	"TOKUDB_FAST":                            tokudbFast,
+	"TOKUDB_LZMA":                            tokudbLzma,
+	"TOKUDB_QUICKLZ":                         tokudbQuickLZ,
+	"TOKUDB_SMALL":                           tokudbSmall,
+	"TOKUDB_SNAPPY":                          tokudbSnappy,
+	"TOKUDB_UNCOMPRESSED":                    tokudbUncompressed,
+	"TOKUDB_ZLIB":                            tokudbZlib,
+	"TOP":                                    top,
+	"TOPN":                                   topn,
+	"TRACE":                                  trace,
+	"TRADITIONAL":                            traditional,
+	"TRAILING":                               trailing,
+	"TRANSACTION":                            transaction,
+	"TRANSACTION_ROUTING":                    transactionRouting,
+	"TRIGGER":                                trigger,
+	"TRIGGERS":                               triggers,
+	"TRIM":                                   trim,
+	"TRUE":                                   trueKwd,
+	"TRUNCATE":                               truncate,
+	"TYPE":                                   tp,
+	"UNBOUNDED":                              unbounded,
+	"UNCOMMITTED":                            uncommitted,
+	"UNDEFINED":                              undefined,
+	"UNDO":                                   undo,
+	"UNICODE":                                unicodeSym,
+	"UNION":                                  union,
+	"UNIQUE":                                 unique,
+	"UNKNOWN":                                unknown,
+	"UNLOCK":                                 unlock,
+	"UNSIGNED":                               unsigned,
+	"UNTIL":                                  until,
+	"UPDATE":                                 update,
+	"USAGE":                                  usage,
+	"USE":                                    use,
+	"USER":                                   user,
+	"USERNAME":                               username,
+	"USERS":                                  users,
+	"USING":                                  using,
+	"UTC_DATE":                               utcDate,
+	"UTC_TIME":                               utcTime,
+	"UTC_TIMESTAMP":                          utcTimestamp,
+	"VALIDATION":                             validation,
+	"VALUE":                                  value,
+	"VALUES":                                 values,
+	"VAR_POP":                                varPop,
+	"VAR_SAMP":                               varSamp,
+	"VARBINARY":                              varbinaryType,
+	"VARCHAR":                                varcharType,
+	"VARCHARACTER":                           varcharacter,
+	"VARIABLES":                              variables,
+	"VARIANCE":                               varPop,
+	"VARYING":                                varying,
+	"VERBOSE":                                verboseType,
+	"VOTER":                                  voter,
+	"VOTER_CONSTRAINTS":                      voterConstraints,
+	"VOTERS":                                 voters,
+	"VIEW":                                   view,
+	"VIRTUAL":                                virtual,
+	"VISIBLE":                                visible,
+	"WARNINGS":                               warnings,
+	"WEEK":                                   week,
+	"WEIGHT":                                 weight,
+	"WEIGHT_STRING":                          weightString,
+	"WHEN":                                   when,
+	"WHERE":                                  where,
+	"WHILE":                                  while,
+	"WIDTH":                                  width,
+	"WITH":                                   with,
+	"WITHOUT":                                without,
+	"WORK":                                   work,
+	"WORKER_ID":                              workerID,
+	"WRITE":                                  write,
+	"X509":                                   x509,
+	"XA":                                     xa,
+	"XID":                                    xid,
+	"XOR":                                    xor,
+	"YEAR_MONTH":                             yearMonth,
+	"YEAR":                                   yearType,
+	"ZEROFILL":                               zerofill,
+	"WAIT":                                   wait,
 }
 
 // See https://dev.mysql.com/doc/refman/5.7/en/function-resolution.html for details.
@@ -956,6 +1139,14 @@ var hintTokenMap = map[string]int{
 	"NTH_PLAN":                hintNthPlan,
 	"FORCE_INDEX":             hintForceIndex,
 
+	// Arana hint names
+	"ROUTE_MASTER": hintRouteMaster,
+	"ROUTE_SLAVE":  hintRouteSlave,
+	"SHARD":        hintShard,
+	"FULLSCAN":     hintFullScan,
+	"TRACE":        hintTrace,
+	"SHADOW":       hintShadow,
+
 	// TiDB hint aliases
 	"TIDB_HJ":   hintHashJoin,
 	"TIDB_INLJ": hintInlJoin,
@@ -32,3 +32,18 @@ func TestSQLError(t *testing.T) {
 	e = NewErr(0, "customized error", nil)
 	require.Greater(t, len(e.Error()), 0)
 }
+
+func TestIsValidSQLState(t *testing.T) {
+	for _, state := range MySQLState {
+		require.True(t, IsValidSQLState(state), state)
+	}
+	require.True(t, IsValidSQLState(DefaultMySQLState))
+	require.True(t, IsValidSQLState("45000"))
+	require.True(t, IsValidSQLState("01000"))
+	require.False(t, IsValidSQLState("00000"))
+	require.False(t, IsValidSQLState("00A01"))
+	require.False(t, IsValidSQLState("4500"))
+	require.False(t, IsValidSQLState("450000"))
+	require.False(t, IsValidSQLState("4500a"))
+	require.False(t, IsValidSQLState("45-00"))
+}
@@ -16,6 +16,8 @@ package mysql
 const (
 	// DefaultMySQLState is default state of the mySQL
 	DefaultMySQLState = "HY000"
+	// SQLStateClassSuccess is the SQLSTATE class of successful completion.
+	SQLStateClassSuccess = "00"
 )
 
 // MySQLState maps error code to MySQL SQLSTATE value.
@@ -258,3 +260,19 @@ var MySQLState = map[uint16]string{
 	ErrJSONDocumentNULLKey:                 "22032",
 	ErrInvalidJSONPathArrayCell:            "42000",
 }
+
+// IsValidSQLState reports whether state can be signaled or handled. A valid
+// SQLSTATE value consists of five digits or uppercase letters, and its class,
+// the first two characters, does not indicate successful completion.
+func IsValidSQLState(state string) bool {
+	if len(state) != 5 {
+		return false
+	}
+	for i := 0; i < len(state); i++ {
+		c := state[i]
+		if !('0' <= c && c <= '9' || 'A' <= c && c <= 'Z') {
+			return false
+		}
+	}
+	return state[:2] != SQLStateClassSuccess
+}
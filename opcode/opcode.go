@@ -55,6 +55,7 @@ const (
 	IsNull
 	IsTruth
 	IsFalsity
+	MemberOf
 )
 
 var ops = [...]struct {
@@ -217,6 +218,11 @@ var ops = [...]struct {
 		literal:   "IS FALSE",
 		isKeyword: true,
 	},
+	MemberOf: {
+		name:      "memberof",
+		literal:   "MEMBER OF",
+		isKeyword: true,
+	},
 }
 
 // String implements Stringer interface.
@@ -26,6 +26,8 @@
 package parser
 
 import (
+	"math"
+	"regexp"
 	"strings"
 
 	"github.com/arana-db/parser/mysql"
@@ -78,6 +80,7 @@ import (
 	and               "AND"
 	as                "AS"
 	asc               "ASC"
+	before            "BEFORE"
 	between           "BETWEEN"
 	bigIntType        "BIGINT"
 	binaryType        "BINARY"
@@ -93,7 +96,9 @@ import (
 	check             "CHECK"
 	collate           "COLLATE"
 	column            "COLUMN"
+	condition         "CONDITION"
 	constraint        "CONSTRAINT"
+	continueKwd       "CONTINUE"
 	convert           "CONVERT"
 	create            "CREATE"
 	cross             "CROSS"
@@ -102,6 +107,7 @@ import (
 	currentTime       "CURRENT_TIME"
 	currentTs         "CURRENT_TIMESTAMP"
 	currentUser       "CURRENT_USER"
+	cursor            "CURSOR"
 	currentRole       "CURRENT_ROLE"
 	database          "DATABASE"
 	databases         "DATABASES"
@@ -110,22 +116,27 @@ import (
 	dayMinute         "DAY_MINUTE"
 	daySecond         "DAY_SECOND"
 	decimalType       "DECIMAL"
+	declare           "DECLARE"
 	defaultKwd        "DEFAULT"
 	delayed           "DELAYED"
 	deleteKwd         "DELETE"
 	denseRank         "DENSE_RANK"
 	desc              "DESC"
 	describe          "DESCRIBE"
+	deterministic     "DETERMINISTIC"
 	distinct          "DISTINCT"
 	distinctRow       "DISTINCTROW"
 	div               "DIV"
 	doubleType        "DOUBLE"
 	drop              "DROP"
 	dual              "DUAL"
+	each              "EACH"
 	elseKwd           "ELSE"
+	elseIfKwd         "ELSEIF"
 	enclosed          "ENCLOSED"
 	escaped           "ESCAPED"
 	exists            "EXISTS"
+	exit              "EXIT"
 	explain           "EXPLAIN"
 	except            "EXCEPT"
 	falseKwd          "FALSE"
@@ -138,6 +149,7 @@ import (
 	from              "FROM"
 	fulltext          "FULLTEXT"
 	generated         "GENERATED"
+	get               "GET"
 	grant             "GRANT"
 	group             "GROUP"
 	groups            "GROUPS"
@@ -152,12 +164,14 @@ import (
 	index             "INDEX"
 	infile            "INFILE"
 	inner             "INNER"
+	inout             "INOUT"
 	integerType       "INTEGER"
 	intersect         "INTERSECT"
 	interval          "INTERVAL"
 	into              "INTO"
 	outfile           "OUTFILE"
 	is                "IS"
+	iterate           "ITERATE"
 	insert            "INSERT"
 	intType           "INT"
 	int1Type          "INT1"
@@ -166,13 +180,16 @@ import (
 	int4Type          "INT4"
 	int8Type          "INT8"
 	join              "JOIN"
+	jsonTable         "JSON_TABLE"
 	key               "KEY"
 	keys              "KEYS"
 	kill              "KILL"
 	lag               "LAG"
 	lastValue         "LAST_VALUE"
+	lateral           "LATERAL"
 	lead              "LEAD"
 	leading           "LEADING"
+	leave             "LEAVE"
 	left              "LEFT"
 	like              "LIKE"
 	limit             "LIMIT"
@@ -184,6 +201,7 @@ import (
 	lock              "LOCK"
 	longblobType      "LONGBLOB"
 	longtextType      "LONGTEXT"
+	loop              "LOOP"
 	lowPriority       "LOW_PRIORITY"
 	match             "MATCH"
 	maxValue          "MAXVALUE"
@@ -193,6 +211,7 @@ import (
 	minuteMicrosecond "MINUTE_MICROSECOND"
 	minuteSecond      "MINUTE_SECOND"
 	mod               "MOD"
+	modifies          "MODIFIES"
 	not               "NOT"
 	noWriteToBinLog   "NO_WRITE_TO_BINLOG"
 	nthValue          "NTH_VALUE"
@@ -206,6 +225,7 @@ import (
 	optionally        "OPTIONALLY"
 	or                "OR"
 	order             "ORDER"
+	out               "OUT"
 	outer             "OUTER"
 	over              "OVER"
 	dbpartition       "DBPARTITION"
@@ -217,6 +237,7 @@ import (
 	rangeKwd          "RANGE"
 	rank              "RANK"
 	read              "READ"
+	reads             "READS"
 	realType          "REAL"
 	recursive         "RECURSIVE"
 	references        "REFERENCES"
@@ -226,7 +247,9 @@ import (
 	repeat            "REPEAT"
 	replace           "REPLACE"
 	require           "REQUIRE"
+	resignal          "RESIGNAL"
 	restrict          "RESTRICT"
+	returnKwd         "RETURN"
 	revoke            "REVOKE"
 	right             "RIGHT"
 	rlike             "RLIKE"
@@ -237,9 +260,13 @@ import (
 	selectKwd         "SELECT"
 	set               "SET"
 	show              "SHOW"
+	signal            "SIGNAL"
 	smallIntType      "SMALLINT"
 	spatial           "SPATIAL"
 	sql               "SQL"
+	sqlexception      "SQLEXCEPTION"
+	sqlstate          "SQLSTATE"
+	sqlwarning        "SQLWARNING"
 	sqlBigResult      "SQL_BIG_RESULT"
 	sqlCalcFoundRows  "SQL_CALC_FOUND_ROWS"
 	sqlSmallResult    "SQL_SMALL_RESULT"
@@ -260,6 +287,7 @@ import (
 	trailing          "TRAILING"
 	trigger           "TRIGGER"
 	trueKwd           "TRUE"
+	undo              "UNDO"
 	unique            "UNIQUE"
 	union             "UNION"
 	unlock            "UNLOCK"
@@ -280,6 +308,7 @@ import (
 	virtual           "VIRTUAL"
 	when              "WHEN"
 	where             "WHERE"
+	while             "WHILE"
 	write             "WRITE"
 	window            "WINDOW"
 	with              "WITH"
@@ -289,350 +318,506 @@ import (
 	natural           "NATURAL"
 
 	/* The following tokens belong to UnReservedKeyword. Notice: make sure these tokens are contained in UnReservedKeyword. */
-	account               "ACCOUNT"
-	action                "ACTION"
-	advise                "ADVISE"
-	after                 "AFTER"
-	against               "AGAINST"
-	ago                   "AGO"
-	algorithm             "ALGORITHM"
-	always                "ALWAYS"
-	any                   "ANY"
-	ascii                 "ASCII"
-	attributes            "ATTRIBUTES"
-	statsOptions          "STATS_OPTIONS"
-	statsSampleRate       "STATS_SAMPLE_RATE"
-	statsColChoice        "STATS_COL_CHOICE"
-	statsColList          "STATS_COL_LIST"
-	autoIdCache           "AUTO_ID_CACHE"
-	autoIncrement         "AUTO_INCREMENT"
-	autoRandom            "AUTO_RANDOM"
-	autoRandomBase        "AUTO_RANDOM_BASE"
-	avg                   "AVG"
-	avgRowLength          "AVG_ROW_LENGTH"
-	backend               "BACKEND"
-	backup                "BACKUP"
-	backups               "BACKUPS"
-	begin                 "BEGIN"
-	bernoulli             "BERNOULLI"
-	binding               "BINDING"
-	bindings              "BINDINGS"
-	binlog                "BINLOG"
-	bitType               "BIT"
-	block                 "BLOCK"
-	booleanType           "BOOLEAN"
-	boolType              "BOOL"
-	btree                 "BTREE"
-	byteType              "BYTE"
-	cache                 "CACHE"
-	capture               "CAPTURE"
-	cascaded              "CASCADED"
-	causal                "CAUSAL"
-	chain                 "CHAIN"
-	charsetKwd            "CHARSET"
-	checkpoint            "CHECKPOINT"
-	checksum              "CHECKSUM"
-	cipher                "CIPHER"
-	cleanup               "CLEANUP"
-	client                "CLIENT"
-	clientErrorsSummary   "CLIENT_ERRORS_SUMMARY"
-	coalesce              "COALESCE"
-	collation             "COLLATION"
-	columnFormat          "COLUMN_FORMAT"
-	columns               "COLUMNS"
-	config                "CONFIG"
-	comment               "COMMENT"
-	commit                "COMMIT"
-	committed             "COMMITTED"
-	compact               "COMPACT"
-	compressed            "COMPRESSED"
-	compression           "COMPRESSION"
-	concurrency           "CONCURRENCY"
-	connection            "CONNECTION"
-	consistency           "CONSISTENCY"
-	consistent            "CONSISTENT"
-	context               "CONTEXT"
-	cpu                   "CPU"
-	csvBackslashEscape    "CSV_BACKSLASH_ESCAPE"
-	csvDelimiter          "CSV_DELIMITER"
-	csvHeader             "CSV_HEADER"
-	csvNotNull            "CSV_NOT_NULL"
-	csvNull               "CSV_NULL"
-	csvSeparator          "CSV_SEPARATOR"
-	csvTrimLastSeparators "CSV_TRIM_LAST_SEPARATORS"
-	current               "CURRENT"
-	clustered             "CLUSTERED"
-	cycle                 "CYCLE"
-	data                  "DATA"
-	datetimeType          "DATETIME"
-	dateType              "DATE"
-	day                   "DAY"
-	deallocate            "DEALLOCATE"
-	definer               "DEFINER"
-	delayKeyWrite         "DELAY_KEY_WRITE"
-	directory             "DIRECTORY"
-	disable               "DISABLE"
-	discard               "DISCARD"
-	disk                  "DISK"
-	do                    "DO"
-	duplicate             "DUPLICATE"
-	dynamic               "DYNAMIC"
-	enable                "ENABLE"
-	encryption            "ENCRYPTION"
-	end                   "END"
-	enforced              "ENFORCED"
-	engine                "ENGINE"
-	engines               "ENGINES"
-	enum                  "ENUM"
-	errorKwd              "ERROR"
-	escape                "ESCAPE"
-	event                 "EVENT"
-	events                "EVENTS"
-	evolve                "EVOLVE"
-	exchange              "EXCHANGE"
-	exclusive             "EXCLUSIVE"
-	execute               "EXECUTE"
-	expansion             "EXPANSION"
-	expire                "EXPIRE"
-	extended              "EXTENDED"
-	faultsSym             "FAULTS"
-	fields                "FIELDS"
-	file                  "FILE"
-	first                 "FIRST"
-	fixed                 "FIXED"
-	flush                 "FLUSH"
-	following             "FOLLOWING"
-	format                "FORMAT"
-	full                  "FULL"
-	function              "FUNCTION"
-	general               "GENERAL"
-	global                "GLOBAL"
-	grants                "GRANTS"
-	hash                  "HASH"
-	help                  "HELP"
-	histogram             "HISTOGRAM"
-	history               "HISTORY"
-	hosts                 "HOSTS"
-	hour                  "HOUR"
-	identified            "IDENTIFIED"
-	identSQLErrors        "ERRORS"
-	importKwd             "IMPORT"
-	imports               "IMPORTS"
-	increment             "INCREMENT"
-	incremental           "INCREMENTAL"
-	indexes               "INDEXES"
-	insertMethod          "INSERT_METHOD"
-	instance              "INSTANCE"
-	invisible             "INVISIBLE"
-	invoker               "INVOKER"
-	io                    "IO"
-	ipc                   "IPC"
-	isolation             "ISOLATION"
-	issuer                "ISSUER"
-	jsonType              "JSON"
-	keyBlockSize          "KEY_BLOCK_SIZE"
-	labels                "LABELS"
-	language              "LANGUAGE"
-	last                  "LAST"
-	lastBackup            "LAST_BACKUP"
-	lastval               "LASTVAL"
-	less                  "LESS"
-	level                 "LEVEL"
-	list                  "LIST"
-	local                 "LOCAL"
-	locked                "LOCKED"
-	location              "LOCATION"
-	logs                  "LOGS"
-	master                "MASTER"
-	max_idxnum            "MAX_IDXNUM"
-	max_minutes           "MAX_MINUTES"
-	maxConnectionsPerHour "MAX_CONNECTIONS_PER_HOUR"
-	maxQueriesPerHour     "MAX_QUERIES_PER_HOUR"
-	maxRows               "MAX_ROWS"
-	maxUpdatesPerHour     "MAX_UPDATES_PER_HOUR"
-	maxUserConnections    "MAX_USER_CONNECTIONS"
-	mb                    "MB"
-	memory                "MEMORY"
-	merge                 "MERGE"
-	microsecond           "MICROSECOND"
-	minRows               "MIN_ROWS"
-	minute                "MINUTE"
-	minValue              "MINVALUE"
-	mode                  "MODE"
-	modify                "MODIFY"
-	month                 "MONTH"
-	names                 "NAMES"
-	national              "NATIONAL"
-	ncharType             "NCHAR"
-	never                 "NEVER"
-	next                  "NEXT"
-	nextval               "NEXTVAL"
-	no                    "NO"
-	nocache               "NOCACHE"
-	nocycle               "NOCYCLE"
-	nodegroup             "NODEGROUP"
-	nomaxvalue            "NOMAXVALUE"
-	nominvalue            "NOMINVALUE"
-	nonclustered          "NONCLUSTERED"
-	none                  "NONE"
-	nowait                "NOWAIT"
-	nvarcharType          "NVARCHAR"
-	nulls                 "NULLS"
-	off                   "OFF"
-	offset                "OFFSET"
-	onDuplicate           "ON_DUPLICATE"
-	online                "ONLINE"
-	only                  "ONLY"
-	open                  "OPEN"
-	optional              "OPTIONAL"
-	packKeys              "PACK_KEYS"
-	pageSym               "PAGE"
-	parser                "PARSER"
-	partial               "PARTIAL"
-	partitioning          "PARTITIONING"
-	partitions            "PARTITIONS"
-	password              "PASSWORD"
-	percent               "PERCENT"
-	per_db                "PER_DB"
-	per_table             "PER_TABLE"
+	account                            "ACCOUNT"
+	action                             "ACTION"
+	advise                             "ADVISE"
+	after                              "AFTER"
+	against                            "AGAINST"
+	aggregate                          "AGGREGATE"
+	ago                                "AGO"
+	algorithm                          "ALGORITHM"
+	always                             "ALWAYS"
+	any                                "ANY"
+	array                              "ARRAY"
+	ascii                              "ASCII"
+	assignGTIDSToAnonymousTransactions "ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS"
+	at                                 "AT"
+	attributes                         "ATTRIBUTES"
+	statsOptions                       "STATS_OPTIONS"
+	statsSampleRate                    "STATS_SAMPLE_RATE"
+	statsColChoice                     "STATS_COL_CHOICE"
+	statsColList                       "STATS_COL_LIST"
+	autoIdCache                        "AUTO_ID_CACHE"
+	autoIncrement                      "AUTO_INCREMENT"
+	autoRandom                         "AUTO_RANDOM"
+	autoRandomBase                     "AUTO_RANDOM_BASE"
+	avg                                "AVG"
+	avgRowLength                       "AVG_ROW_LENGTH"
+	backend                            "BACKEND"
+	backup                             "BACKUP"
+	backups                            "BACKUPS"
+	begin                              "BEGIN"
+	bernoulli                          "BERNOULLI"
+	binding                            "BINDING"
+	bindings                           "BINDINGS"
+	binlog                             "BINLOG"
+	bitType                            "BIT"
+	block                              "BLOCK"
+	booleanType                        "BOOLEAN"
+	boolType                           "BOOL"
+	btree                              "BTREE"
+	byteType                           "BYTE"
+	cache                              "CACHE"
+	capture                            "CAPTURE"
+	cascaded                           "CASCADED"
+	catalogName                        "CATALOG_NAME"
+	causal                             "CAUSAL"
+	chain                              "CHAIN"
+	channel                            "CHANNEL"
+	charsetKwd                         "CHARSET"
+	checkpoint                         "CHECKPOINT"
+	checksum                           "CHECKSUM"
+	cipher                             "CIPHER"
+	classOrigin                        "CLASS_ORIGIN"
+	cleanup                            "CLEANUP"
+	client                             "CLIENT"
+	clientErrorsSummary                "CLIENT_ERRORS_SUMMARY"
+	closeKwd                           "CLOSE"
+	coalesce                           "COALESCE"
+	collation                          "COLLATION"
+	columnFormat                       "COLUMN_FORMAT"
+	columnName                         "COLUMN_NAME"
+	columns                            "COLUMNS"
+	config                             "CONFIG"
+	comment                            "COMMENT"
+	commit                             "COMMIT"
+	committed                          "COMMITTED"
+	compact                            "COMPACT"
+	completion                         "COMPLETION"
+	compressed                         "COMPRESSED"
+	compression                        "COMPRESSION"
+	concurrency                        "CONCURRENCY"
+	connection                         "CONNECTION"
+	consistency                        "CONSISTENCY"
+	consistent                         "CONSISTENT"
+	constraintCatalog                  "CONSTRAINT_CATALOG"
+	constraintName                     "CONSTRAINT_NAME"
+	constraintSchema                   "CONSTRAINT_SCHEMA"
+	contains                           "CONTAINS"
+	context                            "CONTEXT"
+	cpu                                "CPU"
+	csvBackslashEscape                 "CSV_BACKSLASH_ESCAPE"
+	csvDelimiter                       "CSV_DELIMITER"
+	csvHeader                          "CSV_HEADER"
+	csvNotNull                         "CSV_NOT_NULL"
+	csvNull                            "CSV_NULL"
+	csvSeparator                       "CSV_SEPARATOR"
+	csvTrimLastSeparators              "CSV_TRIM_LAST_SEPARATORS"
+	current                            "CURRENT"
+	cursorName                         "CURSOR_NAME"
+	clustered                          "CLUSTERED"
+	cycle                              "CYCLE"
+	data                               "DATA"
+	databaseAlgorithm                  "DATABASE_ALGORITHM"
+	datetimeType                       "DATETIME"
+	dateType                           "DATE"
+	day                                "DAY"
+	db                                 "DB"
+	deallocate                         "DEALLOCATE"
+	defaultAuth                        "DEFAULT_AUTH"
+	definer                            "DEFINER"
+	delayKeyWrite                      "DELAY_KEY_WRITE"
+	diagnostics                        "DIAGNOSTICS"
+	directory                          "DIRECTORY"
+	disable                            "DISABLE"
+	discard                            "DISCARD"
+	disk                               "DISK"
+	do                                 "DO"
+	duplicate                          "DUPLICATE"
+	dynamic                            "DYNAMIC"
+	emptyKwd                           "EMPTY"
+	enable                             "ENABLE"
+	encryption                         "ENCRYPTION"
+	end                                "END"
+	ends                               "ENDS"
+	enforced                           "ENFORCED"
+	engine                             "ENGINE"
+	engines                            "ENGINES"
+	enum                               "ENUM"
+	errorKwd                           "ERROR"
+	escape                             "ESCAPE"
+	event                              "EVENT"
+	events                             "EVENTS"
+	every                              "EVERY"
+	evolve                             "EVOLVE"
+	exchange                           "EXCHANGE"
+	exclusive                          "EXCLUSIVE"
+	execute                            "EXECUTE"
+	expansion                          "EXPANSION"
+	expire                             "EXPIRE"
+	extended                           "EXTENDED"
+	faultsSym                          "FAULTS"
+	fields                             "FIELDS"
+	file                               "FILE"
+	first                              "FIRST"
+	fixed                              "FIXED"
+	flush                              "FLUSH"
+	following                          "FOLLOWING"
+	follows                            "FOLLOWS"
+	format                             "FORMAT"
+	found                              "FOUND"
+	full                               "FULL"
+	function                           "FUNCTION"
+	general                            "GENERAL"
+	geomCollection                     "GEOMCOLLECTION"
+	geometryType                       "GEOMETRY"
+	geometryCollection                 "GEOMETRYCOLLECTION"
+	getMasterPublicKey                 "GET_MASTER_PUBLIC_KEY"
+	getSourcePublicKey                 "GET_SOURCE_PUBLIC_KEY"
+	global                             "GLOBAL"
+	grants                             "GRANTS"
+	gtidOnly                           "GTID_ONLY"
+	handler                            "HANDLER"
+	hash                               "HASH"
+	help                               "HELP"
+	hint                               "HINT"
+	histogram                          "HISTOGRAM"
+	history                            "HISTORY"
+	host                               "HOST"
+	hosts                              "HOSTS"
+	hour                               "HOUR"
+	identified                         "IDENTIFIED"
+	ignoreServerIDS                    "IGNORE_SERVER_IDS"
+	identSQLErrors                     "ERRORS"
+	importKwd                          "IMPORT"
+	imports                            "IMPORTS"
+	increment                          "INCREMENT"
+	incremental                        "INCREMENTAL"
+	indexes                            "INDEXES"
+	insertMethod                       "INSERT_METHOD"
+	instance                           "INSTANCE"
+	invisible                          "INVISIBLE"
+	invoker                            "INVOKER"
+	io                                 "IO"
+	ioThread                           "IO_THREAD"
+	ipc                                "IPC"
+	isolation                          "ISOLATION"
+	issuer                             "ISSUER"
+	jsonType                           "JSON"
+	keyBlockSize                       "KEY_BLOCK_SIZE"
+	keyGenerator                       "KEY_GENERATOR"
+	labels                             "LABELS"
+	language                           "LANGUAGE"
+	last                               "LAST"
+	lastBackup                         "LAST_BACKUP"
+	lastval                            "LASTVAL"
+	less                               "LESS"
+	level                              "LEVEL"
+	linestring                         "LINESTRING"
+	list                               "LIST"
+	loadBalance                        "LOAD_BALANCE"
+	local                              "LOCAL"
+	locked                             "LOCKED"
+	location                           "LOCATION"
+	logs                               "LOGS"
+	master                             "MASTER"
+	masterAutoPosition                 "MASTER_AUTO_POSITION"
+	masterBind                         "MASTER_BIND"
+	masterCompressionAlgorithms        "MASTER_COMPRESSION_ALGORITHMS"
+	masterConnectRetry                 "MASTER_CONNECT_RETRY"
+	masterDelay                        "MASTER_DELAY"
+	masterHeartbeatPeriod              "MASTER_HEARTBEAT_PERIOD"
+	masterHost                         "MASTER_HOST"
+	masterLogFile                      "MASTER_LOG_FILE"
+	masterLogPos                       "MASTER_LOG_POS"
+	masterPassword                     "MASTER_PASSWORD"
+	masterPort                         "MASTER_PORT"
+	masterPublicKeyPath                "MASTER_PUBLIC_KEY_PATH"
+	masterRetryCount                   "MASTER_RETRY_COUNT"
+	masterSSL                          "MASTER_SSL"
+	masterSSLCA                        "MASTER_SSL_CA"
+	masterSSLCAPATH                    "MASTER_SSL_CAPATH"
+	masterSSLCert                      "MASTER_SSL_CERT"
+	masterSSLCipher                    "MASTER_SSL_CIPHER"
+	masterSSLCRL                       "MASTER_SSL_CRL"
+	masterSSLCRLPATH                   "MASTER_SSL_CRLPATH"
+	masterSSLKey                       "MASTER_SSL_KEY"
+	masterSSLVerifyServerCert          "MASTER_SSL_VERIFY_SERVER_CERT"
+	masterTLSCiphersuites              "MASTER_TLS_CIPHERSUITES"
+	masterTLSVersion                   "MASTER_TLS_VERSION"
+	masterUser                         "MASTER_USER"
+	masterZstdCompressionLevel         "MASTER_ZSTD_COMPRESSION_LEVEL"
+	max_idxnum                         "MAX_IDXNUM"
+	max_minutes                        "MAX_MINUTES"
+	maxConnectionsPerHour              "MAX_CONNECTIONS_PER_HOUR"
+	maxQueriesPerHour                  "MAX_QUERIES_PER_HOUR"
+	maxRows                            "MAX_ROWS"
+	maxUpdatesPerHour                  "MAX_UPDATES_PER_HOUR"
+	maxUserConnections                 "MAX_USER_CONNECTIONS"
+	mb                                 "MB"
+	member                             "MEMBER"
+	memory                             "MEMORY"
+	merge                              "MERGE"
+	messageText                        "MESSAGE_TEXT"
+	microsecond                        "MICROSECOND"
+	migrate                            "MIGRATE"
+	minRows                            "MIN_ROWS"
+	minute                             "MINUTE"
+	minValue                           "MINVALUE"
+	mode                               "MODE"
+	modify                             "MODIFY"
+	month                              "MONTH"
+	multilinestring                    "MULTILINESTRING"
+	multipoint                         "MULTIPOINT"
+	multipolygon                       "MULTIPOLYGON"
+	mysqlErrno                         "MYSQL_ERRNO"
+	names                              "NAMES"
+	national                           "NATIONAL"
+	ncharType                          "NCHAR"
+	nested                             "NESTED"
+	networkNamespace                   "NETWORK_NAMESPACE"
+	never                              "NEVER"
+	next                               "NEXT"
+	nextval                            "NEXTVAL"
+	no                                 "NO"
+	nocache                            "NOCACHE"
+	nocycle                            "NOCYCLE"
+	node                               "NODE"
+	nodegroup                          "NODEGROUP"
+	nomaxvalue                         "NOMAXVALUE"
+	nominvalue                         "NOMINVALUE"
+	nonclustered                       "NONCLUSTERED"
+	none                               "NONE"
+	nowait                             "NOWAIT"
+	nvarcharType                       "NVARCHAR"
+	nulls                              "NULLS"
+	number                             "NUMBER"
+	off                                "OFF"
+	offset                             "OFFSET"
+	one                                "ONE"
+	onDuplicate                        "ON_DUPLICATE"
+	online                             "ONLINE"
+	only                               "ONLY"
+	open                               "OPEN"
+	optional                           "OPTIONAL"
+	ordinality                         "ORDINALITY"
+	packKeys                           "PACK_KEYS"
+	pageSym                            "PAGE"
+	parser                             "PARSER"
+	partial                            "PARTIAL"
+	partitioning                       "PARTITIONING"
+	partitions                         "PARTITIONS"
+	password                           "PASSWORD"
+	pathKwd                            "PATH"
+	percent                            "PERCENT"
+	persist                            "PERSIST"
+	persistOnly                        "PERSIST_ONLY"
+	per_db                             "PER_DB"
+	per_table                          "PER_TABLE"
+	phase                              "PHASE"
 	pipesAsOr
-	plugins               "PLUGINS"
-	policy                "POLICY"
-	preSplitRegions       "PRE_SPLIT_REGIONS"
-	preceding             "PRECEDING"
-	prepare               "PREPARE"
-	preserve              "PRESERVE"
-	privileges            "PRIVILEGES"
-	process               "PROCESS"
-	processlist           "PROCESSLIST"
-	profile               "PROFILE"
-	profiles              "PROFILES"
-	proxy                 "PROXY"
-	purge                 "PURGE"
-	quarter               "QUARTER"
-	queries               "QUERIES"
-	query                 "QUERY"
-	quick                 "QUICK"
-	rateLimit             "RATE_LIMIT"
-	rebuild               "REBUILD"
-	recover               "RECOVER"
-	redundant             "REDUNDANT"
-	reload                "RELOAD"
-	remove                "REMOVE"
-	reorganize            "REORGANIZE"
-	repair                "REPAIR"
-	repeatable            "REPEATABLE"
-	replica               "REPLICA"
-	replicas              "REPLICAS"
-	replication           "REPLICATION"
-	required              "REQUIRED"
-	respect               "RESPECT"
-	restart               "RESTART"
-	restore               "RESTORE"
-	restores              "RESTORES"
-	resume                "RESUME"
-	reverse               "REVERSE"
-	role                  "ROLE"
-	rollback              "ROLLBACK"
-	routine               "ROUTINE"
-	rowCount              "ROW_COUNT"
-	rowFormat             "ROW_FORMAT"
-	rtree                 "RTREE"
-	rules                 "RULES"
-	san                   "SAN"
-	second                "SECOND"
-	secondaryEngine       "SECONDARY_ENGINE"
-	secondaryLoad         "SECONDARY_LOAD"
-	secondaryUnload       "SECONDARY_UNLOAD"
-	security              "SECURITY"
-	sendCredentialsToTiKV "SEND_CREDENTIALS_TO_TIKV"
-	separator             "SEPARATOR"
-	sequence              "SEQUENCE"
-	topology              "TOPOLOGY"
-	users                 "USERS"
-	nodes                 "NODES"
-	serial                "SERIAL"
-	serializable          "SERIALIZABLE"
-	session               "SESSION"
-	setval                "SETVAL"
-	sharding              "SHARDING"
-	shardRowIDBits        "SHARD_ROW_ID_BITS"
-	share                 "SHARE"
-	shared                "SHARED"
-	shutdown              "SHUTDOWN"
-	signed                "SIGNED"
-	simple                "SIMPLE"
-	skip                  "SKIP"
-	skipSchemaFiles       "SKIP_SCHEMA_FILES"
-	slave                 "SLAVE"
-	slow                  "SLOW"
-	snapshot              "SNAPSHOT"
-	some                  "SOME"
-	source                "SOURCE"
-	sqlBufferResult       "SQL_BUFFER_RESULT"
-	sqlCache              "SQL_CACHE"
-	sqlNoCache            "SQL_NO_CACHE"
-	sqlTsiDay             "SQL_TSI_DAY"
-	sqlTsiHour            "SQL_TSI_HOUR"
-	sqlTsiMinute          "SQL_TSI_MINUTE"
-	sqlTsiMonth           "SQL_TSI_MONTH"
-	sqlTsiQuarter         "SQL_TSI_QUARTER"
-	sqlTsiSecond          "SQL_TSI_SECOND"
-	sqlTsiWeek            "SQL_TSI_WEEK"
-	sqlTsiYear            "SQL_TSI_YEAR"
-	start                 "START"
-	statsAutoRecalc       "STATS_AUTO_RECALC"
-	statsPersistent       "STATS_PERSISTENT"
-	statsSamplePages      "STATS_SAMPLE_PAGES"
-	status                "STATUS"
-	storage               "STORAGE"
-	strictFormat          "STRICT_FORMAT"
-	subject               "SUBJECT"
-	subpartition          "SUBPARTITION"
-	subpartitions         "SUBPARTITIONS"
-	tbpartition           "TBPARTITION"
-	tbpartitions          "TBPARTITIONS"
-	dbpartitions          "DBPARTITIONS"
-	super                 "SUPER"
-	swaps                 "SWAPS"
-	switchesSym           "SWITCHES"
-	system                "SYSTEM"
-	systemTime            "SYSTEM_TIME"
-	tableChecksum         "TABLE_CHECKSUM"
-	tableRules            "TABLE_RULES"
-	tables                "TABLES"
-	tablespace            "TABLESPACE"
-	temporary             "TEMPORARY"
-	temptable             "TEMPTABLE"
-	textType              "TEXT"
-	than                  "THAN"
-	tikvImporter          "TIKV_IMPORTER"
-	timestampType         "TIMESTAMP"
-	timeType              "TIME"
-	tp                    "TYPE"
-	trace                 "TRACE"
-	traditional           "TRADITIONAL"
-	transaction           "TRANSACTION"
-	triggers              "TRIGGERS"
-	truncate              "TRUNCATE"
-	unbounded             "UNBOUNDED"
-	uncommitted           "UNCOMMITTED"
-	undefined             "UNDEFINED"
-	unicodeSym            "UNICODE"
-	unknown               "UNKNOWN"
-	user                  "USER"
-	validation            "VALIDATION"
-	value                 "VALUE"
-	variables             "VARIABLES"
-	view                  "VIEW"
-	visible               "VISIBLE"
-	warnings              "WARNINGS"
-	week                  "WEEK"
-	weightString          "WEIGHT_STRING"
-	without               "WITHOUT"
-	x509                  "X509"
-	yearType              "YEAR"
-	wait                  "WAIT"
+	plugins                            "PLUGINS"
+	pluginDir                          "PLUGIN_DIR"
+	point                              "POINT"
+	policy                             "POLICY"
+	polygon                            "POLYGON"
+	port                               "PORT"
+	precedes                           "PRECEDES"
+	preSplitRegions                    "PRE_SPLIT_REGIONS"
+	preceding                          "PRECEDING"
+	prepare                            "PREPARE"
+	preserve                           "PRESERVE"
+	preview                            "PREVIEW"
+	privileges                         "PRIVILEGES"
+	privilegeChecksUser                "PRIVILEGE_CHECKS_USER"
+	process                            "PROCESS"
+	processlist                        "PROCESSLIST"
+	profile                            "PROFILE"
+	profiles                           "PROFILES"
+	properties                         "PROPERTIES"
+	proxy                              "PROXY"
+	purge                              "PURGE"
+	quarter                            "QUARTER"
+	queries                            "QUERIES"
+	query                              "QUERY"
+	quick                              "QUICK"
+	rateLimit                          "RATE_LIMIT"
+	rebuild                            "REBUILD"
+	recover                            "RECOVER"
+	redundant                          "REDUNDANT"
+	relaylog                           "RELAYLOG"
+	relayLogFile                       "RELAY_LOG_FILE"
+	relayLogPos                        "RELAY_LOG_POS"
+	relayThread                        "RELAY_THREAD"
+	reload                             "RELOAD"
+	remove                             "REMOVE"
+	reorganize                         "REORGANIZE"
+	repair                             "REPAIR"
+	repeatable                         "REPEATABLE"
+	replica                            "REPLICA"
+	replicas                           "REPLICAS"
+	replication                        "REPLICATION"
+	required                           "REQUIRED"
+	requireRowFormat                   "REQUIRE_ROW_FORMAT"
+	requireTablePrimaryKeyCheck        "REQUIRE_TABLE_PRIMARY_KEY_CHECK"
+	respect                            "RESPECT"
+	restart                            "RESTART"
+	restore                            "RESTORE"
+	restores                           "RESTORES"
+	resume                             "RESUME"
+	returnedSQLState                   "RETURNED_SQLSTATE"
+	returns                            "RETURNS"
+	reverse                            "REVERSE"
+	role                               "ROLE"
+	rollback                           "ROLLBACK"
+	routine                            "ROUTINE"
+	rowCount                           "ROW_COUNT"
+	rowFormat                          "ROW_FORMAT"
+	rtree                              "RTREE"
+	rule                               "RULE"
+	rules                              "RULES"
+	san                                "SAN"
+	savepoint                          "SAVEPOINT"
+	schemaName                         "SCHEMA_NAME"
+	second                             "SECOND"
+	secondaryEngine                    "SECONDARY_ENGINE"
+	secondaryLoad                      "SECONDARY_LOAD"
+	secondaryUnload                    "SECONDARY_UNLOAD"
+	security                           "SECURITY"
+	segment                            "SEGMENT"
+	sendCredentialsToTiKV              "SEND_CREDENTIALS_TO_TIKV"
+	separator                          "SEPARATOR"
+	sequence                           "SEQUENCE"
+	sequences                          "SEQUENCES"
+	topology                           "TOPOLOGY"
+	users                              "USERS"
+	nodes                              "NODES"
+	serial                             "SERIAL"
+	serializable                       "SERIALIZABLE"
+	session                            "SESSION"
+	setval                             "SETVAL"
+	shadow                             "SHADOW"
+	shadowGroup                        "SHADOW_GROUP"
+	sharding                           "SHARDING"
+	shardingColumns                    "SHARDING_COLUMNS"
+	shardRowIDBits                     "SHARD_ROW_ID_BITS"
+	share                              "SHARE"
+	shared                             "SHARED"
+	shutdown                           "SHUTDOWN"
+	signed                             "SIGNED"
+	simple                             "SIMPLE"
+	skip                               "SKIP"
+	skipSchemaFiles                    "SKIP_SCHEMA_FILES"
+	slave                              "SLAVE"
+	slow                               "SLOW"
+	snapshot                           "SNAPSHOT"
+	snowflake                          "SNOWFLAKE"
+	some                               "SOME"
+	soname                             "SONAME"
+	source                             "SOURCE"
+	sourceAutoPosition                 "SOURCE_AUTO_POSITION"
+	sourceBind                         "SOURCE_BIND"
+	sourceCompressionAlgorithms        "SOURCE_COMPRESSION_ALGORITHMS"
+	sourceConnectionAutoFailover       "SOURCE_CONNECTION_AUTO_FAILOVER"
+	sourceConnectRetry                 "SOURCE_CONNECT_RETRY"
+	sourceDelay                        "SOURCE_DELAY"
+	sourceHeartbeatPeriod              "SOURCE_HEARTBEAT_PERIOD"
+	sourceHost                         "SOURCE_HOST"
+	sourceLogFile                      "SOURCE_LOG_FILE"
+	sourceLogPos                       "SOURCE_LOG_POS"
+	sourcePassword                     "SOURCE_PASSWORD"
+	sourcePort                         "SOURCE_PORT"
+	sourcePublicKeyPath                "SOURCE_PUBLIC_KEY_PATH"
+	sourceRetryCount                   "SOURCE_RETRY_COUNT"
+	sourceSSL                          "SOURCE_SSL"
+	sourceSSLCA                        "SOURCE_SSL_CA"
+	sourceSSLCAPATH                    "SOURCE_SSL_CAPATH"
+	sourceSSLCert                      "SOURCE_SSL_CERT"
+	sourceSSLCipher                    "SOURCE_SSL_CIPHER"
+	sourceSSLCRL                       "SOURCE_SSL_CRL"
+	sourceSSLCRLPATH                   "SOURCE_SSL_CRLPATH"
+	sourceSSLKey                       "SOURCE_SSL_KEY"
+	sourceSSLVerifyServerCert          "SOURCE_SSL_VERIFY_SERVER_CERT"
+	sourceTLSCiphersuites              "SOURCE_TLS_CIPHERSUITES"
+	sourceTLSVersion                   "SOURCE_TLS_VERSION"
+	sourceUser                         "SOURCE_USER"
+	sourceZstdCompressionLevel         "SOURCE_ZSTD_COMPRESSION_LEVEL"
+	sqlAfterGTIDS                      "SQL_AFTER_GTIDS"
+	sqlAfterMTSGaps                    "SQL_AFTER_MTS_GAPS"
+	sqlBeforeGTIDS                     "SQL_BEFORE_GTIDS"
+	sqlBufferResult                    "SQL_BUFFER_RESULT"
+	sqlCache                           "SQL_CACHE"
+	sqlNoCache                         "SQL_NO_CACHE"
+	sqlThread                          "SQL_THREAD"
+	sqlTsiDay                          "SQL_TSI_DAY"
+	sqlTsiHour                         "SQL_TSI_HOUR"
+	sqlTsiMinute                       "SQL_TSI_MINUTE"
+	sqlTsiMonth                        "SQL_TSI_MONTH"
+	sqlTsiQuarter                      "SQL_TSI_QUARTER"
+	sqlTsiSecond                       "SQL_TSI_SECOND"
+	sqlTsiWeek                         "SQL_TSI_WEEK"
+	sqlTsiYear                         "SQL_TSI_YEAR"
+	srid                               "SRID"
+	stacked                            "STACKED"
+	start                              "START"
+	starts                             "STARTS"
+	statsAutoRecalc                    "STATS_AUTO_RECALC"
+	statsPersistent                    "STATS_PERSISTENT"
+	statsSamplePages                   "STATS_SAMPLE_PAGES"
+	status                             "STATUS"
+	step                               "STEP"
+	storage                            "STORAGE"
+	strictFormat                       "STRICT_FORMAT"
+	stringType                         "STRING"
+	subclassOrigin                     "SUBCLASS_ORIGIN"
+	subject                            "SUBJECT"
+	subpartition                       "SUBPARTITION"
+	subpartitions                      "SUBPARTITIONS"
+	tbpartition                        "TBPARTITION"
+	tbpartitions                       "TBPARTITIONS"
+	dbpartitions                       "DBPARTITIONS"
+	super                              "SUPER"
+	suspend                            "SUSPEND"
+	swaps                              "SWAPS"
+	switchesSym                        "SWITCHES"
+	system                             "SYSTEM"
+	systemTime                         "SYSTEM_TIME"
+	tableChecksum                      "TABLE_CHECKSUM"
+	tableName                          "TABLE_NAME"
+	tableRules                         "TABLE_RULES"
+	tables                             "TABLES"
+	tablespace                         "TABLESPACE"
+	tableAlgorithm                     "TABLE_ALGORITHM"
+	temporary                          "TEMPORARY"
+	temptable                          "TEMPTABLE"
+	tenant                             "TENANT"
+	textType                           "TEXT"
+	than                               "THAN"
+	tikvImporter                       "TIKV_IMPORTER"
+	timestampType                      "TIMESTAMP"
+	timeType                           "TIME"
+	tp                                 "TYPE"
+	trace                              "TRACE"
+	traditional                        "TRADITIONAL"
+	transaction                        "TRANSACTION"
+	transactionRouting                 "TRANSACTION_ROUTING"
+	triggers                           "TRIGGERS"
+	truncate                           "TRUNCATE"
+	unbounded                          "UNBOUNDED"
+	uncommitted                        "UNCOMMITTED"
+	undefined                          "UNDEFINED"
+	unicodeSym                         "UNICODE"
+	unknown                            "UNKNOWN"
+	until                              "UNTIL"
+	user                               "USER"
+	username                           "USERNAME"
+	validation                         "VALIDATION"
+	value                              "VALUE"
+	variables                          "VARIABLES"
+	view                               "VIEW"
+	visible                            "VISIBLE"
+	warnings                           "WARNINGS"
+	week                               "WEEK"
+	weight                             "WEIGHT"
+	weightString                       "WEIGHT_STRING"
+	without                            "WITHOUT"
+	work                               "WORK"
+	workerID                           "WORKER_ID"
+	x509                               "X509"
+	xa                                 "XA"
+	xid                                "XID"
+	yearType                           "YEAR"
+	wait                               "WAIT"
 
 	/* The following tokens belong to NotKeywordToken. Notice: make sure these tokens are contained in NotKeywordToken. */
 	addDate               "ADDDATE"
@@ -819,6 +1004,11 @@ import (
 
 %token not2
 %type	<expr>
+	DiagnosticsTarget      "user variable or local variable"
+	SignalAllowedExpr      "literal or variable allowed in SIGNAL and GET DIAGNOSTICS"
+	ProcedureDefaultOpt    "optional DEFAULT value of local variables"
+	EventStartsOpt         "optional STARTS clause of event"
+	EventEndsOpt           "optional ENDS clause of event"
 	Expression             "expression"
 	MaxValueOrExpression   "maxvalue or expression"
 	BoolPri                "boolean primary expression"
@@ -850,104 +1040,248 @@ import (
 	ProcedureCall          "Procedure call with Identifier or identifier"
 
 %type	<statement>
-	AdminStmt                  "Check table statement or show ddl statement"
-	AlterDatabaseStmt          "Alter database statement"
-	AlterTableStmt             "Alter table statement"
-	AlterUserStmt              "Alter user statement"
-	AlterImportStmt            "ALTER IMPORT statement"
-	AlterInstanceStmt          "Alter instance statement"
-	AlterPolicyStmt            "Alter Placement Policy statement"
-	AlterSequenceStmt          "Alter sequence statement"
-	AnalyzeTableStmt           "Analyze table statement"
-	BeginTransactionStmt       "BEGIN TRANSACTION statement"
-	BinlogStmt                 "Binlog base64 statement"
-	BRIEStmt                   "BACKUP or RESTORE statement"
-	CommitStmt                 "COMMIT statement"
-	CreateTableStmt            "CREATE TABLE statement"
-	CreateViewStmt             "CREATE VIEW  statement"
-	CreateUserStmt             "CREATE User statement"
-	CreateRoleStmt             "CREATE Role statement"
-	CreateDatabaseStmt         "Create Database Statement"
-	CreateIndexStmt            "CREATE INDEX statement"
-	CreateImportStmt           "CREATE IMPORT statement"
-	CreateBindingStmt          "CREATE BINDING  statement"
-	CreatePolicyStmt           "CREATE PLACEMENT POLICY statement"
-	CreateSequenceStmt         "CREATE SEQUENCE statement"
-	CreateStatisticsStmt       "CREATE STATISTICS statement"
-	DoStmt                     "Do statement"
-	DropDatabaseStmt           "DROP DATABASE statement"
-	DropTriggerStmt            "DROP TRIGGER statement"
-	DropImportStmt             "DROP IMPORT statement"
-	DropIndexStmt              "DROP INDEX statement"
-	DropStatisticsStmt         "DROP STATISTICS statement"
-	DropStatsStmt              "DROP STATS statement"
-	DropTableStmt              "DROP TABLE statement"
-	DropSequenceStmt           "DROP SEQUENCE statement"
-	DropUserStmt               "DROP USER"
-	DropRoleStmt               "DROP ROLE"
-	DropViewStmt               "DROP VIEW statement"
-	DropBindingStmt            "DROP BINDING  statement"
-	DropPolicyStmt             "DROP PLACEMENT POLICY statement"
-	DeallocateStmt             "Deallocate prepared statement"
-	DeleteFromStmt             "DELETE FROM statement"
-	DeleteWithoutUsingStmt     "Normal DELETE statement"
-	DeleteWithUsingStmt        "DELETE USING statement"
-	EmptyStmt                  "empty statement"
-	ExecuteStmt                "Execute statement"
-	ExplainStmt                "EXPLAIN statement"
-	ExplainableStmt            "explainable statement"
-	FlushStmt                  "Flush statement"
-	FlashbackTableStmt         "Flashback table statement"
-	GrantStmt                  "Grant statement"
-	GrantProxyStmt             "Grant proxy statement"
-	GrantRoleStmt              "Grant role statement"
-	InsertIntoStmt             "INSERT INTO statement"
-	CallStmt                   "CALL statement"
-	IndexAdviseStmt            "INDEX ADVISE statement"
-	KillStmt                   "Kill statement"
-	LoadDataStmt               "Load data statement"
-	LoadStatsStmt              "Load statistic statement"
-	LockTablesStmt             "Lock tables statement"
-	PlanReplayerStmt           "Plan replayer statement"
-	PreparedStmt               "PreparedStmt"
-	PurgeImportStmt            "PURGE IMPORT statement that removes a IMPORT task record"
-	SelectStmt                 "SELECT statement"
-	SelectStmtWithClause       "common table expression SELECT statement"
-	RenameTableStmt            "rename table statement"
-	RenameUserStmt             "rename user statement"
-	ReplaceIntoStmt            "REPLACE INTO statement"
-	RecoverTableStmt           "recover table statement"
-	ResumeImportStmt           "RESUME IMPORT statement"
-	RevokeStmt                 "Revoke statement"
-	RevokeRoleStmt             "Revoke role statement"
-	RollbackStmt               "ROLLBACK statement"
-	SplitRegionStmt            "Split index region statement"
-	SetStmt                    "Set variable statement"
-	ChangeStmt                 "Change statement"
-	SetBindingStmt             "Set binding statement"
-	SetRoleStmt                "Set active role statement"
-	SetDefaultRoleStmt         "Set default statement for some user"
-	ShowImportStmt             "SHOW IMPORT statement"
-	ShowStmt                   "Show engines/databases/replicas/tables/user/columns/warnings/status statement"
-	Statement                  "statement"
-	StopImportStmt             "STOP IMPORT statement"
-	TraceStmt                  "TRACE statement"
-	TraceableStmt              "traceable statement"
-	TruncateTableStmt          "TRUNCATE TABLE statement"
-	UnlockTablesStmt           "Unlock tables statement"
-	UpdateStmt                 "UPDATE statement"
-	SetOprStmt                 "Union/Except/Intersect select statement"
-	SetOprStmtWithLimitOrderBy "Union/Except/Intersect select statement with limit and order by"
-	SetOprStmtWoutLimitOrderBy "Union/Except/Intersect select statement without limit and order by"
-	UseStmt                    "USE statement"
-	ShutdownStmt               "SHUTDOWN statement"
-	RestartStmt                "RESTART statement"
-	CreateViewSelectOpt        "Select/Union/Except/Intersect statement in CREATE VIEW ... AS SELECT"
-	BindableStmt               "Statement that can be created binding on"
-	UpdateStmtNoWith           "Update statement without CTE clause"
-	HelpStmt                   "HELP statement"
+	GetDiagnosticsStmt          "GET DIAGNOSTICS statement"
+	ResignalStmt                "RESIGNAL statement"
+	SignalStmt                  "SIGNAL statement"
+	AlterRoutineStmt            "ALTER PROCEDURE/FUNCTION statement"
+	CreateFunctionStmt          "CREATE FUNCTION statement"
+	StoredFunctionTail          "CREATE FUNCTION statement for stored functions after the FUNCTION keyword"
+	LoadableFunctionTail        "CREATE FUNCTION statement for loadable functions after the FUNCTION keyword"
+	CreateProcedureStmt         "CREATE PROCEDURE statement"
+	DropRoutineStmt             "DROP PROCEDURE/FUNCTION statement"
+	ProcedureCaseStmt           "CASE statement of stored programs"
+	ProcedureCursorSelect       "cursor select statement"
+	ProcedureDecl               "DECLARE statement of stored programs"
+	ProcedureIfStmt             "IF statement of stored programs"
+	ProcedureLabelableStmt      "stored program statement which can be labeled"
+	ProcedureStatement          "stored program statement"
+	ProcedureUnlabeledStmt      "stored program statement without label"
+	AdminStmt                   "Check table statement or show ddl statement"
+	AlterDatabaseStmt           "Alter database statement"
+	AlterTableStmt              "Alter table statement"
+	AlterUserStmt               "Alter user statement"
+	AlterImportStmt             "ALTER IMPORT statement"
+	AlterInstanceStmt           "Alter instance statement"
+	AlterPolicyStmt             "Alter Placement Policy statement"
+	AlterSequenceStmt           "Alter sequence statement"
+	AnalyzeTableStmt            "Analyze table statement"
+	BeginTransactionStmt        "BEGIN TRANSACTION statement"
+	StartTransactionStmt        "START TRANSACTION statement"
+	BinlogStmt                  "Binlog base64 statement"
+	BRIEStmt                    "BACKUP or RESTORE statement"
+	CommitStmt                  "COMMIT statement"
+	CreateTableStmt             "CREATE TABLE statement"
+	CreateViewStmt              "CREATE VIEW  statement"
+	CreateUserStmt              "CREATE User statement"
+	CreateRoleStmt              "CREATE Role statement"
+	CreateDatabaseStmt          "Create Database Statement"
+	CreateIndexStmt             "CREATE INDEX statement"
+	CreateImportStmt            "CREATE IMPORT statement"
+	CreateBindingStmt           "CREATE BINDING  statement"
+	CreatePolicyStmt            "CREATE PLACEMENT POLICY statement"
+	CreateSequenceStmt          "CREATE SEQUENCE statement"
+	CreateStatisticsStmt        "CREATE STATISTICS statement"
+	CreateTriggerStmt           "CREATE TRIGGER statement"
+	CreateEventStmt             "CREATE EVENT statement"
+	AlterEventStmt              "ALTER EVENT statement"
+	DropEventStmt               "DROP EVENT statement"
+	DoStmt                      "Do statement"
+	DropDatabaseStmt            "DROP DATABASE statement"
+	DropTriggerStmt             "DROP TRIGGER statement"
+	DropImportStmt              "DROP IMPORT statement"
+	DropIndexStmt               "DROP INDEX statement"
+	DropStatisticsStmt          "DROP STATISTICS statement"
+	DropStatsStmt               "DROP STATS statement"
+	DropTableStmt               "DROP TABLE statement"
+	DropSequenceStmt            "DROP SEQUENCE statement"
+	DropUserStmt                "DROP USER"
+	DropRoleStmt                "DROP ROLE"
+	DropViewStmt                "DROP VIEW statement"
+	DropBindingStmt             "DROP BINDING  statement"
+	DropPolicyStmt              "DROP PLACEMENT POLICY statement"
+	DeallocateStmt              "Deallocate prepared statement"
+	DeleteFromStmt              "DELETE FROM statement"
+	DeleteWithoutUsingStmt      "Normal DELETE statement"
+	DeleteWithUsingStmt         "DELETE USING statement"
+	EmptyStmt                   "empty statement"
+	ExecuteStmt                 "Execute statement"
+	ExplainStmt                 "EXPLAIN statement"
+	ExplainShardingStmt         "EXPLAIN SHARDING statement"
+	ExplainableStmt             "explainable statement"
+	FlushStmt                   "Flush statement"
+	FlashbackTableStmt          "Flashback table statement"
+	GrantStmt                   "Grant statement"
+	GrantProxyStmt              "Grant proxy statement"
+	GrantRoleStmt               "Grant role statement"
+	InsertIntoStmt              "INSERT INTO statement"
+	CallStmt                    "CALL statement"
+	IndexAdviseStmt             "INDEX ADVISE statement"
+	KillStmt                    "Kill statement"
+	LoadDataStmt                "Load data statement"
+	LoadStatsStmt               "Load statistic statement"
+	LockTablesStmt              "Lock tables statement"
+	PlanReplayerStmt            "Plan replayer statement"
+	PreparedStmt                "PreparedStmt"
+	PurgeImportStmt             "PURGE IMPORT statement that removes a IMPORT task record"
+	SelectStmt                  "SELECT statement"
+	SelectStmtWithClause        "common table expression SELECT statement"
+	RenameTableStmt             "rename table statement"
+	RenameUserStmt              "rename user statement"
+	ReplaceIntoStmt             "REPLACE INTO statement"
+	RecoverTableStmt            "recover table statement"
+	ResumeImportStmt            "RESUME IMPORT statement"
+	RevokeStmt                  "Revoke statement"
+	RevokeRoleStmt              "Revoke role statement"
+	RollbackStmt                "ROLLBACK statement"
+	SavepointStmt               "SAVEPOINT statement"
+	ReleaseSavepointStmt        "RELEASE SAVEPOINT statement"
+	XAStmt                      "XA transaction statement"
+	SplitRegionStmt             "Split index region statement"
+	SetStmt                     "Set variable statement"
+	ChangeStmt                  "Change statement"
+	ChangeReplicationSourceStmt "CHANGE REPLICATION SOURCE statement"
+	StartReplicaStmt            "START REPLICA statement"
+	StopReplicaStmt             "STOP REPLICA statement"
+	ResetReplicaStmt            "RESET REPLICA statement"
+	ResetMasterStmt             "RESET MASTER statement"
+	ResetPersistStmt            "RESET PERSIST statement"
+	PurgeBinaryLogsStmt         "PURGE BINARY LOGS statement"
+	CreateShardingTableRuleStmt "CREATE SHARDING TABLE RULE statement"
+	AlterShardingTableRuleStmt  "ALTER SHARDING TABLE RULE statement"
+	DropShardingTableRuleStmt   "DROP SHARDING TABLE RULE statement"
+	CreateTenantStmt            "CREATE TENANT statement"
+	AlterTenantStmt             "ALTER TENANT statement"
+	DropTenantStmt              "DROP TENANT statement"
+	CreateNodeStmt              "CREATE NODE statement"
+	AlterNodeStmt               "ALTER NODE statement"
+	DropNodeStmt                "DROP NODE statement"
+	CreateDBGroupStmt           "CREATE DB GROUP statement"
+	AlterDBGroupStmt            "ALTER DB GROUP statement"
+	DropDBGroupStmt             "DROP DB GROUP statement"
+	CreateShadowRuleStmt        "CREATE SHADOW RULE statement"
+	AlterShadowRuleStmt         "ALTER SHADOW RULE statement"
+	DropShadowRuleStmt          "DROP SHADOW RULE statement"
+	SetBindingStmt              "Set binding statement"
+	SetRoleStmt                 "Set active role statement"
+	SetDefaultRoleStmt          "Set default statement for some user"
+	ShowImportStmt              "SHOW IMPORT statement"
+	ShowStmt                    "Show engines/databases/replicas/tables/user/columns/warnings/status statement"
+	Statement                   "statement"
+	StopImportStmt              "STOP IMPORT statement"
+	TraceStmt                   "TRACE statement"
+	TraceableStmt               "traceable statement"
+	ShardingPreviewableStmt     "statement which can be previewed by EXPLAIN SHARDING"
+	TruncateTableStmt           "TRUNCATE TABLE statement"
+	UnlockTablesStmt            "Unlock tables statement"
+	UpdateStmt                  "UPDATE statement"
+	SetOprStmt                  "Union/Except/Intersect select statement"
+	SetOprStmtWithLimitOrderBy  "Union/Except/Intersect select statement with limit and order by"
+	SetOprStmtWoutLimitOrderBy  "Union/Except/Intersect select statement without limit and order by"
+	UseStmt                     "USE statement"
+	ShutdownStmt                "SHUTDOWN statement"
+	RestartStmt                 "RESTART statement"
+	CreateViewSelectOpt         "Select/Union/Except/Intersect statement in CREATE VIEW ... AS SELECT"
+	BindableStmt                "Statement that can be created binding on"
+	UpdateStmtNoWith            "Update statement without CTE clause"
+	HelpStmt                    "HELP statement"
 
 %type	<item>
+	ConditionInfoItem                      "condition information item of GET DIAGNOSTICS"
+	ConditionInfoItemList                  "condition information item list of GET DIAGNOSTICS"
+	ConditionInfoItemName                  "condition information item name"
+	DiagnosticsAreaOpt                     "optional CURRENT or STACKED"
+	ProcedureSQLState                      "SQLSTATE value"
+	SignalConditionValue                   "SQLSTATE value or condition name of SIGNAL/RESIGNAL"
+	SignalInfoItem                         "SET item of SIGNAL/RESIGNAL"
+	SignalInfoItemList                     "SET item list of SIGNAL/RESIGNAL"
+	SignalInfoItemListOpt                  "optional SET item list of SIGNAL/RESIGNAL"
+	StatementInfoItem                      "statement information item of GET DIAGNOSTICS"
+	StatementInfoItemList                  "statement information item list of GET DIAGNOSTICS"
+	ValueOpt                               "optional VALUE keyword"
+	ReplicationSourceOptionList            "CHANGE REPLICATION SOURCE option list"
+	ReplicationSourceOption                "CHANGE REPLICATION SOURCE option"
+	ReplicationSourceStringOptionName      "CHANGE REPLICATION SOURCE string option name"
+	ReplicationSourceIntegerOptionName     "CHANGE REPLICATION SOURCE integer option name"
+	ReplicationSourceBooleanOptionName     "CHANGE REPLICATION SOURCE boolean option name"
+	ReplicationServerIDListOpt             "IGNORE_SERVER_IDS list or empty"
+	ReplicationServerIDList                "IGNORE_SERVER_IDS list"
+	ReplicaOrSlave                         "REPLICA or SLAVE"
+	ReplicaThreadTypeListOpt               "replication thread type list or empty"
+	ReplicaThreadTypeList                  "replication thread type list"
+	ReplicaThreadType                      "replication thread type"
+	ReplicaUntilOpt                        "START REPLICA UNTIL clause or empty"
+	BinaryOrMaster                         "BINARY or MASTER"
+	ShowBinlogFromOpt                      "SHOW BINLOG EVENTS FROM clause or empty"
+	ShardingTableRuleList                  "sharding table rule list"
+	ShardingTableRule                      "sharding table rule"
+	ShardingTableRuleOptionList            "sharding table rule option list"
+	ShardingTableRuleOption                "sharding table rule option"
+	ShardingAlgorithm                      "sharding algorithm definition"
+	ShardingPropertiesOpt                  "optional sharding algorithm properties"
+	ShardingPropertyList                   "sharding algorithm property list"
+	ShardingProperty                       "sharding algorithm property"
+	AlterTenantSpecList                    "ALTER TENANT specification list"
+	AlterTenantSpec                        "ALTER TENANT specification"
+	NodeOptionList                         "node option list"
+	NodeOption                             "node option"
+	NodeLabelList                          "node label list"
+	NodeLabel                              "node label"
+	DBGroupOptionList                      "DB group option list"
+	DBGroupOption                          "DB group option"
+	DBGroupReplicaListOpt                  "DB group replica list or empty"
+	DBGroupReplicaList                     "DB group replica list"
+	DBGroupReplica                         "DB group replica"
+	GroupsKwd                              "GROUPS keyword"
+	ShadowRuleList                         "shadow rule list"
+	ShadowRule                             "shadow rule"
+	ShadowMatcherListOpt                   "shadow matcher list or empty"
+	ShadowMatcher                          "shadow matcher"
+	ShadowOperationsOpt                    "ON clause of shadow matcher or empty"
+	ShadowOperationList                    "shadow operation list"
+	ShadowOperation                        "shadow operation"
+	CheckShardingTableItemsOpt             "WITH clause of CHECK SHARDING TABLE or empty"
+	CheckShardingTableItemList             "CHECK SHARDING TABLE item list"
+	CheckShardingTableItem                 "CHECK SHARDING TABLE item"
+	CastArrayOpt                           "optional ARRAY keyword in CAST and CONVERT"
+	EventSchedule                          "ON SCHEDULE clause of event"
+	AlterEventScheduleOpt                  "optional ON SCHEDULE and ON COMPLETION clauses of ALTER EVENT"
+	EventCompletion                        "ON COMPLETION clause of event"
+	EventCompletionOpt                     "optional ON COMPLETION clause of event"
+	EventStatusOpt                         "optional ENABLE or DISABLE clause of event"
+	EventRenameOpt                         "optional RENAME TO clause of ALTER EVENT"
+	EventBodyOpt                           "optional DO clause of ALTER EVENT"
+	JSONTable                              "JSON_TABLE table function"
+	JSONTableColumn                        "JSON_TABLE column definition"
+	JSONTableColumnList                    "JSON_TABLE column definition list"
+	JSONTableColumns                       "COLUMNS clause of JSON_TABLE"
+	JSONTableOnEmptyOnErrorOpt             "optional ON EMPTY and ON ERROR clauses of JSON_TABLE column"
+	JSONTableResponse                      "NULL, ERROR or DEFAULT response of JSON_TABLE column"
+	XABeginOrStart                         "BEGIN or START of XA START"
+	XID                                    "XA transaction identifier"
+	XIDFormatID                            "formatID of XA transaction identifier"
+	CreateRoutineCharacteristic            "stored routine characteristic of CREATE PROCEDURE/FUNCTION"
+	CreateRoutineCharacteristicListOpt     "stored routine characteristic list of CREATE PROCEDURE/FUNCTION"
+	FunctionParam                          "stored function parameter"
+	FunctionParamList                      "stored function parameter list"
+	FunctionParamListOpt                   "optional stored function parameter list"
+	ProcedureConditionCode                 "error code or SQLSTATE value of a condition"
+	ProcedureDeclListOpt                   "optional DECLARE statement list"
+	ProcedureElseIfListOpt                 "optional ELSEIF branch list"
+	ProcedureElseOpt                       "optional ELSE branch"
+	ProcedureHandlerAction                 "handler action"
+	ProcedureHandlerCondition              "handler condition value"
+	ProcedureHandlerConditionList          "handler condition value list"
+	ProcedureParam                         "stored procedure parameter"
+	ProcedureParamList                     "stored procedure parameter list"
+	ProcedureParamListOpt                  "optional stored procedure parameter list"
+	ProcedureParamMode                     "stored procedure parameter mode"
+	ProcedureStatementList                 "stored program statement list"
+	ProcedureStatementListOpt              "optional stored program statement list"
+	ProcedureWhen                          "WHEN branch of CASE statement"
+	ProcedureWhenList                      "WHEN branch list of CASE statement"
+	RoutineCharacteristic                  "stored routine characteristic"
+	RoutineCharacteristicListOpt           "stored routine characteristic list"
+	RoutineType                            "PROCEDURE or FUNCTION"
 	AdminShowSlow                          "Admin Show Slow statement"
 	AllOrPartitionNameList                 "All or partition name list"
 	AlgorithmClause                        "Alter table algorithm"
@@ -999,6 +1333,7 @@ import (
 	CreateSequenceOptionListOpt            "create sequence list opt"
 	CreateTableOptionListOpt               "create table option list opt"
 	CreateTableSelectOpt                   "Select/Union statement in CREATE TABLE ... SELECT"
+	CreateViewPrefix                       "OR REPLACE, ALGORITHM and DEFINER in CREATE VIEW"
 	DatabaseOption                         "CREATE Database specification"
 	DatabaseOptionList                     "CREATE Database specification list"
 	DatabaseOptionListOpt                  "CREATE Database specification list opt"
@@ -1076,6 +1411,7 @@ import (
 	LoadDataSetSpecOpt                     "Optional load data specification"
 	LoadDataSetList                        "Load data specifications"
 	LoadDataSetItem                        "Single load data specification"
+	LoadableFunctionReturnType             "return type of loadable functions"
 	LocalOpt                               "Local opt"
 	LockClause                             "Alter table lock clause"
 	LogTypeOpt                             "Optional log type used in FLUSH statements"
@@ -1083,6 +1419,8 @@ import (
 	NoWriteToBinLogAliasOpt                "NO_WRITE_TO_BINLOG alias LOCAL or empty"
 	ObjectType                             "Grant statement object type"
 	OnDuplicateKeyUpdate                   "ON DUPLICATE KEY UPDATE value list"
+	InsertRowAlias                         "row alias of INSERT"
+	InsertRowAliasOpt                      "optional row alias of INSERT"
 	OnCommitOpt                            "ON COMMIT DELETE |PRESERVE ROWS"
 	DuplicateOpt                           "[IGNORE|REPLACE] in CREATE TABLE ... SELECT statement or LOAD DATA statement"
 	OfTablesOpt                            "OF table_name [, ...]"
@@ -1144,6 +1482,8 @@ import (
 	RowValue                               "Row value"
 	RowStmt                                "Row constructor"
 	SelectLockOpt                          "SELECT lock options"
+	SelectLockClause                       "SELECT locking clause"
+	SelectLockClauseList                   "SELECT locking clause list"
 	SelectStmtSQLCache                     "SELECT statement optional SQL_CAHCE/SQL_NO_CACHE"
 	SelectStmtFieldList                    "SELECT statement field list"
 	SelectStmtLimit                        "SELECT statement LIMIT clause"
@@ -1156,8 +1496,12 @@ import (
 	SelectStmtFromTable                    "SELECT statement from table"
 	SelectStmtGroup                        "SELECT statement optional GROUP BY clause"
 	SelectStmtIntoOption                   "SELECT statement into clause"
+	SelectIntoClause                       "SELECT statement into clause which is not empty"
+	SelectIntoVar                          "SELECT ... INTO target variable"
+	SelectIntoVarList                      "SELECT ... INTO target variable list"
 	SequenceOption                         "Create sequence option"
 	SequenceOptionList                     "Create sequence option list"
+	SequenceType                           "sequence type managed by the proxy"
 	SetRoleOpt                             "Set role options"
 	SetDefaultRoleOpt                      "Set default role options"
 	SetOpr                                 "Set operator contain UNION, EXCEPT and INTERSECT"
@@ -1217,6 +1561,9 @@ import (
 	LockType                               "Table locks type"
 	TransactionChar                        "Transaction characteristic"
 	TransactionChars                       "Transaction characteristic list"
+	TriggerEvent                           "Trigger event: INSERT, UPDATE or DELETE"
+	TriggerOrderOpt                        "Optional trigger order: FOLLOWS or PRECEDES"
+	TriggerTiming                          "Trigger action time: BEFORE or AFTER"
 	TrimDirection                          "Trim string direction"
 	SetOprOpt                              "Union/Except/Intersect Option(empty/ALL/DISTINCT)"
 	Username                               "Username"
@@ -1234,6 +1581,7 @@ import (
 	VariableAssignment                     "set variable value"
 	VariableAssignmentList                 "set variable value list"
 	ViewAlgorithm                          "view algorithm"
+	ViewAlgorithmType                      "view algorithm type"
 	ViewCheckOption                        "view check option"
 	ViewDefiner                            "view definer"
 	ViewName                               "view name"
@@ -1291,6 +1639,7 @@ import (
 	BlobType                               "Blob types"
 	TextType                               "Text types"
 	DateAndTimeType                        "Date and Time types"
+	SpatialType                            "Spatial types"
 	OptFieldLen                            "Field length or empty"
 	FieldLen                               "Field length"
 	FieldOpts                              "Field type definition option list"
@@ -1337,6 +1686,7 @@ import (
 	KeyOrIndex        "{KEY|INDEX}"
 	ColumnKeywordOpt  "Column keyword or empty"
 	PrimaryOpt        "Optional primary keyword"
+	WorkOpt           "Optional WORK keyword"
 	NowSym            "CURRENT_TIMESTAMP/LOCALTIME/LOCALTIMESTAMP"
 	NowSymFunc        "CURRENT_TIMESTAMP/LOCALTIME/LOCALTIMESTAMP/NOW"
 	DefaultKwdOpt     "optional DEFAULT keyword"
@@ -1373,42 +1723,54 @@ import (
 	RowOrRows         "ROW or ROWS"
 
 %type	<ident>
-	Identifier                      "identifier or unreserved keyword"
-	NotKeywordToken                 "Tokens not mysql keyword but treated specially"
-	UnReservedKeyword               "MySQL unreserved keywords"
-	TiDBKeyword                     "TiDB added keywords"
-	FunctionNameConflict            "Built-in function call names which are conflict with keywords"
-	FunctionNameOptionalBraces      "Function with optional braces, all of them are reserved keywords."
-	FunctionNameDatetimePrecision   "Function with optional datetime precision, all of them are reserved keywords."
-	FunctionNameDateArith           "Date arith function call names (date_add or date_sub)"
-	FunctionNameDateArithMultiForms "Date arith function call names (adddate or subdate)"
-	VariableName                    "A simple Identifier like xx or the xx.xx form"
-	ConfigItemName                  "A config item like aa or aa.bb or aa.bb-cc.dd"
-	AuthString                      "Password string value"
-	AuthPlugin                      "Authentication plugin name"
-	CharsetName                     "Character set name"
-	CollationName                   "Collation name"
-	ColumnFormat                    "Column format"
-	DBName                          "Database Name"
-	PolicyName                      "Placement Policy Name"
-	ExplainFormatType               "explain format type"
-	FieldAsName                     "Field alias name"
-	FieldAsNameOpt                  "Field alias name opt"
-	FieldTerminator                 "Field terminator"
-	FlashbackToNewName              "Flashback to new name"
-	HashString                      "Hashed string"
-	LikeEscapeOpt                   "like escape option"
-	LinesTerminated                 "Lines terminated by"
-	OptCharset                      "Optional Character setting"
-	OptCollate                      "Optional Collate setting"
-	PasswordOpt                     "Password option"
-	RoleNameString                  "role name string"
-	ShowDatabaseNameOpt             "Show tables/columns statement database name option"
-	Starting                        "Starting by"
-	StringName                      "string literal or identifier"
-	StringNameOrBRIEOptionKeyword   "string literal or identifier or keyword used for BRIE options"
-	Symbol                          "Constraint Symbol"
-	Tenant                          "Tenant Name"
+	Identifier                       "identifier or unreserved keyword"
+	NotKeywordToken                  "Tokens not mysql keyword but treated specially"
+	UnReservedKeyword                "MySQL unreserved keywords"
+	TiDBKeyword                      "TiDB added keywords"
+	FunctionNameConflict             "Built-in function call names which are conflict with keywords"
+	FunctionNameOptionalBraces       "Function with optional braces, all of them are reserved keywords."
+	FunctionNameDatetimePrecision    "Function with optional datetime precision, all of them are reserved keywords."
+	FunctionNameDateArith            "Date arith function call names (date_add or date_sub)"
+	FunctionNameDateArithMultiForms  "Date arith function call names (adddate or subdate)"
+	VariableName                     "A simple Identifier like xx or the xx.xx form"
+	ConfigItemName                   "A config item like aa or aa.bb or aa.bb-cc.dd"
+	AuthString                       "Password string value"
+	AuthPlugin                       "Authentication plugin name"
+	CharsetName                      "Character set name"
+	CollationName                    "Collation name"
+	ColumnFormat                     "Column format"
+	DBName                           "Database Name"
+	PolicyName                       "Placement Policy Name"
+	ProcedureEndLabelOpt             "optional end label"
+	ProcedureLabel                   "label of stored program statement"
+	ReplicationChannelOpt            "FOR CHANNEL clause or empty"
+	ReplicationSourceHeartbeatPeriod "SOURCE_HEARTBEAT_PERIOD or MASTER_HEARTBEAT_PERIOD"
+	ReplicaUntilSourceLogFile        "SOURCE_LOG_FILE or MASTER_LOG_FILE"
+	ReplicaUntilSourceLogPos         "SOURCE_LOG_POS or MASTER_LOG_POS"
+	ReplicaUserOpt                   "START REPLICA USER option or empty"
+	ReplicaPasswordOpt               "START REPLICA PASSWORD option or empty"
+	ReplicaDefaultAuthOpt            "START REPLICA DEFAULT_AUTH option or empty"
+	ReplicaPluginDirOpt              "START REPLICA PLUGIN_DIR option or empty"
+	ShowBinlogInOpt                  "SHOW BINLOG EVENTS IN clause or empty"
+	EventCommentOpt                  "optional COMMENT clause of event"
+	ExplainFormatType                "explain format type"
+	FieldAsName                      "Field alias name"
+	FieldAsNameOpt                   "Field alias name opt"
+	FieldTerminator                  "Field terminator"
+	FlashbackToNewName               "Flashback to new name"
+	HashString                       "Hashed string"
+	LikeEscapeOpt                    "like escape option"
+	LinesTerminated                  "Lines terminated by"
+	OptCharset                       "Optional Character setting"
+	OptCollate                       "Optional Collate setting"
+	PasswordOpt                      "Password option"
+	RoleNameString                   "role name string"
+	ShowDatabaseNameOpt              "Show tables/columns statement database name option"
+	Starting                         "Starting by"
+	StringName                       "string literal or identifier"
+	StringNameOrBRIEOptionKeyword    "string literal or identifier or keyword used for BRIE options"
+	Symbol                           "Constraint Symbol"
+	Tenant                           "Tenant Name"
 
 %precedence empty
 %precedence as
@@ -1425,6 +1787,8 @@ import (
 %precedence stringLit
 %precedence lowerThanSetKeyword
 %precedence set
+%precedence lowerThanInto
+%precedence into
 %precedence selectKwd
 %precedence lowerThanSelectStmt
 %precedence lowerThanInsertValues
@@ -1459,7 +1823,7 @@ import (
 %left andand and
 %left between
 %precedence lowerThanEq
-%left eq ge le neq neqSynonym '>' '<' is like in
+%left eq ge le neq neqSynonym '>' '<' is like in member
 %left '|'
 %left '&'
 %left rsh lsh
@@ -2751,7 +3115,10 @@ BeginTransactionStmt:
 			Mode: ast.Optimistic,
 		}
 	}
-|	"START" "TRANSACTION"
+|	StartTransactionStmt
+
+StartTransactionStmt:
+	"START" "TRANSACTION"
 	{
 		$$ = &ast.BeginStmt{}
 	}
@@ -2913,15 +3280,19 @@ ColumnNameOrUserVarListOptWithBrackets:
 	}
 
 CommitStmt:
-	"COMMIT"
+	"COMMIT" WorkOpt
 	{
 		$$ = &ast.CommitStmt{}
 	}
-|	"COMMIT" CompletionTypeWithinTransaction
+|	"COMMIT" WorkOpt CompletionTypeWithinTransaction
 	{
-		$$ = &ast.CommitStmt{CompletionType: $2.(ast.CompletionType)}
+		$$ = &ast.CommitStmt{CompletionType: $3.(ast.CompletionType)}
 	}
 
+WorkOpt:
+	{}
+|	"WORK"
+
 PrimaryOpt:
 	{}
 |	"PRIMARY"
@@ -3083,6 +3454,15 @@ ColumnOption:
 	{
 		$$ = &ast.ColumnOption{Tp: ast.ColumnOptionAutoRandom, AutoRandomBitLength: $2.(int)}
 	}
+|	"SRID" LengthNum
+	{
+		srid := $2.(uint64)
+		if srid > math.MaxUint32 {
+			yylex.AppendError(yylex.Errorf("The SRID %d is out of range", srid))
+			return 1
+		}
+		$$ = &ast.ColumnOption{Tp: ast.ColumnOptionSrid, Srid: uint32(srid)}
+	}
 
 StorageMedia:
 	"DEFAULT"
@@ -3177,6 +3557,19 @@ ConstraintElem:
 		}
 		$$ = c
 	}
+|	"SPATIAL" KeyOrIndexOpt IndexName '(' IndexPartSpecificationList ')' IndexOptionList
+	{
+		c := &ast.Constraint{
+			Tp:           ast.ConstraintSpatial,
+			Keys:         $5.([]*ast.IndexPartSpecification),
+			Name:         $3.(*ast.NullString).String,
+			IsEmptyIndex: $3.(*ast.NullString).Empty,
+		}
+		if $7 != nil {
+			c.Option = $7.(*ast.IndexOption)
+		}
+		$$ = c
+	}
 |	KeyOrIndex IfNotExists IndexNameAndTypeOpt '(' IndexPartSpecificationList ')' IndexOptionList
 	{
 		c := &ast.Constraint{
@@ -4262,24 +4655,20 @@ LikeTableWithOrWithoutParen:
  *          as select Col1,Col2 from table WITH LOCAL CHECK OPTION
  *******************************************************************/
 CreateViewStmt:
-	"CREATE" OrReplace ViewAlgorithm ViewDefiner ViewSQLSecurity "VIEW" ViewName ViewFieldList "AS" CreateViewSelectOpt ViewCheckOption
+	"CREATE" CreateViewPrefix ViewSQLSecurity "VIEW" ViewName ViewFieldList "AS" CreateViewSelectOpt ViewCheckOption
 	{
 		startOffset := parser.startOffset(&yyS[yypt-1])
-		selStmt := $10.(ast.StmtNode)
+		selStmt := $8.(ast.StmtNode)
 		selStmt.SetText(parser.lexer.client, strings.TrimSpace(parser.src[startOffset:]))
-		x := &ast.CreateViewStmt{
-			OrReplace: $2.(bool),
-			ViewName:  $7.(*ast.TableName),
-			Select:    selStmt,
-			Algorithm: $3.(model.ViewAlgorithm),
-			Definer:   $4.(*auth.UserIdentity),
-			Security:  $5.(model.ViewSecurity),
-		}
-		if $8 != nil {
-			x.Cols = $8.([]model.CIStr)
+		x := $2.(*ast.CreateViewStmt)
+		x.ViewName = $5.(*ast.TableName)
+		x.Select = selStmt
+		x.Security = $3.(model.ViewSecurity)
+		if $6 != nil {
+			x.Cols = $6.([]model.CIStr)
 		}
-		if $11 != nil {
-			x.CheckOption = $11.(model.ViewCheckOption)
+		if $9 != nil {
+			x.CheckOption = $9.(model.ViewCheckOption)
 			endOffset := parser.startOffset(&yyS[yypt])
 			selStmt.SetText(parser.lexer.client, strings.TrimSpace(parser.src[startOffset:endOffset]))
 		} else {
@@ -4298,20 +4687,54 @@ OrReplace:
 		$$ = true
 	}
 
+/*
+ * CreateViewPrefix is split by whether OR REPLACE or ALGORITHM is present, so that
+ * "CREATE DEFINER = ..." stays a common prefix of views, triggers and stored programs.
+ */
+CreateViewPrefix:
+	ViewDefiner
+	{
+		$$ = &ast.CreateViewStmt{
+			Algorithm: model.AlgorithmUndefined,
+			Definer:   $1.(*auth.UserIdentity),
+		}
+	}
+|	"OR" "REPLACE" ViewAlgorithm ViewDefiner
+	{
+		$$ = &ast.CreateViewStmt{
+			OrReplace: true,
+			Algorithm: $3.(model.ViewAlgorithm),
+			Definer:   $4.(*auth.UserIdentity),
+		}
+	}
+|	"ALGORITHM" "=" ViewAlgorithmType ViewDefiner
+	{
+		$$ = &ast.CreateViewStmt{
+			Algorithm: $3.(model.ViewAlgorithm),
+			Definer:   $4.(*auth.UserIdentity),
+		}
+	}
+
 ViewAlgorithm:
 	/* EMPTY */
 	{
 		$$ = model.AlgorithmUndefined
 	}
-|	"ALGORITHM" "=" "UNDEFINED"
+|	"ALGORITHM" "=" ViewAlgorithmType
+	{
+		$$ = $3
+	}
+
+ViewAlgorithmType:
+	"UNDEFINED"
 	{
 		$$ = model.AlgorithmUndefined
 	}
-|	"ALGORITHM" "=" "MERGE"
+|	"MERGE"
 	{
 		$$ = model.AlgorithmMerge
 	}
-|	"ALGORITHM" "=" "TEMPTABLE"
+|	"TEMPTABLE"
 	{
 		$$ = model.AlgorithmTemptable
 	}
@@ -4493,1686 +4916,2922 @@ DropDatabaseStmt:
 TriggerSym:
 	"TRIGGER"
 
-DropTriggerStmt:
-	"DROP" TriggerSym IfExists TableName
-	{
-		$$ = &ast.DropTriggerStmt{IfExists: $3.(bool), Trigger: $4.(*ast.TableName)}
-	}
-
-/******************************************************************
- * Drop Index Statement
- * See https://dev.mysql.com/doc/refman/8.0/en/drop-index.html
- *
- *  DROP INDEX index_name ON tbl_name
- *      [algorithm_option | lock_option] ...
+/*******************************************************************
  *
- *  algorithm_option:
- *      ALGORITHM [=] {DEFAULT|INPLACE|COPY}
+ *  Create Trigger Statement
  *
- *  lock_option:
- *      LOCK [=] {DEFAULT|NONE|SHARED|EXCLUSIVE}
- ******************************************************************/
-DropIndexStmt:
-	"DROP" "INDEX" IfExists Identifier "ON" TableName IndexLockAndAlgorithmOpt
+ *  Example:
+ *      CREATE DEFINER = 'root'@'%' TRIGGER ins_sum BEFORE INSERT ON account
+ *          FOR EACH ROW FOLLOWS ins_log SET @sum = @sum + NEW.amount
+ *******************************************************************/
+CreateTriggerStmt:
+	"CREATE" ViewDefiner TriggerSym IfNotExists TableName TriggerTiming TriggerEvent "ON" TableName "FOR" "EACH" "ROW" TriggerOrderOpt ProcedureStatement
 	{
-		var indexLockAndAlgorithm *ast.IndexLockAndAlgorithm
-		if $7 != nil {
-			indexLockAndAlgorithm = $7.(*ast.IndexLockAndAlgorithm)
-			if indexLockAndAlgorithm.LockTp == ast.LockTypeDefault && indexLockAndAlgorithm.AlgorithmTp == ast.AlgorithmTypeDefault {
-				indexLockAndAlgorithm = nil
-			}
+		startOffset := parser.startOffset(&yyS[yypt])
+		body := $14
+		body.SetText(parser.lexer.client, strings.TrimSpace(parser.src[startOffset:]))
+		markLocalAssignments(body, nil, true)
+		x := &ast.CreateTriggerStmt{
+			IfNotExists: $4.(bool),
+			Definer:     $2.(*auth.UserIdentity),
+			Trigger:     $5.(*ast.TableName),
+			Timing:      $6.(ast.TriggerTiming),
+			Event:       $7.(ast.TriggerEvent),
+			Table:       $9.(*ast.TableName),
+			Body:        body,
 		}
-		$$ = &ast.DropIndexStmt{IfExists: $3.(bool), IndexName: $4, Table: $6.(*ast.TableName), LockAlg: indexLockAndAlgorithm}
+		if $13 != nil {
+			x.Order = $13.(*ast.TriggerOrder)
+		}
+		$$ = x
 	}
 
-DropTableStmt:
-	"DROP" OptTemporary TableOrTables IfExists TableNameList RestrictOrCascadeOpt
+TriggerTiming:
+	"BEFORE"
 	{
-		$$ = &ast.DropTableStmt{IfExists: $4.(bool), Tables: $5.([]*ast.TableName), IsView: false, TemporaryKeyword: $2.(ast.TemporaryKeyword)}
+		$$ = ast.TriggerBefore
+	}
+|	"AFTER"
+	{
+		$$ = ast.TriggerAfter
 	}
 
-OptTemporary:
-	/* empty */
+TriggerEvent:
+	"INSERT"
 	{
-		$$ = ast.TemporaryNone
+		$$ = ast.TriggerEventInsert
 	}
-|	"TEMPORARY"
+|	"UPDATE"
 	{
-		$$ = ast.TemporaryLocal
+		$$ = ast.TriggerEventUpdate
 	}
-|	"GLOBAL" "TEMPORARY"
+|	"DELETE"
 	{
-		$$ = ast.TemporaryGlobal
+		$$ = ast.TriggerEventDelete
 	}
 
-DropViewStmt:
-	"DROP" "VIEW" TableNameList RestrictOrCascadeOpt
+TriggerOrderOpt:
 	{
-		$$ = &ast.DropTableStmt{Tables: $3.([]*ast.TableName), IsView: true}
+		$$ = nil
 	}
-|	"DROP" "VIEW" "IF" "EXISTS" TableNameList RestrictOrCascadeOpt
+|	"FOLLOWS" Identifier
 	{
-		$$ = &ast.DropTableStmt{IfExists: true, Tables: $5.([]*ast.TableName), IsView: true}
+		$$ = &ast.TriggerOrder{Tp: ast.TriggerOrderFollows, OtherTrigger: model.NewCIStr($2)}
 	}
-
-DropUserStmt:
-	"DROP" "USER" UsernameList
+|	"PRECEDES" Identifier
 	{
-		$$ = &ast.DropUserStmt{IsDropRole: false, IfExists: false, UserList: $3.([]*auth.UserIdentity)}
+		$$ = &ast.TriggerOrder{Tp: ast.TriggerOrderPrecedes, OtherTrigger: model.NewCIStr($2)}
 	}
-|	"DROP" "USER" "IF" "EXISTS" UsernameList
+
+DropTriggerStmt:
+	"DROP" TriggerSym IfExists TableName
 	{
-		$$ = &ast.DropUserStmt{IsDropRole: false, IfExists: true, UserList: $5.([]*auth.UserIdentity)}
+		$$ = &ast.DropTriggerStmt{IfExists: $3.(bool), Trigger: $4.(*ast.TableName)}
 	}
 
-DropRoleStmt:
-	"DROP" "ROLE" RolenameList
+/*******************************************************************
+ *
+ *  Create/Alter/Drop Event Statement
+ *
+ *  See https://dev.mysql.com/doc/refman/8.0/en/create-event.html
+ *******************************************************************/
+CreateEventStmt:
+	"CREATE" ViewDefiner "EVENT" IfNotExists TableName "ON" "SCHEDULE" EventSchedule EventCompletionOpt EventStatusOpt EventCommentOpt "DO" ProcedureStatement
 	{
-		tmp := make([]*auth.UserIdentity, 0, 10)
-		roleList := $3.([]*auth.RoleIdentity)
-		for _, r := range roleList {
-			tmp = append(tmp, &auth.UserIdentity{Username: r.Username, Hostname: r.Hostname})
+		startOffset := parser.startOffset(&yyS[yypt])
+		body := $13
+		body.SetText(parser.lexer.client, strings.TrimSpace(parser.src[startOffset:]))
+		markLocalAssignments(body, nil, false)
+		$$ = &ast.CreateEventStmt{
+			Definer:      $2.(*auth.UserIdentity),
+			IfNotExists:  $4.(bool),
+			Name:         $5.(*ast.TableName),
+			Schedule:     $8.(*ast.EventSchedule),
+			OnCompletion: $9.(ast.EventCompletion),
+			Status:       $10.(ast.EventStatus),
+			Comment:      $11,
+			Body:         body,
+		}
+	}
+
+AlterEventStmt:
+	"ALTER" ViewDefiner "EVENT" TableName AlterEventScheduleOpt EventRenameOpt EventStatusOpt EventCommentOpt EventBodyOpt
+	{
+		x := $5.(*ast.AlterEventStmt)
+		x.Definer = $2.(*auth.UserIdentity)
+		x.Name = $4.(*ast.TableName)
+		x.Status = $7.(ast.EventStatus)
+		x.Comment = $8
+		if $6 != nil {
+			x.RenameTo = $6.(*ast.TableName)
 		}
-		$$ = &ast.DropUserStmt{IsDropRole: true, IfExists: false, UserList: tmp}
-	}
-|	"DROP" "ROLE" "IF" "EXISTS" RolenameList
-	{
-		tmp := make([]*auth.UserIdentity, 0, 10)
-		roleList := $5.([]*auth.RoleIdentity)
-		for _, r := range roleList {
-			tmp = append(tmp, &auth.UserIdentity{Username: r.Username, Hostname: r.Hostname})
+		if $9 != nil {
+			x.Body = $9.(ast.StmtNode)
 		}
-		$$ = &ast.DropUserStmt{IsDropRole: true, IfExists: true, UserList: tmp}
+		if x.Schedule == nil && x.OnCompletion == ast.EventCompletionUnspecified && x.RenameTo == nil &&
+			x.Status == ast.EventStatusUnspecified && x.Comment == "" && x.Body == nil {
+			yylex.AppendError(yylex.Errorf("ALTER EVENT requires at least one clause to change"))
+			return 1
+		}
+		$$ = x
 	}
 
-DropStatsStmt:
-	"DROP" "STATS" TableName
+DropEventStmt:
+	"DROP" "EVENT" IfExists TableName
 	{
-		$$ = &ast.DropStatsStmt{Table: $3.(*ast.TableName)}
+		$$ = &ast.DropEventStmt{IfExists: $3.(bool), Name: $4.(*ast.TableName)}
 	}
-|	"DROP" "STATS" TableName "PARTITION" PartitionNameList
+
+EventSchedule:
+	"AT" Expression
 	{
-		$$ = &ast.DropStatsStmt{
-			Table:          $3.(*ast.TableName),
-			PartitionNames: $5.([]model.CIStr),
-		}
+		$$ = &ast.EventSchedule{At: $2}
 	}
-|	"DROP" "STATS" TableName "GLOBAL"
+|	"EVERY" Expression TimeUnit EventStartsOpt EventEndsOpt
 	{
-		$$ = &ast.DropStatsStmt{
-			Table:         $3.(*ast.TableName),
-			IsGlobalStats: true,
+		$$ = &ast.EventSchedule{
+			Every:  $2,
+			Unit:   $3.(ast.TimeUnitType),
+			Starts: $4,
+			Ends:   $5,
 		}
 	}
 
-RestrictOrCascadeOpt:
-	{}
-|	"RESTRICT"
-|	"CASCADE"
-
-TableOrTables:
-	"TABLE"
-|	"TABLES"
-
-EqOpt:
-	{}
-|	eq
-
-EmptyStmt:
-	/* EMPTY */
+AlterEventScheduleOpt:
 	{
-		$$ = nil
+		$$ = &ast.AlterEventStmt{}
+	}
+|	"ON" "SCHEDULE" EventSchedule
+	{
+		$$ = &ast.AlterEventStmt{Schedule: $3.(*ast.EventSchedule)}
+	}
+|	EventCompletion
+	{
+		$$ = &ast.AlterEventStmt{OnCompletion: $1.(ast.EventCompletion)}
+	}
+|	"ON" "SCHEDULE" EventSchedule EventCompletion
+	{
+		$$ = &ast.AlterEventStmt{Schedule: $3.(*ast.EventSchedule), OnCompletion: $4.(ast.EventCompletion)}
 	}
 
-TraceStmt:
-	"TRACE" TraceableStmt
+EventStartsOpt:
 	{
-		$$ = &ast.TraceStmt{
-			Stmt:      $2,
-			Format:    "row",
-			TracePlan: false,
-		}
-		startOffset := parser.startOffset(&yyS[yypt])
-		$2.SetText(parser.lexer.client, string(parser.src[startOffset:]))
+		$$ = nil
 	}
-|	"TRACE" "FORMAT" "=" stringLit TraceableStmt
+|	"STARTS" Expression
 	{
-		$$ = &ast.TraceStmt{
-			Stmt:      $5,
-			Format:    $4,
-			TracePlan: false,
-		}
-		startOffset := parser.startOffset(&yyS[yypt])
-		$5.SetText(parser.lexer.client, string(parser.src[startOffset:]))
+		$$ = $2
 	}
-|	"TRACE" "PLAN" TraceableStmt
+
+EventEndsOpt:
 	{
-		$$ = &ast.TraceStmt{
-			Stmt:      $3,
-			TracePlan: true,
-		}
-		startOffset := parser.startOffset(&yyS[yypt])
-		$3.SetText(parser.lexer.client, string(parser.src[startOffset:]))
+		$$ = nil
 	}
-|	"TRACE" "PLAN" "TARGET" "=" stringLit TraceableStmt
+|	"ENDS" Expression
 	{
-		$$ = &ast.TraceStmt{
-			Stmt:            $6,
-			TracePlan:       true,
-			TracePlanTarget: $5,
-		}
-		startOffset := parser.startOffset(&yyS[yypt])
-		$6.SetText(parser.lexer.client, string(parser.src[startOffset:]))
+		$$ = $2
 	}
 
-ExplainSym:
-	"EXPLAIN"
-|	"DESCRIBE"
-|	"DESC"
+EventCompletionOpt:
+	{
+		$$ = ast.EventCompletionUnspecified
+	}
+|	EventCompletion
 
-ExplainStmt:
-	ExplainSym TableName
+EventCompletion:
+	"ON" "COMPLETION" "PRESERVE"
 	{
-		$$ = &ast.ExplainStmt{
-			Stmt: &ast.ShowStmt{
-				Tp:    ast.ShowColumns,
-				Table: $2.(*ast.TableName),
-			},
-		}
+		$$ = ast.EventCompletionPreserve
 	}
-|	ExplainSym TableName ColumnName
+|	"ON" "COMPLETION" "NOT" "PRESERVE"
 	{
-		$$ = &ast.ExplainStmt{
-			Stmt: &ast.ShowStmt{
-				Tp:     ast.ShowColumns,
-				Table:  $2.(*ast.TableName),
-				Column: $3.(*ast.ColumnName),
-			},
-		}
+		$$ = ast.EventCompletionNotPreserve
 	}
-|	ExplainSym ExplainableStmt
+
+EventStatusOpt:
 	{
-		$$ = &ast.ExplainStmt{
-			Stmt:   $2,
-			Format: "row",
-		}
+		$$ = ast.EventStatusUnspecified
 	}
-|	ExplainSym "FOR" "CONNECTION" NUM
+|	"ENABLE"
 	{
-		$$ = &ast.ExplainForStmt{
-			Format:       "row",
-			ConnectionID: getUint64FromNUM($4),
-		}
+		$$ = ast.EventStatusEnable
 	}
-|	ExplainSym "FORMAT" "=" stringLit "FOR" "CONNECTION" NUM
+|	"DISABLE"
 	{
-		$$ = &ast.ExplainForStmt{
-			Format:       $4,
-			ConnectionID: getUint64FromNUM($7),
-		}
+		$$ = ast.EventStatusDisable
 	}
-|	ExplainSym "FORMAT" "=" stringLit ExplainableStmt
+|	"DISABLE" "ON" "SLAVE"
 	{
-		$$ = &ast.ExplainStmt{
-			Stmt:   $5,
-			Format: $4,
-		}
+		$$ = ast.EventStatusDisableOnSlave
 	}
-|	ExplainSym "FORMAT" "=" ExplainFormatType "FOR" "CONNECTION" NUM
+|	"DISABLE" "ON" "REPLICA"
 	{
-		$$ = &ast.ExplainForStmt{
-			Format:       $4,
-			ConnectionID: getUint64FromNUM($7),
-		}
+		$$ = ast.EventStatusDisableOnReplica
 	}
-|	ExplainSym "FORMAT" "=" ExplainFormatType ExplainableStmt
+
+EventCommentOpt:
 	{
-		$$ = &ast.ExplainStmt{
-			Stmt:   $5,
-			Format: $4,
-		}
+		$$ = ""
 	}
-|	ExplainSym "ANALYZE" ExplainableStmt
+|	"COMMENT" stringLit
 	{
-		$$ = &ast.ExplainStmt{
-			Stmt:    $3,
-			Format:  "row",
-			Analyze: true,
-		}
+		$$ = $2
 	}
 
-ExplainFormatType:
-	"TRADITIONAL"
-|	"JSON"
-|	"ROW"
-|	"DOT"
-|	"BRIEF"
-|	"VERBOSE"
-
-/*******************************************************************
- * Backup / restore / import statements
- *
- *	BACKUP DATABASE [ * | db1, db2, db3 ] TO 'scheme://location' [ options... ]
- *	BACKUP TABLE [ db1.tbl1, db2.tbl2 ] TO 'scheme://location' [ options... ]
- *	RESTORE DATABASE [ * | db1, db2, db3 ] FROM 'scheme://location' [ options... ]
- *	RESTORE TABLE [ db1.tbl1, db2.tbl2 ] FROM 'scheme://location' [ options... ]
- */
-BRIEStmt:
-	"BACKUP" BRIETables "TO" stringLit BRIEOptions
+EventRenameOpt:
 	{
-		stmt := $2.(*ast.BRIEStmt)
-		stmt.Kind = ast.BRIEKindBackup
-		stmt.Storage = $4
-		stmt.Options = $5.([]*ast.BRIEOption)
-		$$ = stmt
+		$$ = nil
 	}
-|	"RESTORE" BRIETables "FROM" stringLit BRIEOptions
+|	"RENAME" "TO" TableName
 	{
-		stmt := $2.(*ast.BRIEStmt)
-		stmt.Kind = ast.BRIEKindRestore
-		stmt.Storage = $4
-		stmt.Options = $5.([]*ast.BRIEOption)
-		$$ = stmt
+		$$ = $3
 	}
 
-BRIETables:
-	DatabaseSym '*'
-	{
-		$$ = &ast.BRIEStmt{}
-	}
-|	DatabaseSym DBNameList
+EventBodyOpt:
 	{
-		$$ = &ast.BRIEStmt{Schemas: $2.([]string)}
+		$$ = nil
 	}
-|	"TABLE" TableNameList
+|	"DO" ProcedureStatement
 	{
-		$$ = &ast.BRIEStmt{Tables: $2.([]*ast.TableName)}
+		startOffset := parser.startOffset(&yyS[yypt])
+		body := $2
+		body.SetText(parser.lexer.client, strings.TrimSpace(parser.src[startOffset:]))
+		markLocalAssignments(body, nil, false)
+		$$ = body
 	}
 
-DBNameList:
-	DBName
+/*******************************************************************
+ *
+ *  Create Procedure/Function Statement
+ *
+ *  Example:
+ *      CREATE PROCEDURE p(IN a INT, OUT b INT) DETERMINISTIC
+ *      BEGIN
+ *          DECLARE c INT DEFAULT 0;
+ *          SET c = a + 1;
+ *          SET b = c;
+ *      END
+ *******************************************************************/
+CreateProcedureStmt:
+	"CREATE" ViewDefiner "PROCEDURE" IfNotExists TableName '(' ProcedureParamListOpt ')' CreateRoutineCharacteristicListOpt ProcedureStatement
 	{
-		$$ = []string{$1}
+		startOffset := parser.startOffset(&yyS[yypt])
+		body := $10
+		body.SetText(parser.lexer.client, strings.TrimSpace(parser.src[startOffset:]))
+		params := $7.([]*ast.ProcedureParameter)
+		markLocalAssignments(body, params, false)
+		$$ = &ast.CreateProcedureStmt{
+			IfNotExists:     $4.(bool),
+			Definer:         $2.(*auth.UserIdentity),
+			Name:            $5.(*ast.TableName),
+			Params:          params,
+			Characteristics: $9.([]*ast.RoutineCharacteristic),
+			Body:            body,
+		}
+	}
+
+// A function body may start with '(' right after the RETURNS type, the empty
+// OptFieldLen, FloatOpt and OptBinary rules take a lower precedence so that
+// the '(' is always read as the field length of the type.
+CreateFunctionStmt:
+	"CREATE" "FUNCTION" StoredFunctionTail
+	{
+		st := $3.(*ast.CreateFunctionStmt)
+		st.Definer = &auth.UserIdentity{CurrentUser: true}
+		$$ = st
 	}
-|	DBNameList ',' DBName
+|	"CREATE" "DEFINER" "=" Username "FUNCTION" StoredFunctionTail
 	{
-		$$ = append($1.([]string), $3)
+		st := $6.(*ast.CreateFunctionStmt)
+		st.Definer = $4.(*auth.UserIdentity)
+		$$ = st
 	}
-
-BRIEOptions:
-	%prec empty
+|	"CREATE" "FUNCTION" LoadableFunctionTail
 	{
-		$$ = []*ast.BRIEOption{}
+		$$ = $3
 	}
-|	BRIEOptions BRIEOption
+|	"CREATE" "AGGREGATE" "FUNCTION" LoadableFunctionTail
 	{
-		$$ = append($1.([]*ast.BRIEOption), $2.(*ast.BRIEOption))
+		st := $4.(*ast.CreateLoadableFunctionStmt)
+		st.Aggregate = true
+		$$ = st
 	}
 
-BRIEIntegerOptionName:
-	"CONCURRENCY"
-	{
-		$$ = ast.BRIEOptionConcurrency
-	}
-|	"RESUME"
+StoredFunctionTail:
+	IfNotExists TableName '(' FunctionParamListOpt ')' "RETURNS" Type CreateRoutineCharacteristicListOpt ProcedureStatement
 	{
-		$$ = ast.BRIEOptionResume
+		startOffset := parser.startOffset(&yyS[yypt])
+		body := $9
+		body.SetText(parser.lexer.client, strings.TrimSpace(parser.src[startOffset:]))
+		params := $4.([]*ast.ProcedureParameter)
+		markLocalAssignments(body, params, false)
+		$$ = &ast.CreateFunctionStmt{
+			IfNotExists:     $1.(bool),
+			Name:            $2.(*ast.TableName),
+			Params:          params,
+			Returns:         $7.(*types.FieldType),
+			Characteristics: $8.([]*ast.RoutineCharacteristic),
+			Body:            body,
+		}
+	}
+
+// See https://dev.mysql.com/doc/refman/8.0/en/create-function-loadable.html
+LoadableFunctionTail:
+	IfNotExists TableName "RETURNS" LoadableFunctionReturnType "SONAME" stringLit
+	{
+		name := $2.(*ast.TableName)
+		if name.Schema.O != "" {
+			// A loadable function is global, it doesn't belong to a database.
+			yylex.AppendError(yylex.Errorf("Incorrect database name '%s'", name.Schema.O))
+			return 1
+		}
+		$$ = &ast.CreateLoadableFunctionStmt{
+			IfNotExists: $1.(bool),
+			Name:        name.Name,
+			Returns:     $4.(ast.LoadableFunctionReturnType),
+			SOName:      $6,
+		}
 	}
 
-BRIEBooleanOptionName:
-	"SEND_CREDENTIALS_TO_TIKV"
+LoadableFunctionReturnType:
+	"STRING"
 	{
-		$$ = ast.BRIEOptionSendCreds
+		$$ = ast.LoadableFunctionReturnString
 	}
-|	"ONLINE"
+|	"INTEGER"
 	{
-		$$ = ast.BRIEOptionOnline
+		$$ = ast.LoadableFunctionReturnInteger
 	}
-|	"CHECKPOINT"
+|	"REAL"
 	{
-		$$ = ast.BRIEOptionCheckpoint
+		$$ = ast.LoadableFunctionReturnReal
 	}
-|	"SKIP_SCHEMA_FILES"
+|	"DECIMAL"
 	{
-		$$ = ast.BRIEOptionSkipSchemaFiles
+		$$ = ast.LoadableFunctionReturnDecimal
 	}
-|	"STRICT_FORMAT"
+
+ProcedureParamListOpt:
 	{
-		$$ = ast.BRIEOptionStrictFormat
+		$$ = []*ast.ProcedureParameter{}
 	}
-|	"CSV_NOT_NULL"
+|	ProcedureParamList
+
+ProcedureParamList:
+	ProcedureParam
 	{
-		$$ = ast.BRIEOptionCSVNotNull
+		$$ = []*ast.ProcedureParameter{$1.(*ast.ProcedureParameter)}
 	}
-|	"CSV_BACKSLASH_ESCAPE"
+|	ProcedureParamList ',' ProcedureParam
 	{
-		$$ = ast.BRIEOptionCSVBackslashEscape
+		$$ = append($1.([]*ast.ProcedureParameter), $3.(*ast.ProcedureParameter))
 	}
-|	"CSV_TRIM_LAST_SEPARATORS"
+
+ProcedureParam:
+	ProcedureParamMode Identifier Type
 	{
-		$$ = ast.BRIEOptionCSVTrimLastSeparators
+		$$ = &ast.ProcedureParameter{Mode: $1.(ast.ProcedureParamMode), Name: model.NewCIStr($2), Tp: $3.(*types.FieldType)}
 	}
 
-BRIEStringOptionName:
-	"TIKV_IMPORTER"
+ProcedureParamMode:
 	{
-		$$ = ast.BRIEOptionTiKVImporter
+		$$ = ast.ProcedureParamIn
 	}
-|	"CSV_SEPARATOR"
+|	"IN"
 	{
-		$$ = ast.BRIEOptionCSVSeparator
+		$$ = ast.ProcedureParamIn
 	}
-|	"CSV_DELIMITER"
+|	"OUT"
 	{
-		$$ = ast.BRIEOptionCSVDelimiter
+		$$ = ast.ProcedureParamOut
 	}
-|	"CSV_NULL"
+|	"INOUT"
 	{
-		$$ = ast.BRIEOptionCSVNull
+		$$ = ast.ProcedureParamInOut
 	}
 
-BRIEKeywordOptionName:
-	"BACKEND"
+FunctionParamListOpt:
 	{
-		$$ = ast.BRIEOptionBackend
+		$$ = []*ast.ProcedureParameter{}
 	}
-|	"ON_DUPLICATE"
+|	FunctionParamList
+
+FunctionParamList:
+	FunctionParam
 	{
-		$$ = ast.BRIEOptionOnDuplicate
+		$$ = []*ast.ProcedureParameter{$1.(*ast.ProcedureParameter)}
 	}
-|	"ON" "DUPLICATE"
+|	FunctionParamList ',' FunctionParam
 	{
-		$$ = ast.BRIEOptionOnDuplicate
+		$$ = append($1.([]*ast.ProcedureParameter), $3.(*ast.ProcedureParameter))
 	}
 
-BRIEOption:
-	BRIEIntegerOptionName EqOpt LengthNum
+FunctionParam:
+	Identifier Type
 	{
-		$$ = &ast.BRIEOption{
-			Tp:        $1.(ast.BRIEOptionType),
-			UintValue: $3.(uint64),
-		}
+		$$ = &ast.ProcedureParameter{Name: model.NewCIStr($1), Tp: $2.(*types.FieldType)}
 	}
-|	BRIEBooleanOptionName EqOpt Boolean
+
+CreateRoutineCharacteristicListOpt:
 	{
-		value := uint64(0)
-		if $3.(bool) {
-			value = 1
-		}
-		$$ = &ast.BRIEOption{
-			Tp:        $1.(ast.BRIEOptionType),
-			UintValue: value,
-		}
+		$$ = []*ast.RoutineCharacteristic{}
 	}
-|	BRIEStringOptionName EqOpt stringLit
+|	CreateRoutineCharacteristicListOpt CreateRoutineCharacteristic
 	{
-		$$ = &ast.BRIEOption{
-			Tp:       $1.(ast.BRIEOptionType),
-			StrValue: $3,
-		}
+		$$ = append($1.([]*ast.RoutineCharacteristic), $2.(*ast.RoutineCharacteristic))
 	}
-|	BRIEKeywordOptionName EqOpt StringNameOrBRIEOptionKeyword
+
+CreateRoutineCharacteristic:
+	RoutineCharacteristic
+|	"DETERMINISTIC"
 	{
-		$$ = &ast.BRIEOption{
-			Tp:       $1.(ast.BRIEOptionType),
-			StrValue: strings.ToLower($3),
-		}
+		$$ = &ast.RoutineCharacteristic{Tp: ast.RoutineCharacteristicDeterministic}
 	}
-|	"SNAPSHOT" EqOpt LengthNum TimestampUnit "AGO"
+|	"NOT" "DETERMINISTIC"
 	{
-		unit, err := $4.(ast.TimeUnitType).Duration()
-		if err != nil {
-			yylex.AppendError(err)
-			return 1
-		}
-		// TODO: check overflow?
-		$$ = &ast.BRIEOption{
-			Tp:        ast.BRIEOptionBackupTimeAgo,
-			UintValue: $3.(uint64) * uint64(unit),
-		}
+		$$ = &ast.RoutineCharacteristic{Tp: ast.RoutineCharacteristicNotDeterministic}
 	}
-|	"SNAPSHOT" EqOpt stringLit
-	// not including this into BRIEStringOptionName to avoid shift/reduce conflict
+
+RoutineCharacteristicListOpt:
 	{
-		$$ = &ast.BRIEOption{
-			Tp:       ast.BRIEOptionBackupTS,
-			StrValue: $3,
-		}
+		$$ = []*ast.RoutineCharacteristic{}
 	}
-|	"SNAPSHOT" EqOpt LengthNum
-	// not including this into BRIEIntegerOptionName to avoid shift/reduce conflict
+|	RoutineCharacteristicListOpt RoutineCharacteristic
 	{
-		$$ = &ast.BRIEOption{
-			Tp:        ast.BRIEOptionBackupTSO,
-			UintValue: $3.(uint64),
-		}
+		$$ = append($1.([]*ast.RoutineCharacteristic), $2.(*ast.RoutineCharacteristic))
 	}
-|	"LAST_BACKUP" EqOpt stringLit
+
+RoutineCharacteristic:
+	"COMMENT" stringLit
 	{
-		$$ = &ast.BRIEOption{
-			Tp:       ast.BRIEOptionLastBackupTS,
-			StrValue: $3,
-		}
+		$$ = &ast.RoutineCharacteristic{Tp: ast.RoutineCharacteristicComment, Comment: $2}
 	}
-|	"LAST_BACKUP" EqOpt LengthNum
+|	"LANGUAGE" "SQL"
 	{
-		$$ = &ast.BRIEOption{
-			Tp:        ast.BRIEOptionLastBackupTSO,
-			UintValue: $3.(uint64),
-		}
+		$$ = &ast.RoutineCharacteristic{Tp: ast.RoutineCharacteristicLanguageSQL}
 	}
-|	"RATE_LIMIT" EqOpt LengthNum "MB" '/' "SECOND"
+|	"CONTAINS" "SQL"
 	{
-		// TODO: check overflow?
-		$$ = &ast.BRIEOption{
-			Tp:        ast.BRIEOptionRateLimit,
-			UintValue: $3.(uint64) * 1048576,
-		}
+		$$ = &ast.RoutineCharacteristic{Tp: ast.RoutineCharacteristicContainsSQL}
 	}
-|	"CSV_HEADER" EqOpt FieldsOrColumns
+|	"NO" "SQL"
 	{
-		$$ = &ast.BRIEOption{
-			Tp:        ast.BRIEOptionCSVHeader,
-			UintValue: ast.BRIECSVHeaderIsColumns,
-		}
+		$$ = &ast.RoutineCharacteristic{Tp: ast.RoutineCharacteristicNoSQL}
 	}
-|	"CSV_HEADER" EqOpt LengthNum
+|	"READS" "SQL" "DATA"
 	{
-		$$ = &ast.BRIEOption{
-			Tp:        ast.BRIEOptionCSVHeader,
-			UintValue: $3.(uint64),
-		}
+		$$ = &ast.RoutineCharacteristic{Tp: ast.RoutineCharacteristicReadsSQLData}
 	}
-|	"CHECKSUM" EqOpt Boolean
+|	"MODIFIES" "SQL" "DATA"
 	{
-		value := uint64(0)
-		if $3.(bool) {
-			value = 1
-		}
-		$$ = &ast.BRIEOption{
-			Tp:        ast.BRIEOptionChecksum,
-			UintValue: value,
-		}
+		$$ = &ast.RoutineCharacteristic{Tp: ast.RoutineCharacteristicModifiesSQLData}
 	}
-|	"CHECKSUM" EqOpt OptionLevel
+|	"SQL" "SECURITY" "DEFINER"
 	{
-		$$ = &ast.BRIEOption{
-			Tp:        ast.BRIEOptionChecksum,
-			UintValue: uint64($3.(ast.BRIEOptionLevel)),
-		}
+		$$ = &ast.RoutineCharacteristic{Tp: ast.RoutineCharacteristicSQLSecurity, Security: model.SecurityDefiner}
 	}
-|	"ANALYZE" EqOpt Boolean
+|	"SQL" "SECURITY" "INVOKER"
 	{
-		value := uint64(0)
-		if $3.(bool) {
-			value = 1
-		}
-		$$ = &ast.BRIEOption{
-			Tp:        ast.BRIEOptionAnalyze,
-			UintValue: value,
-		}
+		$$ = &ast.RoutineCharacteristic{Tp: ast.RoutineCharacteristicSQLSecurity, Security: model.SecurityInvoker}
 	}
-|	"ANALYZE" EqOpt OptionLevel
+
+/*******************************************************************
+ *
+ *  Signal/Resignal Statement
+ *
+ *  Example:
+ *      SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'An error occurred', MYSQL_ERRNO = 1001
+ *      RESIGNAL SET MESSAGE_TEXT = 'Oops'
+ *******************************************************************/
+SignalStmt:
+	"SIGNAL" SignalConditionValue SignalInfoItemListOpt
 	{
-		$$ = &ast.BRIEOption{
-			Tp:        ast.BRIEOptionAnalyze,
-			UintValue: uint64($3.(ast.BRIEOptionLevel)),
-		}
+		$$ = &ast.SignalStmt{Condition: $2.(*ast.ConditionValue), Items: $3.([]*ast.SignalInfoItem)}
 	}
 
-LengthNum:
-	NUM
+ResignalStmt:
+	"RESIGNAL" SignalInfoItemListOpt
 	{
-		$$ = getUint64FromNUM($1)
+		$$ = &ast.ResignalStmt{Items: $2.([]*ast.SignalInfoItem)}
+	}
+|	"RESIGNAL" SignalConditionValue SignalInfoItemListOpt
+	{
+		$$ = &ast.ResignalStmt{Condition: $2.(*ast.ConditionValue), Items: $3.([]*ast.SignalInfoItem)}
 	}
 
-Int64Num:
-	NUM
+SignalConditionValue:
+	ProcedureSQLState
+|	Identifier
 	{
-		v, rangeErrMsg := getInt64FromNUM($1)
-		if len(rangeErrMsg) != 0 {
-			yylex.AppendError(yylex.Errorf(rangeErrMsg))
-			return 1
-		}
-		$$ = v
-	}
-
-NUM:
-	intLit
-
-Boolean:
-	NUM
-	{
-		$$ = $1.(int64) != 0
-	}
-|	"FALSE"
-	{
-		$$ = false
-	}
-|	"TRUE"
-	{
-		$$ = true
+		$$ = &ast.ConditionValue{Tp: ast.ConditionValueName, Name: model.NewCIStr($1)}
 	}
 
-OptionLevel:
-	"OFF"
-	{
-		$$ = ast.BRIEOptionLevelOff
-	}
-|	"OPTIONAL"
+SignalInfoItemListOpt:
 	{
-		$$ = ast.BRIEOptionLevelOptional
+		$$ = []*ast.SignalInfoItem{}
 	}
-|	"REQUIRED"
+|	"SET" SignalInfoItemList
 	{
-		$$ = ast.BRIEOptionLevelRequired
+		$$ = $2
 	}
 
-PurgeImportStmt:
-	"PURGE" "IMPORT" NUM
+SignalInfoItemList:
+	SignalInfoItem
 	{
-		$$ = &ast.PurgeImportStmt{TaskID: getUint64FromNUM($3)}
+		$$ = []*ast.SignalInfoItem{$1.(*ast.SignalInfoItem)}
 	}
-
-/*******************************************************************
- * import statements
- *
- *	CREATE IMPORT [IF NOT EXISTS] import_name
- *		FROM data_location [REPLACE | SKIP {ALL | CONSTRAINT | DUPLICATE ｜ STRICT}]
- *		[options_list]
- *	STOP IMPORT [IF RUNNING] import_name
- *	RESUME IMPORT [IF NOT RUNNING] import_name
- *	ALTER IMPORT import_name
- *		[REPLACE | SKIP {ALL | CONSTRAINT | DUPLICATE | STRICT}]
- *		[options_list]
- *		[TRUNCATE
- *			{ALL | ERRORS} [TABLE table_name [, table_name] ...]
- *		]
- *	DROP IMPORT [IF EXISTS] import_name
- *	SHOW IMPORT import_name [ERRORS] [TABLE table_name [, table_name] ...]
- */
-CreateImportStmt:
-	"CREATE" "IMPORT" IfNotExists Identifier "FROM" stringLit ErrorHandling BRIEOptions
+|	SignalInfoItemList ',' SignalInfoItem
 	{
-		$$ = &ast.CreateImportStmt{
-			IfNotExists:   $3.(bool),
-			Name:          $4,
-			Storage:       $6,
-			ErrorHandling: $7.(ast.ErrorHandlingOption),
-			Options:       $8.([]*ast.BRIEOption),
+		items := $1.([]*ast.SignalInfoItem)
+		item := $3.(*ast.SignalInfoItem)
+		for _, x := range items {
+			if x.Name == item.Name {
+				yylex.AppendError(ErrDupSignalSet.GenWithStackByArgs(item.Name.String()))
+				return 1
+			}
 		}
+		$$ = append(items, item)
 	}
 
-StopImportStmt:
-	"STOP" "IMPORT" IfRunning Identifier
+SignalInfoItem:
+	ConditionInfoItemName "=" SignalAllowedExpr
 	{
-		$$ = &ast.StopImportStmt{
-			IfRunning: $3.(bool),
-			Name:      $4,
+		name := $1.(ast.DiagnosticsItemName)
+		if name == ast.DiagnosticsReturnedSQLState {
+			yylex.AppendError(yylex.Errorf("RETURNED_SQLSTATE can not be set by SIGNAL/RESIGNAL"))
+			return 1
 		}
+		$$ = &ast.SignalInfoItem{Name: name, Value: $3}
 	}
 
-ResumeImportStmt:
-	"RESUME" "IMPORT" IfNotRunning Identifier
+SignalAllowedExpr:
+	SignedLiteral
+|	Variable
+|	Identifier
 	{
-		$$ = &ast.ResumeImportStmt{
-			IfNotRunning: $3.(bool),
-			Name:         $4,
-		}
+		$$ = &ast.ColumnNameExpr{Name: &ast.ColumnName{Name: model.NewCIStr($1)}}
 	}
 
-AlterImportStmt:
-	"ALTER" "IMPORT" Identifier ErrorHandling BRIEOptions ImportTruncate
+/*******************************************************************
+ *
+ *  Get Diagnostics Statement
+ *
+ *  Example:
+ *      GET DIAGNOSTICS @cnt = NUMBER, @rows = ROW_COUNT
+ *      GET STACKED DIAGNOSTICS CONDITION 1 @code = RETURNED_SQLSTATE, @msg = MESSAGE_TEXT
+ *******************************************************************/
+GetDiagnosticsStmt:
+	"GET" DiagnosticsAreaOpt "DIAGNOSTICS" StatementInfoItemList
 	{
-		s := &ast.AlterImportStmt{
-			Name:          $3,
-			ErrorHandling: $4.(ast.ErrorHandlingOption),
-			Options:       $5.([]*ast.BRIEOption),
-		}
-		if $6 != nil {
-			s.Truncate = $6.(*ast.ImportTruncate)
-		}
-		$$ = s
+		$$ = &ast.GetDiagnosticsStmt{Area: $2.(ast.DiagnosticsArea), Items: $4.([]*ast.DiagnosticsItem)}
 	}
-
-DropImportStmt:
-	"DROP" "IMPORT" IfExists Identifier
+|	"GET" DiagnosticsAreaOpt "DIAGNOSTICS" "CONDITION" SignalAllowedExpr ConditionInfoItemList
 	{
-		$$ = &ast.DropImportStmt{
-			IfExists: $3.(bool),
-			Name:     $4,
-		}
+		$$ = &ast.GetDiagnosticsStmt{Area: $2.(ast.DiagnosticsArea), Condition: $5, Items: $6.([]*ast.DiagnosticsItem)}
 	}
 
-ShowImportStmt:
-	"SHOW" "IMPORT" Identifier OptErrors TableNameListOpt2
+DiagnosticsAreaOpt:
 	{
-		$$ = &ast.ShowImportStmt{
-			Name:       $3,
-			ErrorsOnly: $4.(bool),
-			TableNames: $5.([]*ast.TableName),
-		}
+		$$ = ast.DiagnosticsAreaNone
 	}
-
-IfRunning:
+|	"CURRENT"
 	{
-		$$ = false
+		$$ = ast.DiagnosticsAreaCurrent
 	}
-|	"IF" "RUNNING"
+|	"STACKED"
 	{
-		$$ = true
+		$$ = ast.DiagnosticsAreaStacked
 	}
 
-IfNotRunning:
+StatementInfoItemList:
+	StatementInfoItem
 	{
-		$$ = false
+		$$ = []*ast.DiagnosticsItem{$1.(*ast.DiagnosticsItem)}
 	}
-|	"IF" NotSym "RUNNING"
+|	StatementInfoItemList ',' StatementInfoItem
 	{
-		$$ = true
+		$$ = append($1.([]*ast.DiagnosticsItem), $3.(*ast.DiagnosticsItem))
 	}
 
-OptErrors:
+StatementInfoItem:
+	DiagnosticsTarget "=" "NUMBER"
 	{
-		$$ = false
+		$$ = &ast.DiagnosticsItem{Target: $1, Name: ast.DiagnosticsNumber}
 	}
-|	"ERRORS"
+|	DiagnosticsTarget "=" "ROW_COUNT"
 	{
-		$$ = true
+		$$ = &ast.DiagnosticsItem{Target: $1, Name: ast.DiagnosticsRowCount}
 	}
 
-ErrorHandling:
-	{
-		$$ = ast.ErrorHandleError
-	}
-|	"REPLACE"
-	{
-		$$ = ast.ErrorHandleReplace
-	}
-|	"SKIP" "ALL"
-	{
-		$$ = ast.ErrorHandleSkipAll
-	}
-|	"SKIP" "CONSTRAINT"
-	{
-		$$ = ast.ErrorHandleSkipConstraint
-	}
-|	"SKIP" "DUPLICATE"
+ConditionInfoItemList:
+	ConditionInfoItem
 	{
-		$$ = ast.ErrorHandleSkipDuplicate
+		$$ = []*ast.DiagnosticsItem{$1.(*ast.DiagnosticsItem)}
 	}
-|	"SKIP" "STRICT"
+|	ConditionInfoItemList ',' ConditionInfoItem
 	{
-		$$ = ast.ErrorHandleSkipStrict
+		$$ = append($1.([]*ast.DiagnosticsItem), $3.(*ast.DiagnosticsItem))
 	}
 
-ImportTruncate:
-	{
-		$$ = nil
-	}
-|	"TRUNCATE" "ALL" TableNameListOpt2
+ConditionInfoItem:
+	DiagnosticsTarget "=" ConditionInfoItemName
 	{
-		$$ = &ast.ImportTruncate{
-			IsErrorsOnly: false,
-			TableNames:   $3.([]*ast.TableName),
-		}
+		$$ = &ast.DiagnosticsItem{Target: $1, Name: $3.(ast.DiagnosticsItemName)}
 	}
-|	"TRUNCATE" "ERRORS" TableNameListOpt2
+
+DiagnosticsTarget:
+	UserVariable
+|	Identifier
 	{
-		$$ = &ast.ImportTruncate{
-			IsErrorsOnly: true,
-			TableNames:   $3.([]*ast.TableName),
-		}
+		$$ = &ast.ColumnNameExpr{Name: &ast.ColumnName{Name: model.NewCIStr($1)}}
 	}
 
-Expression:
-	singleAtIdentifier assignmentEq Expression %prec assignmentEq
+ConditionInfoItemName:
+	"CLASS_ORIGIN"
 	{
-		v := $1
-		v = strings.TrimPrefix(v, "@")
-		$$ = &ast.VariableExpr{
-			Name:     v,
-			IsGlobal: false,
-			IsSystem: false,
-			Value:    $3,
-		}
+		$$ = ast.DiagnosticsClassOrigin
 	}
-|	Expression logOr Expression %prec pipes
+|	"SUBCLASS_ORIGIN"
 	{
-		$$ = &ast.BinaryOperationExpr{Op: opcode.LogicOr, L: $1, R: $3}
+		$$ = ast.DiagnosticsSubclassOrigin
 	}
-|	Expression "XOR" Expression %prec xor
+|	"RETURNED_SQLSTATE"
 	{
-		$$ = &ast.BinaryOperationExpr{Op: opcode.LogicXor, L: $1, R: $3}
+		$$ = ast.DiagnosticsReturnedSQLState
 	}
-|	Expression logAnd Expression %prec andand
+|	"MESSAGE_TEXT"
 	{
-		$$ = &ast.BinaryOperationExpr{Op: opcode.LogicAnd, L: $1, R: $3}
+		$$ = ast.DiagnosticsMessageText
 	}
-|	"NOT" Expression %prec not
+|	"MYSQL_ERRNO"
 	{
-		expr, ok := $2.(*ast.ExistsSubqueryExpr)
-		if ok {
-			expr.Not = !expr.Not
-			$$ = $2
-		} else {
-			$$ = &ast.UnaryOperationExpr{Op: opcode.Not, V: $2}
-		}
+		$$ = ast.DiagnosticsMySQLErrno
 	}
-|	"MATCH" '(' ColumnNameList ')' "AGAINST" '(' BitExpr FulltextSearchModifierOpt ')'
+|	"CONSTRAINT_CATALOG"
 	{
-		$$ = &ast.MatchAgainst{
-			ColumnNames: $3.([]*ast.ColumnName),
-			Against:     $7,
-			Modifier:    ast.FulltextSearchModifier($8.(int)),
-		}
+		$$ = ast.DiagnosticsConstraintCatalog
 	}
-|	BoolPri IsOrNotOp trueKwd %prec is
+|	"CONSTRAINT_SCHEMA"
 	{
-		$$ = &ast.IsTruthExpr{Expr: $1, Not: !$2.(bool), True: int64(1)}
+		$$ = ast.DiagnosticsConstraintSchema
 	}
-|	BoolPri IsOrNotOp falseKwd %prec is
+|	"CONSTRAINT_NAME"
 	{
-		$$ = &ast.IsTruthExpr{Expr: $1, Not: !$2.(bool), True: int64(0)}
+		$$ = ast.DiagnosticsConstraintName
 	}
-|	BoolPri IsOrNotOp "UNKNOWN" %prec is
+|	"CATALOG_NAME"
 	{
-		/* https://dev.mysql.com/doc/refman/5.7/en/comparison-operators.html#operator_is */
-		$$ = &ast.IsNullExpr{Expr: $1, Not: !$2.(bool)}
+		$$ = ast.DiagnosticsCatalogName
 	}
-|	BoolPri
-
-MaxValueOrExpression:
-	"MAXVALUE"
+|	"SCHEMA_NAME"
 	{
-		$$ = &ast.MaxValueExpr{}
+		$$ = ast.DiagnosticsSchemaName
 	}
-|	BitExpr
-
-FulltextSearchModifierOpt:
-	/* empty */
+|	"TABLE_NAME"
 	{
-		$$ = ast.FulltextSearchModifierNaturalLanguageMode
+		$$ = ast.DiagnosticsTableName
 	}
-|	"IN" "NATURAL" "LANGUAGE" "MODE"
+|	"COLUMN_NAME"
 	{
-		$$ = ast.FulltextSearchModifierNaturalLanguageMode
+		$$ = ast.DiagnosticsColumnName
 	}
-|	"IN" "NATURAL" "LANGUAGE" "MODE" "WITH" "QUERY" "EXPANSION"
+|	"CURSOR_NAME"
 	{
-		$$ = ast.FulltextSearchModifierNaturalLanguageMode | ast.FulltextSearchModifierWithQueryExpansion
+		$$ = ast.DiagnosticsCursorName
 	}
-|	"IN" "BOOLEAN" "MODE"
+
+/*******************************************************************
+ *
+ *  Alter/Drop Procedure/Function Statement
+ *
+ *  Example:
+ *      ALTER PROCEDURE p COMMENT 'x' SQL SECURITY INVOKER
+ *      DROP FUNCTION IF EXISTS f
+ *******************************************************************/
+AlterRoutineStmt:
+	"ALTER" RoutineType TableName RoutineCharacteristicListOpt
 	{
-		$$ = ast.FulltextSearchModifierBooleanMode
+		$$ = &ast.AlterRoutineStmt{
+			Tp:              $2.(ast.RoutineType),
+			Name:            $3.(*ast.TableName),
+			Characteristics: $4.([]*ast.RoutineCharacteristic),
+		}
 	}
-|	"WITH" "QUERY" "EXPANSION"
+
+DropRoutineStmt:
+	"DROP" RoutineType IfExists TableName
 	{
-		$$ = ast.FulltextSearchModifierWithQueryExpansion
+		$$ = &ast.DropRoutineStmt{Tp: $2.(ast.RoutineType), IfExists: $3.(bool), Name: $4.(*ast.TableName)}
 	}
 
-logOr:
-	pipesAsOr
-|	"OR"
-
-logAnd:
-	"&&"
-|	"AND"
-
-ExpressionList:
-	Expression
+RoutineType:
+	"PROCEDURE"
 	{
-		$$ = []ast.ExprNode{$1}
+		$$ = ast.RoutineProcedure
 	}
-|	ExpressionList ',' Expression
+|	"FUNCTION"
 	{
-		$$ = append($1.([]ast.ExprNode), $3)
+		$$ = ast.RoutineFunction
 	}
 
-MaxValueOrExpressionList:
-	MaxValueOrExpression
-	{
-		$$ = []ast.ExprNode{$1}
-	}
-|	MaxValueOrExpressionList ',' MaxValueOrExpression
+/*******************************************************************
+ *
+ *  Stored Program Statements
+ *
+ *  See https://dev.mysql.com/doc/refman/8.0/en/sql-compound-statements.html
+ *******************************************************************/
+ProcedureStatement:
+	ProcedureUnlabeledStmt
+|	ProcedureLabel ':' ProcedureLabelableStmt ProcedureEndLabelOpt
 	{
-		$$ = append($1.([]ast.ExprNode), $3)
+		label := model.NewCIStr($1)
+		if $4 != "" && model.NewCIStr($4).L != label.L {
+			yylex.AppendError(yylex.Errorf("End-label %s without match", $4))
+			return 1
+		}
+		switch x := $3.(type) {
+		case *ast.CompoundStmt:
+			x.Label = label
+		case *ast.LoopStmt:
+			x.Label = label
+		case *ast.WhileStmt:
+			x.Label = label
+		case *ast.RepeatStmt:
+			x.Label = label
+		}
+		$$ = $3
 	}
 
-ExpressionListOpt:
+ProcedureEndLabelOpt:
 	{
-		$$ = []ast.ExprNode{}
+		$$ = ""
 	}
-|	ExpressionList
+|	ProcedureLabel
 
-FuncDatetimePrecListOpt:
-	{
-		$$ = []ast.ExprNode{}
-	}
-|	FuncDatetimePrecList
+// ProcedureLabel excludes keywords, otherwise labels like `end` could not be
+// told apart from the end of a statement list with a single lookahead.
+ProcedureLabel:
+	identifier
 
-FuncDatetimePrecList:
-	intLit
+ProcedureUnlabeledStmt:
+	ProcedureLabelableStmt
+|	SelectStmt
+|	SetOprStmt
+|	SelectStmtWithClause
+|	SubSelect
 	{
-		expr := ast.NewValueExpr($1, parser.charset, parser.collation)
-		$$ = []ast.ExprNode{expr}
+		var sel ast.StmtNode
+		switch x := $1.(*ast.SubqueryExpr).Query.(type) {
+		case *ast.SelectStmt:
+			x.IsInBraces = true
+			sel = x
+		case *ast.SetOprStmt:
+			x.IsInBraces = true
+			sel = x
+		}
+		$$ = sel
 	}
-
-BoolPri:
-	BoolPri IsOrNotOp "NULL" %prec is
+|	InsertIntoStmt
+|	ReplaceIntoStmt
+|	UpdateStmt
+|	DeleteFromStmt
+|	TruncateTableStmt
+|	SetStmt
+|	CallStmt
+|	DoStmt
+|	ShowStmt
+|	ExplainStmt
+|	AlterDatabaseStmt
+|	AlterTableStmt
+|	AlterUserStmt
+|	AnalyzeTableStmt
+|	CreateDatabaseStmt
+|	CreateIndexStmt
+|	CreateTableStmt
+|	CreateViewStmt
+|	CreateUserStmt
+|	CreateRoleStmt
+|	DropDatabaseStmt
+|	DropIndexStmt
+|	DropTableStmt
+|	DropViewStmt
+|	DropUserStmt
+|	DropRoleStmt
+|	RenameTableStmt
+|	RenameUserStmt
+|	GrantStmt
+|	GrantRoleStmt
+|	RevokeStmt
+|	RevokeRoleStmt
+|	FlushStmt
+|	KillStmt
+|	LockTablesStmt
+|	UnlockTablesStmt
+|	StartTransactionStmt
+|	CommitStmt
+|	RollbackStmt
+|	SavepointStmt
+|	ReleaseSavepointStmt
+|	PreparedStmt
+|	ExecuteStmt
+|	DeallocateStmt
+|	ProcedureIfStmt
+|	ProcedureCaseStmt
+|	SignalStmt
+|	ResignalStmt
+|	GetDiagnosticsStmt
+|	"LEAVE" Identifier
 	{
-		$$ = &ast.IsNullExpr{Expr: $1, Not: !$2.(bool)}
+		$$ = &ast.LeaveStmt{Label: model.NewCIStr($2)}
 	}
-|	BoolPri CompareOp PredicateExpr %prec eq
+|	"ITERATE" Identifier
 	{
-		$$ = &ast.BinaryOperationExpr{Op: $2.(opcode.Op), L: $1, R: $3}
+		$$ = &ast.IterateStmt{Label: model.NewCIStr($2)}
 	}
-|	BoolPri CompareOp AnyOrAll SubSelect %prec eq
+|	"OPEN" Identifier
 	{
-		sq := $4.(*ast.SubqueryExpr)
-		sq.MultiRows = true
-		$$ = &ast.CompareSubqueryExpr{Op: $2.(opcode.Op), L: $1, R: sq, All: $3.(bool)}
+		$$ = &ast.OpenCursorStmt{Name: model.NewCIStr($2)}
 	}
-|	BoolPri CompareOp singleAtIdentifier assignmentEq PredicateExpr %prec assignmentEq
+|	"FETCH" Identifier "INTO" IdentList
 	{
-		v := $3
-		v = strings.TrimPrefix(v, "@")
-		variable := &ast.VariableExpr{
-			Name:     v,
-			IsGlobal: false,
-			IsSystem: false,
-			Value:    $5,
-		}
-		$$ = &ast.BinaryOperationExpr{Op: $2.(opcode.Op), L: $1, R: variable}
+		$$ = &ast.FetchCursorStmt{Name: model.NewCIStr($2), Into: $4.([]model.CIStr)}
 	}
-|	PredicateExpr
-
-CompareOp:
-	">="
+|	"FETCH" "FROM" Identifier "INTO" IdentList
 	{
-		$$ = opcode.GE
+		$$ = &ast.FetchCursorStmt{Name: model.NewCIStr($3), Into: $5.([]model.CIStr)}
 	}
-|	'>'
+|	"FETCH" "NEXT" "FROM" Identifier "INTO" IdentList
 	{
-		$$ = opcode.GT
+		$$ = &ast.FetchCursorStmt{Name: model.NewCIStr($4), Into: $6.([]model.CIStr)}
 	}
-|	"<="
+|	"CLOSE" Identifier
 	{
-		$$ = opcode.LE
+		$$ = &ast.CloseCursorStmt{Name: model.NewCIStr($2)}
 	}
-|	'<'
+|	"RETURN" Expression
 	{
-		$$ = opcode.LT
+		$$ = &ast.ReturnStmt{Expr: $2}
 	}
-|	"!="
+
+ProcedureLabelableStmt:
+	"BEGIN" ProcedureDeclListOpt ProcedureStatementListOpt "END"
 	{
-		$$ = opcode.NE
+		$$ = &ast.CompoundStmt{Stmts: append($2.([]ast.StmtNode), $3.([]ast.StmtNode)...)}
 	}
-|	"<>"
+|	"LOOP" ProcedureStatementList "END" "LOOP"
 	{
-		$$ = opcode.NE
+		$$ = &ast.LoopStmt{Stmts: $2.([]ast.StmtNode)}
 	}
-|	"="
+|	"WHILE" Expression "DO" ProcedureStatementList "END" "WHILE"
 	{
-		$$ = opcode.EQ
+		$$ = &ast.WhileStmt{Cond: $2, Stmts: $4.([]ast.StmtNode)}
 	}
-|	"<=>"
+|	"REPEAT" ProcedureStatementList "UNTIL" Expression "END" "REPEAT"
 	{
-		$$ = opcode.NullEQ
+		$$ = &ast.RepeatStmt{Stmts: $2.([]ast.StmtNode), Until: $4}
 	}
 
-BetweenOrNotOp:
-	"BETWEEN"
+ProcedureStatementListOpt:
 	{
-		$$ = true
+		$$ = []ast.StmtNode{}
 	}
-|	NotSym "BETWEEN"
+|	ProcedureStatementList
+
+ProcedureStatementList:
+	ProcedureStatement ';'
 	{
-		$$ = false
+		$$ = []ast.StmtNode{$1}
 	}
-
-IsOrNotOp:
-	"IS"
+|	ProcedureStatementList ProcedureStatement ';'
 	{
-		$$ = true
+		$$ = append($1.([]ast.StmtNode), $2)
 	}
-|	"IS" NotSym
+
+ProcedureIfStmt:
+	"IF" Expression "THEN" ProcedureStatementList ProcedureElseIfListOpt ProcedureElseOpt "END" "IF"
 	{
-		$$ = false
+		branches := []*ast.IfBranch{{Cond: $2, Stmts: $4.([]ast.StmtNode)}}
+		x := &ast.IfStmt{Branches: append(branches, $5.([]*ast.IfBranch)...)}
+		if $6 != nil {
+			x.Else = $6.([]ast.StmtNode)
+		}
+		$$ = x
 	}
 
-InOrNotOp:
-	"IN"
+ProcedureElseIfListOpt:
 	{
-		$$ = true
+		$$ = []*ast.IfBranch{}
 	}
-|	NotSym "IN"
+|	ProcedureElseIfListOpt "ELSEIF" Expression "THEN" ProcedureStatementList
 	{
-		$$ = false
+		$$ = append($1.([]*ast.IfBranch), &ast.IfBranch{Cond: $3, Stmts: $5.([]ast.StmtNode)})
 	}
 
-LikeOrNotOp:
-	"LIKE"
+ProcedureElseOpt:
 	{
-		$$ = true
+		$$ = nil
 	}
-|	NotSym "LIKE"
+|	"ELSE" ProcedureStatementList
 	{
-		$$ = false
+		$$ = $2
 	}
 
-RegexpOrNotOp:
-	RegexpSym
+ProcedureCaseStmt:
+	"CASE" ExpressionOpt ProcedureWhenList ProcedureElseOpt "END" "CASE"
 	{
-		$$ = true
+		x := &ast.CaseStmt{Whens: $3.([]*ast.CaseStmtWhen)}
+		if $2 != nil {
+			x.Value = $2
+		}
+		if $4 != nil {
+			x.Else = $4.([]ast.StmtNode)
+		}
+		$$ = x
 	}
-|	NotSym RegexpSym
+
+ProcedureWhenList:
+	ProcedureWhen
 	{
-		$$ = false
+		$$ = []*ast.CaseStmtWhen{$1.(*ast.CaseStmtWhen)}
+	}
+|	ProcedureWhenList ProcedureWhen
+	{
+		$$ = append($1.([]*ast.CaseStmtWhen), $2.(*ast.CaseStmtWhen))
 	}
 
-AnyOrAll:
-	"ANY"
+ProcedureWhen:
+	"WHEN" Expression "THEN" ProcedureStatementList
 	{
-		$$ = false
+		$$ = &ast.CaseStmtWhen{Expr: $2, Stmts: $4.([]ast.StmtNode)}
 	}
-|	"SOME"
+
+ProcedureDeclListOpt:
 	{
-		$$ = false
+		$$ = []ast.StmtNode{}
 	}
-|	"ALL"
+|	ProcedureDeclListOpt ProcedureDecl ';'
 	{
-		$$ = true
+		$$ = append($1.([]ast.StmtNode), $2)
 	}
 
-PredicateExpr:
-	BitExpr InOrNotOp '(' ExpressionList ')'
+ProcedureDecl:
+	"DECLARE" IdentList Type ProcedureDefaultOpt
 	{
-		$$ = &ast.PatternInExpr{Expr: $1, Not: !$2.(bool), List: $4.([]ast.ExprNode)}
+		$$ = &ast.DeclareVarStmt{Names: $2.([]model.CIStr), Tp: $3.(*types.FieldType), Default: $4}
 	}
-|	BitExpr InOrNotOp SubSelect
+|	"DECLARE" Identifier "CONDITION" "FOR" ProcedureConditionCode
 	{
-		sq := $3.(*ast.SubqueryExpr)
-		sq.MultiRows = true
-		$$ = &ast.PatternInExpr{Expr: $1, Not: !$2.(bool), Sel: sq}
+		$$ = &ast.DeclareConditionStmt{Name: model.NewCIStr($2), Value: $5.(*ast.ConditionValue)}
 	}
-|	BitExpr BetweenOrNotOp BitExpr "AND" PredicateExpr
+|	"DECLARE" Identifier "CURSOR" "FOR" ProcedureCursorSelect
 	{
-		$$ = &ast.BetweenExpr{
-			Expr:  $1,
-			Left:  $3,
-			Right: $5,
-			Not:   !$2.(bool),
-		}
+		$$ = &ast.DeclareCursorStmt{Name: model.NewCIStr($2), Select: $5.(ast.ResultSetNode)}
 	}
-|	BitExpr LikeOrNotOp SimpleExpr LikeEscapeOpt
+|	"DECLARE" ProcedureHandlerAction "HANDLER" "FOR" ProcedureHandlerConditionList ProcedureStatement
 	{
-		escape := $4
-		if len(escape) > 1 {
-			yylex.AppendError(ErrWrongArguments.GenWithStackByArgs("ESCAPE"))
-			return 1
-		} else if len(escape) == 0 {
-			escape = "\\"
-		}
-		$$ = &ast.PatternLikeExpr{
-			Expr:    $1,
-			Pattern: $3,
-			Not:     !$2.(bool),
-			Escape:  escape[0],
+		$$ = &ast.DeclareHandlerStmt{
+			Action:     $2.(ast.HandlerAction),
+			Conditions: $5.([]*ast.ConditionValue),
+			Body:       $6,
 		}
 	}
-|	BitExpr RegexpOrNotOp SimpleExpr
-	{
-		$$ = &ast.PatternRegexpExpr{Expr: $1, Pattern: $3, Not: !$2.(bool)}
-	}
-|	BitExpr
-
-RegexpSym:
-	"REGEXP"
-|	"RLIKE"
 
-LikeEscapeOpt:
-	%prec empty
+ProcedureDefaultOpt:
 	{
-		$$ = "\\"
+		$$ = nil
 	}
-|	"ESCAPE" stringLit
+|	"DEFAULT" Expression
 	{
 		$$ = $2
 	}
 
-Field:
-	'*' %prec '*'
+ProcedureCursorSelect:
+	SelectStmt
+|	SetOprStmt
+|	SelectStmtWithClause
+
+ProcedureHandlerAction:
+	"CONTINUE"
 	{
-		$$ = &ast.SelectField{WildCard: &ast.WildCardField{}}
+		$$ = ast.HandlerContinue
 	}
-|	Identifier '.' '*' %prec '*'
+|	"EXIT"
 	{
-		wildCard := &ast.WildCardField{Table: model.NewCIStr($1)}
-		$$ = &ast.SelectField{WildCard: wildCard}
+		$$ = ast.HandlerExit
 	}
-|	Identifier '.' Identifier '.' '*' %prec '*'
+|	"UNDO"
 	{
-		wildCard := &ast.WildCardField{Schema: model.NewCIStr($1), Table: model.NewCIStr($3)}
-		$$ = &ast.SelectField{WildCard: wildCard}
+		$$ = ast.HandlerUndo
 	}
-|	Expression FieldAsNameOpt
+
+ProcedureConditionCode:
+	LengthNum
 	{
-		expr := $1
-		asName := $2
-		$$ = &ast.SelectField{Expr: expr, AsName: model.NewCIStr(asName)}
+		$$ = &ast.ConditionValue{Tp: ast.ConditionValueErrorCode, ErrorCode: $1.(uint64)}
 	}
+|	ProcedureSQLState
 
-FieldAsNameOpt:
-	/* EMPTY */
+ProcedureSQLState:
+	"SQLSTATE" ValueOpt stringLit
 	{
-		$$ = ""
+		if !mysql.IsValidSQLState($3) {
+			yylex.AppendWarn(ErrBadSQLState.GenWithStackByArgs($3))
+		}
+		$$ = &ast.ConditionValue{Tp: ast.ConditionValueSQLState, SQLState: $3}
 	}
-|	FieldAsName
 
-FieldAsName:
-	Identifier
-|	"AS" Identifier
+ValueOpt:
+	{}
+|	"VALUE"
+	{}
+
+ProcedureHandlerConditionList:
+	ProcedureHandlerCondition
 	{
-		$$ = $2
+		$$ = []*ast.ConditionValue{$1.(*ast.ConditionValue)}
 	}
-|	stringLit
-|	"AS" stringLit
+|	ProcedureHandlerConditionList ',' ProcedureHandlerCondition
 	{
-		$$ = $2
+		$$ = append($1.([]*ast.ConditionValue), $3.(*ast.ConditionValue))
 	}
 
-FieldList:
-	Field
-	{
-		field := $1.(*ast.SelectField)
-		field.Offset = parser.startOffset(&yyS[yypt])
-		$$ = []*ast.SelectField{field}
-	}
-|	FieldList ',' Field
+ProcedureHandlerCondition:
+	ProcedureConditionCode
+|	Identifier
 	{
-		fl := $1.([]*ast.SelectField)
-		last := fl[len(fl)-1]
-		if last.Expr != nil && last.AsName.O == "" {
-			lastEnd := parser.endOffset(&yyS[yypt-1])
-			last.SetText(parser.lexer.client, parser.src[last.Offset:lastEnd])
-		}
-		newField := $3.(*ast.SelectField)
-		newField.Offset = parser.startOffset(&yyS[yypt])
-		$$ = append(fl, newField)
+		$$ = &ast.ConditionValue{Tp: ast.ConditionValueName, Name: model.NewCIStr($1)}
 	}
-
-GroupByClause:
-	"GROUP" "BY" ByList
+|	"SQLWARNING"
 	{
-		$$ = &ast.GroupByClause{Items: $3.([]*ast.ByItem)}
+		$$ = &ast.ConditionValue{Tp: ast.ConditionValueSQLWarning}
 	}
-
-HavingClause:
+|	"NOT" "FOUND"
 	{
-		$$ = nil
+		$$ = &ast.ConditionValue{Tp: ast.ConditionValueNotFound}
 	}
-|	"HAVING" Expression
+|	"SQLEXCEPTION"
 	{
-		$$ = &ast.HavingClause{Expr: $2}
+		$$ = &ast.ConditionValue{Tp: ast.ConditionValueSQLException}
 	}
 
-AsOfClauseOpt:
-	%prec empty
+/******************************************************************
+ * Drop Index Statement
+ * See https://dev.mysql.com/doc/refman/8.0/en/drop-index.html
+ *
+ *  DROP INDEX index_name ON tbl_name
+ *      [algorithm_option | lock_option] ...
+ *
+ *  algorithm_option:
+ *      ALGORITHM [=] {DEFAULT|INPLACE|COPY}
+ *
+ *  lock_option:
+ *      LOCK [=] {DEFAULT|NONE|SHARED|EXCLUSIVE}
+ ******************************************************************/
+DropIndexStmt:
+	"DROP" "INDEX" IfExists Identifier "ON" TableName IndexLockAndAlgorithmOpt
 	{
-		$$ = nil
+		var indexLockAndAlgorithm *ast.IndexLockAndAlgorithm
+		if $7 != nil {
+			indexLockAndAlgorithm = $7.(*ast.IndexLockAndAlgorithm)
+			if indexLockAndAlgorithm.LockTp == ast.LockTypeDefault && indexLockAndAlgorithm.AlgorithmTp == ast.AlgorithmTypeDefault {
+				indexLockAndAlgorithm = nil
+			}
+		}
+		$$ = &ast.DropIndexStmt{IfExists: $3.(bool), IndexName: $4, Table: $6.(*ast.TableName), LockAlg: indexLockAndAlgorithm}
 	}
-|	AsOfClause
 
-AsOfClause:
-	asof "TIMESTAMP" Expression
+DropTableStmt:
+	"DROP" OptTemporary TableOrTables IfExists TableNameList RestrictOrCascadeOpt
 	{
-		$$ = &ast.AsOfClause{
-			TsExpr: $3.(ast.ExprNode),
-		}
+		$$ = &ast.DropTableStmt{IfExists: $4.(bool), Tables: $5.([]*ast.TableName), IsView: false, TemporaryKeyword: $2.(ast.TemporaryKeyword)}
 	}
 
-IfExists:
+OptTemporary:
+	/* empty */
 	{
-		$$ = false
+		$$ = ast.TemporaryNone
 	}
-|	"IF" "EXISTS"
+|	"TEMPORARY"
 	{
-		$$ = true
+		$$ = ast.TemporaryLocal
+	}
+|	"GLOBAL" "TEMPORARY"
+	{
+		$$ = ast.TemporaryGlobal
 	}
 
-IfNotExists:
+DropViewStmt:
+	"DROP" "VIEW" TableNameList RestrictOrCascadeOpt
 	{
-		$$ = false
+		$$ = &ast.DropTableStmt{Tables: $3.([]*ast.TableName), IsView: true}
 	}
-|	"IF" NotSym "EXISTS"
+|	"DROP" "VIEW" "IF" "EXISTS" TableNameList RestrictOrCascadeOpt
 	{
-		$$ = true
+		$$ = &ast.DropTableStmt{IfExists: true, Tables: $5.([]*ast.TableName), IsView: true}
 	}
 
-IgnoreOptional:
+DropUserStmt:
+	"DROP" "USER" UsernameList
 	{
-		$$ = false
+		$$ = &ast.DropUserStmt{IsDropRole: false, IfExists: false, UserList: $3.([]*auth.UserIdentity)}
 	}
-|	"IGNORE"
+|	"DROP" "USER" "IF" "EXISTS" UsernameList
 	{
-		$$ = true
+		$$ = &ast.DropUserStmt{IsDropRole: false, IfExists: true, UserList: $5.([]*auth.UserIdentity)}
 	}
 
-IndexName:
+DropRoleStmt:
+	"DROP" "ROLE" RolenameList
 	{
-		$$ = &ast.NullString{
-			String: "",
-			Empty:  false,
+		tmp := make([]*auth.UserIdentity, 0, 10)
+		roleList := $3.([]*auth.RoleIdentity)
+		for _, r := range roleList {
+			tmp = append(tmp, &auth.UserIdentity{Username: r.Username, Hostname: r.Hostname})
 		}
+		$$ = &ast.DropUserStmt{IsDropRole: true, IfExists: false, UserList: tmp}
 	}
-|	Identifier
+|	"DROP" "ROLE" "IF" "EXISTS" RolenameList
 	{
-		$$ = &ast.NullString{
-			String: $1,
-			Empty:  len($1) == 0,
+		tmp := make([]*auth.UserIdentity, 0, 10)
+		roleList := $5.([]*auth.RoleIdentity)
+		for _, r := range roleList {
+			tmp = append(tmp, &auth.UserIdentity{Username: r.Username, Hostname: r.Hostname})
 		}
+		$$ = &ast.DropUserStmt{IsDropRole: true, IfExists: true, UserList: tmp}
 	}
 
-IndexOptionList:
+DropStatsStmt:
+	"DROP" "STATS" TableName
 	{
-		$$ = nil
+		$$ = &ast.DropStatsStmt{Table: $3.(*ast.TableName)}
 	}
-|	IndexOptionList IndexOption
+|	"DROP" "STATS" TableName "PARTITION" PartitionNameList
 	{
-		// Merge the options
-		if $1 == nil {
-			$$ = $2
-		} else {
-			opt1 := $1.(*ast.IndexOption)
-			opt2 := $2.(*ast.IndexOption)
-			if len(opt2.Comment) > 0 {
-				opt1.Comment = opt2.Comment
-			} else if opt2.Tp != 0 {
-				opt1.Tp = opt2.Tp
-			} else if opt2.KeyBlockSize > 0 {
-				opt1.KeyBlockSize = opt2.KeyBlockSize
-			} else if len(opt2.ParserName.O) > 0 {
-				opt1.ParserName = opt2.ParserName
-			} else if opt2.Visibility != ast.IndexVisibilityDefault {
-				opt1.Visibility = opt2.Visibility
-			} else if opt2.PrimaryKeyTp != model.PrimaryKeyTypeDefault {
-				opt1.PrimaryKeyTp = opt2.PrimaryKeyTp
-			}
-			$$ = opt1
+		$$ = &ast.DropStatsStmt{
+			Table:          $3.(*ast.TableName),
+			PartitionNames: $5.([]model.CIStr),
 		}
 	}
-
-IndexOption:
-	"KEY_BLOCK_SIZE" EqOpt LengthNum
+|	"DROP" "STATS" TableName "GLOBAL"
 	{
-		$$ = &ast.IndexOption{
-			KeyBlockSize: $3.(uint64),
+		$$ = &ast.DropStatsStmt{
+			Table:         $3.(*ast.TableName),
+			IsGlobalStats: true,
 		}
 	}
-|	IndexType
+
+RestrictOrCascadeOpt:
+	{}
+|	"RESTRICT"
+|	"CASCADE"
+
+TableOrTables:
+	"TABLE"
+|	"TABLES"
+
+EqOpt:
+	{}
+|	eq
+
+EmptyStmt:
+	/* EMPTY */
 	{
-		$$ = &ast.IndexOption{
-			Tp: $1.(model.IndexType),
-		}
+		$$ = nil
 	}
-|	"WITH" "PARSER" Identifier
+
+TraceStmt:
+	"TRACE" TraceableStmt
 	{
-		$$ = &ast.IndexOption{
-			ParserName: model.NewCIStr($3),
+		$$ = &ast.TraceStmt{
+			Stmt:      $2,
+			Format:    "row",
+			TracePlan: false,
 		}
-		yylex.AppendError(yylex.Errorf("The WITH PARASER clause is parsed but ignored by all storage engines."))
-		parser.lastErrorAsWarn()
+		startOffset := parser.startOffset(&yyS[yypt])
+		$2.SetText(parser.lexer.client, string(parser.src[startOffset:]))
 	}
-|	"COMMENT" stringLit
+|	"TRACE" "FORMAT" "=" stringLit TraceableStmt
 	{
-		$$ = &ast.IndexOption{
-			Comment: $2,
+		$$ = &ast.TraceStmt{
+			Stmt:      $5,
+			Format:    $4,
+			TracePlan: false,
 		}
+		startOffset := parser.startOffset(&yyS[yypt])
+		$5.SetText(parser.lexer.client, string(parser.src[startOffset:]))
 	}
-|	IndexInvisible
+|	"TRACE" "PLAN" TraceableStmt
 	{
-		$$ = &ast.IndexOption{
-			Visibility: $1.(ast.IndexVisibility),
+		$$ = &ast.TraceStmt{
+			Stmt:      $3,
+			TracePlan: true,
 		}
+		startOffset := parser.startOffset(&yyS[yypt])
+		$3.SetText(parser.lexer.client, string(parser.src[startOffset:]))
 	}
-|	WithClustered
+|	"TRACE" "PLAN" "TARGET" "=" stringLit TraceableStmt
 	{
-		$$ = &ast.IndexOption{
-			PrimaryKeyTp: $1.(model.PrimaryKeyType),
+		$$ = &ast.TraceStmt{
+			Stmt:            $6,
+			TracePlan:       true,
+			TracePlanTarget: $5,
 		}
+		startOffset := parser.startOffset(&yyS[yypt])
+		$6.SetText(parser.lexer.client, string(parser.src[startOffset:]))
 	}
 
-/*
-  See: https://github.com/mysql/mysql-server/blob/8.0/sql/sql_yacc.yy#L7179
-
-  The syntax for defining an index is:
-
-    ... INDEX [index_name] [USING|TYPE] <index_type> ...
-
-  The problem is that whereas USING is a reserved word, TYPE is not. We can
-  still handle it if an index name is supplied, i.e.:
-
-    ... INDEX type TYPE <index_type> ...
-
-  here the index's name is unmbiguously 'type', but for this:
-
-    ... INDEX TYPE <index_type> ...
+ExplainSym:
+	"EXPLAIN"
+|	"DESCRIBE"
+|	"DESC"
 
-  it's impossible to know what this actually mean - is 'type' the name or the
-  type? For this reason we accept the TYPE syntax only if a name is supplied.
-*/
-IndexNameAndTypeOpt:
-	IndexName
+ExplainStmt:
+	ExplainSym TableName
 	{
-		$$ = []interface{}{$1, nil}
+		$$ = &ast.ExplainStmt{
+			Stmt: &ast.ShowStmt{
+				Tp:    ast.ShowColumns,
+				Table: $2.(*ast.TableName),
+			},
+		}
 	}
-|	IndexName "USING" IndexTypeName
+|	ExplainSym TableName ColumnName
 	{
-		$$ = []interface{}{$1, $3}
+		$$ = &ast.ExplainStmt{
+			Stmt: &ast.ShowStmt{
+				Tp:     ast.ShowColumns,
+				Table:  $2.(*ast.TableName),
+				Column: $3.(*ast.ColumnName),
+			},
+		}
 	}
-|	Identifier "TYPE" IndexTypeName
+|	ExplainSym ExplainableStmt
 	{
-		$$ = []interface{}{&ast.NullString{String: $1, Empty: len($1) == 0}, $3}
+		$$ = &ast.ExplainStmt{
+			Stmt:   $2,
+			Format: "row",
+		}
 	}
-
-IndexTypeOpt:
+|	ExplainSym "FOR" "CONNECTION" NUM
 	{
-		$$ = nil
+		$$ = &ast.ExplainForStmt{
+			Format:       "row",
+			ConnectionID: getUint64FromNUM($4),
+		}
 	}
-|	IndexType
-
-IndexType:
-	"USING" IndexTypeName
+|	ExplainSym "FORMAT" "=" stringLit "FOR" "CONNECTION" NUM
 	{
-		$$ = $2
-	}
-|	"TYPE" IndexTypeName
+		$$ = &ast.ExplainForStmt{
+			Format:       $4,
+			ConnectionID: getUint64FromNUM($7),
+		}
+	}
+|	ExplainSym "FORMAT" "=" stringLit ExplainableStmt
 	{
-		$$ = $2
+		$$ = &ast.ExplainStmt{
+			Stmt:   $5,
+			Format: $4,
+		}
+	}
+|	ExplainSym "FORMAT" "=" ExplainFormatType "FOR" "CONNECTION" NUM
+	{
+		$$ = &ast.ExplainForStmt{
+			Format:       $4,
+			ConnectionID: getUint64FromNUM($7),
+		}
+	}
+|	ExplainSym "FORMAT" "=" ExplainFormatType ExplainableStmt
+	{
+		$$ = &ast.ExplainStmt{
+			Stmt:   $5,
+			Format: $4,
+		}
+	}
+|	ExplainSym "ANALYZE" ExplainableStmt
+	{
+		$$ = &ast.ExplainStmt{
+			Stmt:    $3,
+			Format:  "row",
+			Analyze: true,
+		}
 	}
 
-IndexTypeName:
-	"BTREE"
+ExplainFormatType:
+	"TRADITIONAL"
+|	"JSON"
+|	"ROW"
+|	"DOT"
+|	"BRIEF"
+|	"VERBOSE"
+
+/*******************************************************************
+ *
+ *  Explain Sharding Statement
+ *
+ *  Example:
+ *      EXPLAIN SHARDING SELECT * FROM t WHERE uid = 1
+ *      PREVIEW UPDATE t SET c = 1 WHERE uid IN (1, 2)
+ *******************************************************************/
+ExplainShardingStmt:
+	ExplainSym "SHARDING" ShardingPreviewableStmt
 	{
-		$$ = model.IndexTypeBtree
+		startOffset := parser.startOffset(&yyS[yypt])
+		$3.SetOriginTextPosition(startOffset)
+		$3.SetText(parser.lexer.client, strings.TrimSpace(parser.src[startOffset:]))
+		$$ = &ast.ExplainShardingStmt{Stmt: $3}
 	}
-|	"HASH"
+|	"PREVIEW" ShardingPreviewableStmt
 	{
-		$$ = model.IndexTypeHash
+		startOffset := parser.startOffset(&yyS[yypt])
+		$2.SetOriginTextPosition(startOffset)
+		$2.SetText(parser.lexer.client, strings.TrimSpace(parser.src[startOffset:]))
+		$$ = &ast.ExplainShardingStmt{Stmt: $2, Preview: true}
 	}
-|	"RTREE"
+
+/*******************************************************************
+ * Backup / restore / import statements
+ *
+ *	BACKUP DATABASE [ * | db1, db2, db3 ] TO 'scheme://location' [ options... ]
+ *	BACKUP TABLE [ db1.tbl1, db2.tbl2 ] TO 'scheme://location' [ options... ]
+ *	RESTORE DATABASE [ * | db1, db2, db3 ] FROM 'scheme://location' [ options... ]
+ *	RESTORE TABLE [ db1.tbl1, db2.tbl2 ] FROM 'scheme://location' [ options... ]
+ */
+BRIEStmt:
+	"BACKUP" BRIETables "TO" stringLit BRIEOptions
 	{
-		$$ = model.IndexTypeRtree
+		stmt := $2.(*ast.BRIEStmt)
+		stmt.Kind = ast.BRIEKindBackup
+		stmt.Storage = $4
+		stmt.Options = $5.([]*ast.BRIEOption)
+		$$ = stmt
+	}
+|	"RESTORE" BRIETables "FROM" stringLit BRIEOptions
+	{
+		stmt := $2.(*ast.BRIEStmt)
+		stmt.Kind = ast.BRIEKindRestore
+		stmt.Storage = $4
+		stmt.Options = $5.([]*ast.BRIEOption)
+		$$ = stmt
 	}
 
-IndexInvisible:
-	"VISIBLE"
+BRIETables:
+	DatabaseSym '*'
 	{
-		$$ = ast.IndexVisibilityVisible
+		$$ = &ast.BRIEStmt{}
 	}
-|	"INVISIBLE"
+|	DatabaseSym DBNameList
 	{
-		$$ = ast.IndexVisibilityInvisible
+		$$ = &ast.BRIEStmt{Schemas: $2.([]string)}
+	}
+|	"TABLE" TableNameList
+	{
+		$$ = &ast.BRIEStmt{Tables: $2.([]*ast.TableName)}
 	}
 
-/**********************************Identifier********************************************/
-Identifier:
-	identifier
-|	UnReservedKeyword
-|	NotKeywordToken
-|	TiDBKeyword
+DBNameList:
+	DBName
+	{
+		$$ = []string{$1}
+	}
+|	DBNameList ',' DBName
+	{
+		$$ = append($1.([]string), $3)
+	}
 
-UnReservedKeyword:
-	"ACTION"
-|	"ADVISE"
-|	"ASCII"
-|	"ATTRIBUTES"
-|	"STATS_OPTIONS"
-|	"STATS_SAMPLE_RATE"
-|	"STATS_COL_CHOICE"
-|	"STATS_COL_LIST"
-|	"AUTO_ID_CACHE"
-|	"AUTO_INCREMENT"
-|	"AFTER"
-|	"ALWAYS"
-|	"AVG"
-|	"BEGIN"
-|	"BIT"
-|	"BOOL"
-|	"BOOLEAN"
-|	"BTREE"
-|	"BYTE"
-|	"CAPTURE"
-|	"CAUSAL"
-|	"CLEANUP"
-|	"CHAIN"
-|	"CHARSET"
-|	"COLUMNS"
-|	"CONFIG"
-|	"SAN"
-|	"COMMIT"
-|	"COMPACT"
-|	"COMPRESSED"
-|	"CONSISTENCY"
-|	"CONSISTENT"
-|	"CURRENT"
-|	"DATA"
-|	"DATE" %prec lowerThanStringLitToken
-|	"DATETIME"
-|	"DAY"
-|	"DEALLOCATE"
-|	"DO"
-|	"DUPLICATE"
-|	"DYNAMIC"
-|	"ENCRYPTION"
-|	"END"
-|	"ENFORCED"
-|	"ENGINE"
-|	"ENGINES"
-|	"ENUM"
-|	"ERROR"
-|	"ERRORS"
-|	"ESCAPE"
-|	"EVOLVE"
-|	"EXECUTE"
-|	"EXTENDED"
-|	"FIELDS"
-|	"FILE"
-|	"FIRST"
-|	"FIXED"
-|	"FLUSH"
-|	"FOLLOWING"
-|	"FORMAT"
-|	"FULL"
-|	"GENERAL"
-|	"GLOBAL"
-|	"HASH"
-|	"HELP"
-|	"HOUR"
-|	"INSERT_METHOD"
-|	"LESS"
-|	"LOCAL"
-|	"LAST"
-|	"NAMES"
-|	"NVARCHAR"
-|	"OFFSET"
-|	"PACK_KEYS"
-|	"PARSER"
-|	"PASSWORD" %prec lowerThanEq
-|	"PREPARE"
-|	"PRE_SPLIT_REGIONS"
-|	"PROXY"
-|	"QUICK"
-|	"REBUILD"
-|	"REDUNDANT"
-|	"REORGANIZE"
-|	"RESTART"
-|	"ROLE"
-|	"ROLLBACK"
-|	"SESSION"
-|	"SIGNED"
-|	"SHARD_ROW_ID_BITS"
-|	"SHUTDOWN"
-|	"SNAPSHOT"
-|	"START"
-|	"STATUS"
-|	"OPEN"
-|	"SUBPARTITIONS"
-|	"SUBPARTITION"
-|	"DBPARTITIONS"
-|	"TBPARTITIONS"
-|	"TBPARTITION"
-|	"TABLES"
-|	"TABLESPACE"
-|	"TEXT"
-|	"THAN"
-|	"TIME" %prec lowerThanStringLitToken
-|	"TIMESTAMP" %prec lowerThanStringLitToken
-|	"TRACE"
-|	"TRANSACTION"
-|	"TRUNCATE"
-|	"UNBOUNDED"
-|	"UNKNOWN"
-|	"VALUE" %prec lowerThanValueKeyword
-|	"WARNINGS"
-|	"YEAR"
-|	"MODE"
-|	"WEEK"
-|	"WEIGHT_STRING"
-|	"ANY"
-|	"SOME"
-|	"USER"
-|	"IDENTIFIED"
-|	"COLLATION"
-|	"COMMENT"
-|	"AVG_ROW_LENGTH"
-|	"CONNECTION"
-|	"CHECKSUM"
-|	"COMPRESSION"
-|	"KEY_BLOCK_SIZE"
-|	"MASTER"
-|	"MAX_ROWS"
-|	"MIN_ROWS"
-|	"NATIONAL"
-|	"NCHAR"
-|	"ROW_FORMAT"
-|	"QUARTER"
-|	"GRANTS"
-|	"TRIGGERS"
-|	"DELAY_KEY_WRITE"
-|	"ISOLATION"
-|	"JSON"
-|	"REPEATABLE"
-|	"RESPECT"
-|	"COMMITTED"
-|	"UNCOMMITTED"
-|	"ONLY"
-|	"SERIAL"
-|	"SERIALIZABLE"
-|	"LEVEL"
-|	"VARIABLES"
-|	"SQL_CACHE"
-|	"INDEXES"
-|	"PROCESSLIST"
-|	"SQL_NO_CACHE"
-|	"DISABLE"
-|	"ENABLE"
-|	"REVERSE"
-|	"PRIVILEGES"
-|	"NO"
-|	"BINLOG"
-|	"FUNCTION"
-|	"VIEW"
-|	"BINDING"
-|	"BINDINGS"
-|	"MODIFY"
-|	"EVENTS"
-|	"PARTITIONS"
-|	"NONE"
-|	"NULLS"
-|	"SUPER"
-|	"EXCLUSIVE"
-|	"STATS_PERSISTENT"
-|	"STATS_AUTO_RECALC"
-|	"ROW_COUNT"
-|	"COALESCE"
-|	"MONTH"
-|	"PROCESS"
-|	"PROFILE"
-|	"PROFILES"
-|	"MICROSECOND"
-|	"MINUTE"
-|	"PLUGINS"
-|	"PRECEDING"
-|	"QUERY"
-|	"QUERIES"
-|	"SECOND"
-|	"SEPARATOR"
-|	"SHARE"
-|	"SHARED"
-|	"SLOW"
-|	"MAX_CONNECTIONS_PER_HOUR"
-|	"MAX_QUERIES_PER_HOUR"
-|	"MAX_UPDATES_PER_HOUR"
-|	"MAX_USER_CONNECTIONS"
-|	"REPLICATION"
-|	"CLIENT"
-|	"SLAVE"
-|	"RELOAD"
-|	"TEMPORARY"
-|	"ROUTINE"
-|	"EVENT"
-|	"ALGORITHM"
-|	"DEFINER"
-|	"INVOKER"
-|	"MERGE"
-|	"TEMPTABLE"
-|	"UNDEFINED"
-|	"SECURITY"
-|	"CASCADED"
-|	"RECOVER"
-|	"CIPHER"
-|	"SUBJECT"
-|	"ISSUER"
-|	"X509"
-|	"NEVER"
-|	"EXPIRE"
-|	"ACCOUNT"
-|	"INCREMENTAL"
-|	"CPU"
-|	"MEMORY"
-|	"BLOCK"
-|	"IO"
-|	"CONTEXT"
-|	"SWITCHES"
-|	"PAGE"
-|	"FAULTS"
-|	"IPC"
-|	"SWAPS"
-|	"SOURCE"
-|	"TRADITIONAL"
-|	"SQL_BUFFER_RESULT"
-|	"DIRECTORY"
-|	"HISTOGRAM"
-|	"HISTORY"
-|	"LIST"
-|	"NODEGROUP"
-|	"SYSTEM_TIME"
-|	"PARTIAL"
-|	"SIMPLE"
-|	"REMOVE"
-|	"PARTITIONING"
-|	"STORAGE"
-|	"DISK"
-|	"STATS_SAMPLE_PAGES"
-|	"SECONDARY_ENGINE"
-|	"SECONDARY_LOAD"
-|	"SECONDARY_UNLOAD"
-|	"VALIDATION"
-|	"WITHOUT"
-|	"RTREE"
-|	"EXCHANGE"
-|	"COLUMN_FORMAT"
-|	"REPAIR"
-|	"IMPORT"
-|	"IMPORTS"
-|	"DISCARD"
-|	"TABLE_CHECKSUM"
-|	"UNICODE"
-|	"AUTO_RANDOM"
-|	"AUTO_RANDOM_BASE"
-|	"SQL_TSI_DAY"
-|	"SQL_TSI_HOUR"
-|	"SQL_TSI_MINUTE"
-|	"SQL_TSI_MONTH"
-|	"SQL_TSI_QUARTER"
-|	"SQL_TSI_SECOND"
-|	"LANGUAGE"
-|	"SQL_TSI_WEEK"
-|	"SQL_TSI_YEAR"
-|	"INVISIBLE"
-|	"VISIBLE"
-|	"TYPE"
-|	"NOWAIT"
-|	"INSTANCE"
-|	"REPLICA"
-|	"LOCATION"
-|	"LABELS"
-|	"LOGS"
-|	"HOSTS"
-|	"AGAINST"
-|	"EXPANSION"
-|	"INCREMENT"
-|	"MINVALUE"
-|	"NOMAXVALUE"
-|	"NOMINVALUE"
-|	"NOCACHE"
-|	"CACHE"
-|	"CYCLE"
-|	"NOCYCLE"
-|	"SEQUENCE"
-|	"TOPOLOGY"
-|	"USERS"
-|	"NODES"
-|	"MAX_MINUTES"
-|	"MAX_IDXNUM"
-|	"PER_TABLE"
-|	"PER_DB"
-|	"NEXT"
-|	"NEXTVAL"
-|	"LASTVAL"
-|	"SETVAL"
-|	"AGO"
-|	"BACKUP"
-|	"BACKUPS"
-|	"CONCURRENCY"
-|	"MB"
+BRIEOptions:
+	%prec empty
+	{
+		$$ = []*ast.BRIEOption{}
+	}
+|	BRIEOptions BRIEOption
+	{
+		$$ = append($1.([]*ast.BRIEOption), $2.(*ast.BRIEOption))
+	}
+
+BRIEIntegerOptionName:
+	"CONCURRENCY"
+	{
+		$$ = ast.BRIEOptionConcurrency
+	}
+|	"RESUME"
+	{
+		$$ = ast.BRIEOptionResume
+	}
+
+BRIEBooleanOptionName:
+	"SEND_CREDENTIALS_TO_TIKV"
+	{
+		$$ = ast.BRIEOptionSendCreds
+	}
 |	"ONLINE"
-|	"RATE_LIMIT"
-|	"RESTORE"
-|	"RESTORES"
-|	"RULES"
-|	"SEND_CREDENTIALS_TO_TIKV"
-|	"LAST_BACKUP"
+	{
+		$$ = ast.BRIEOptionOnline
+	}
 |	"CHECKPOINT"
+	{
+		$$ = ast.BRIEOptionCheckpoint
+	}
 |	"SKIP_SCHEMA_FILES"
+	{
+		$$ = ast.BRIEOptionSkipSchemaFiles
+	}
 |	"STRICT_FORMAT"
-|	"BACKEND"
-|	"CSV_BACKSLASH_ESCAPE"
+	{
+		$$ = ast.BRIEOptionStrictFormat
+	}
 |	"CSV_NOT_NULL"
+	{
+		$$ = ast.BRIEOptionCSVNotNull
+	}
+|	"CSV_BACKSLASH_ESCAPE"
+	{
+		$$ = ast.BRIEOptionCSVBackslashEscape
+	}
 |	"CSV_TRIM_LAST_SEPARATORS"
+	{
+		$$ = ast.BRIEOptionCSVTrimLastSeparators
+	}
+
+BRIEStringOptionName:
+	"TIKV_IMPORTER"
+	{
+		$$ = ast.BRIEOptionTiKVImporter
+	}
+|	"CSV_SEPARATOR"
+	{
+		$$ = ast.BRIEOptionCSVSeparator
+	}
 |	"CSV_DELIMITER"
-|	"CSV_HEADER"
+	{
+		$$ = ast.BRIEOptionCSVDelimiter
+	}
 |	"CSV_NULL"
-|	"CSV_SEPARATOR"
-|	"ON_DUPLICATE"
-|	"TIKV_IMPORTER"
-|	"REPLICAS"
-|	"POLICY"
-|	"WAIT"
-|	"CLIENT_ERRORS_SUMMARY"
-|	"BERNOULLI"
-|	"SYSTEM"
-|	"PERCENT"
-|	"RESUME"
-|	"OFF"
-|	"OPTIONAL"
-|	"REQUIRED"
-|	"PURGE"
-|	"SKIP"
-|	"LOCKED"
-|	"CLUSTERED"
-|	"NONCLUSTERED"
-|	"TABLE_RULES"
-|	"SHARDING"
-|	"PRESERVE"
+	{
+		$$ = ast.BRIEOptionCSVNull
+	}
 
-TiDBKeyword:
-	"ADMIN"
-|	"BUCKETS"
-|	"BUILTINS"
-|	"CANCEL"
+BRIEKeywordOptionName:
+	"BACKEND"
+	{
+		$$ = ast.BRIEOptionBackend
+	}
+|	"ON_DUPLICATE"
+	{
+		$$ = ast.BRIEOptionOnDuplicate
+	}
+|	"ON" "DUPLICATE"
+	{
+		$$ = ast.BRIEOptionOnDuplicate
+	}
+
+BRIEOption:
+	BRIEIntegerOptionName EqOpt LengthNum
+	{
+		$$ = &ast.BRIEOption{
+			Tp:        $1.(ast.BRIEOptionType),
+			UintValue: $3.(uint64),
+		}
+	}
+|	BRIEBooleanOptionName EqOpt Boolean
+	{
+		value := uint64(0)
+		if $3.(bool) {
+			value = 1
+		}
+		$$ = &ast.BRIEOption{
+			Tp:        $1.(ast.BRIEOptionType),
+			UintValue: value,
+		}
+	}
+|	BRIEStringOptionName EqOpt stringLit
+	{
+		$$ = &ast.BRIEOption{
+			Tp:       $1.(ast.BRIEOptionType),
+			StrValue: $3,
+		}
+	}
+|	BRIEKeywordOptionName EqOpt StringNameOrBRIEOptionKeyword
+	{
+		$$ = &ast.BRIEOption{
+			Tp:       $1.(ast.BRIEOptionType),
+			StrValue: strings.ToLower($3),
+		}
+	}
+|	"SNAPSHOT" EqOpt LengthNum TimestampUnit "AGO"
+	{
+		unit, err := $4.(ast.TimeUnitType).Duration()
+		if err != nil {
+			yylex.AppendError(err)
+			return 1
+		}
+		// TODO: check overflow?
+		$$ = &ast.BRIEOption{
+			Tp:        ast.BRIEOptionBackupTimeAgo,
+			UintValue: $3.(uint64) * uint64(unit),
+		}
+	}
+|	"SNAPSHOT" EqOpt stringLit
+	// not including this into BRIEStringOptionName to avoid shift/reduce conflict
+	{
+		$$ = &ast.BRIEOption{
+			Tp:       ast.BRIEOptionBackupTS,
+			StrValue: $3,
+		}
+	}
+|	"SNAPSHOT" EqOpt LengthNum
+	// not including this into BRIEIntegerOptionName to avoid shift/reduce conflict
+	{
+		$$ = &ast.BRIEOption{
+			Tp:        ast.BRIEOptionBackupTSO,
+			UintValue: $3.(uint64),
+		}
+	}
+|	"LAST_BACKUP" EqOpt stringLit
+	{
+		$$ = &ast.BRIEOption{
+			Tp:       ast.BRIEOptionLastBackupTS,
+			StrValue: $3,
+		}
+	}
+|	"LAST_BACKUP" EqOpt LengthNum
+	{
+		$$ = &ast.BRIEOption{
+			Tp:        ast.BRIEOptionLastBackupTSO,
+			UintValue: $3.(uint64),
+		}
+	}
+|	"RATE_LIMIT" EqOpt LengthNum "MB" '/' "SECOND"
+	{
+		// TODO: check overflow?
+		$$ = &ast.BRIEOption{
+			Tp:        ast.BRIEOptionRateLimit,
+			UintValue: $3.(uint64) * 1048576,
+		}
+	}
+|	"CSV_HEADER" EqOpt FieldsOrColumns
+	{
+		$$ = &ast.BRIEOption{
+			Tp:        ast.BRIEOptionCSVHeader,
+			UintValue: ast.BRIECSVHeaderIsColumns,
+		}
+	}
+|	"CSV_HEADER" EqOpt LengthNum
+	{
+		$$ = &ast.BRIEOption{
+			Tp:        ast.BRIEOptionCSVHeader,
+			UintValue: $3.(uint64),
+		}
+	}
+|	"CHECKSUM" EqOpt Boolean
+	{
+		value := uint64(0)
+		if $3.(bool) {
+			value = 1
+		}
+		$$ = &ast.BRIEOption{
+			Tp:        ast.BRIEOptionChecksum,
+			UintValue: value,
+		}
+	}
+|	"CHECKSUM" EqOpt OptionLevel
+	{
+		$$ = &ast.BRIEOption{
+			Tp:        ast.BRIEOptionChecksum,
+			UintValue: uint64($3.(ast.BRIEOptionLevel)),
+		}
+	}
+|	"ANALYZE" EqOpt Boolean
+	{
+		value := uint64(0)
+		if $3.(bool) {
+			value = 1
+		}
+		$$ = &ast.BRIEOption{
+			Tp:        ast.BRIEOptionAnalyze,
+			UintValue: value,
+		}
+	}
+|	"ANALYZE" EqOpt OptionLevel
+	{
+		$$ = &ast.BRIEOption{
+			Tp:        ast.BRIEOptionAnalyze,
+			UintValue: uint64($3.(ast.BRIEOptionLevel)),
+		}
+	}
+
+LengthNum:
+	NUM
+	{
+		$$ = getUint64FromNUM($1)
+	}
+
+Int64Num:
+	NUM
+	{
+		v, rangeErrMsg := getInt64FromNUM($1)
+		if len(rangeErrMsg) != 0 {
+			yylex.AppendError(yylex.Errorf(rangeErrMsg))
+			return 1
+		}
+		$$ = v
+	}
+
+NUM:
+	intLit
+
+Boolean:
+	NUM
+	{
+		$$ = $1.(int64) != 0
+	}
+|	"FALSE"
+	{
+		$$ = false
+	}
+|	"TRUE"
+	{
+		$$ = true
+	}
+
+OptionLevel:
+	"OFF"
+	{
+		$$ = ast.BRIEOptionLevelOff
+	}
+|	"OPTIONAL"
+	{
+		$$ = ast.BRIEOptionLevelOptional
+	}
+|	"REQUIRED"
+	{
+		$$ = ast.BRIEOptionLevelRequired
+	}
+
+PurgeImportStmt:
+	"PURGE" "IMPORT" NUM
+	{
+		$$ = &ast.PurgeImportStmt{TaskID: getUint64FromNUM($3)}
+	}
+
+/*******************************************************************
+ * import statements
+ *
+ *	CREATE IMPORT [IF NOT EXISTS] import_name
+ *		FROM data_location [REPLACE | SKIP {ALL | CONSTRAINT | DUPLICATE ｜ STRICT}]
+ *		[options_list]
+ *	STOP IMPORT [IF RUNNING] import_name
+ *	RESUME IMPORT [IF NOT RUNNING] import_name
+ *	ALTER IMPORT import_name
+ *		[REPLACE | SKIP {ALL | CONSTRAINT | DUPLICATE | STRICT}]
+ *		[options_list]
+ *		[TRUNCATE
+ *			{ALL | ERRORS} [TABLE table_name [, table_name] ...]
+ *		]
+ *	DROP IMPORT [IF EXISTS] import_name
+ *	SHOW IMPORT import_name [ERRORS] [TABLE table_name [, table_name] ...]
+ */
+CreateImportStmt:
+	"CREATE" "IMPORT" IfNotExists Identifier "FROM" stringLit ErrorHandling BRIEOptions
+	{
+		$$ = &ast.CreateImportStmt{
+			IfNotExists:   $3.(bool),
+			Name:          $4,
+			Storage:       $6,
+			ErrorHandling: $7.(ast.ErrorHandlingOption),
+			Options:       $8.([]*ast.BRIEOption),
+		}
+	}
+
+StopImportStmt:
+	"STOP" "IMPORT" IfRunning Identifier
+	{
+		$$ = &ast.StopImportStmt{
+			IfRunning: $3.(bool),
+			Name:      $4,
+		}
+	}
+
+ResumeImportStmt:
+	"RESUME" "IMPORT" IfNotRunning Identifier
+	{
+		$$ = &ast.ResumeImportStmt{
+			IfNotRunning: $3.(bool),
+			Name:         $4,
+		}
+	}
+
+AlterImportStmt:
+	"ALTER" "IMPORT" Identifier ErrorHandling BRIEOptions ImportTruncate
+	{
+		s := &ast.AlterImportStmt{
+			Name:          $3,
+			ErrorHandling: $4.(ast.ErrorHandlingOption),
+			Options:       $5.([]*ast.BRIEOption),
+		}
+		if $6 != nil {
+			s.Truncate = $6.(*ast.ImportTruncate)
+		}
+		$$ = s
+	}
+
+DropImportStmt:
+	"DROP" "IMPORT" IfExists Identifier
+	{
+		$$ = &ast.DropImportStmt{
+			IfExists: $3.(bool),
+			Name:     $4,
+		}
+	}
+
+ShowImportStmt:
+	"SHOW" "IMPORT" Identifier OptErrors TableNameListOpt2
+	{
+		$$ = &ast.ShowImportStmt{
+			Name:       $3,
+			ErrorsOnly: $4.(bool),
+			TableNames: $5.([]*ast.TableName),
+		}
+	}
+
+IfRunning:
+	{
+		$$ = false
+	}
+|	"IF" "RUNNING"
+	{
+		$$ = true
+	}
+
+IfNotRunning:
+	{
+		$$ = false
+	}
+|	"IF" NotSym "RUNNING"
+	{
+		$$ = true
+	}
+
+OptErrors:
+	{
+		$$ = false
+	}
+|	"ERRORS"
+	{
+		$$ = true
+	}
+
+ErrorHandling:
+	{
+		$$ = ast.ErrorHandleError
+	}
+|	"REPLACE"
+	{
+		$$ = ast.ErrorHandleReplace
+	}
+|	"SKIP" "ALL"
+	{
+		$$ = ast.ErrorHandleSkipAll
+	}
+|	"SKIP" "CONSTRAINT"
+	{
+		$$ = ast.ErrorHandleSkipConstraint
+	}
+|	"SKIP" "DUPLICATE"
+	{
+		$$ = ast.ErrorHandleSkipDuplicate
+	}
+|	"SKIP" "STRICT"
+	{
+		$$ = ast.ErrorHandleSkipStrict
+	}
+
+ImportTruncate:
+	{
+		$$ = nil
+	}
+|	"TRUNCATE" "ALL" TableNameListOpt2
+	{
+		$$ = &ast.ImportTruncate{
+			IsErrorsOnly: false,
+			TableNames:   $3.([]*ast.TableName),
+		}
+	}
+|	"TRUNCATE" "ERRORS" TableNameListOpt2
+	{
+		$$ = &ast.ImportTruncate{
+			IsErrorsOnly: true,
+			TableNames:   $3.([]*ast.TableName),
+		}
+	}
+
+Expression:
+	singleAtIdentifier assignmentEq Expression %prec assignmentEq
+	{
+		v := $1
+		v = strings.TrimPrefix(v, "@")
+		$$ = &ast.VariableExpr{
+			Name:     v,
+			IsGlobal: false,
+			IsSystem: false,
+			Value:    $3,
+		}
+	}
+|	Expression logOr Expression %prec pipes
+	{
+		$$ = &ast.BinaryOperationExpr{Op: opcode.LogicOr, L: $1, R: $3}
+	}
+|	Expression "XOR" Expression %prec xor
+	{
+		$$ = &ast.BinaryOperationExpr{Op: opcode.LogicXor, L: $1, R: $3}
+	}
+|	Expression logAnd Expression %prec andand
+	{
+		$$ = &ast.BinaryOperationExpr{Op: opcode.LogicAnd, L: $1, R: $3}
+	}
+|	"NOT" Expression %prec not
+	{
+		expr, ok := $2.(*ast.ExistsSubqueryExpr)
+		if ok {
+			expr.Not = !expr.Not
+			$$ = $2
+		} else {
+			$$ = &ast.UnaryOperationExpr{Op: opcode.Not, V: $2}
+		}
+	}
+|	"MATCH" '(' ColumnNameList ')' "AGAINST" '(' BitExpr FulltextSearchModifierOpt ')'
+	{
+		$$ = &ast.MatchAgainst{
+			ColumnNames: $3.([]*ast.ColumnName),
+			Against:     $7,
+			Modifier:    ast.FulltextSearchModifier($8.(int)),
+		}
+	}
+|	BoolPri IsOrNotOp trueKwd %prec is
+	{
+		$$ = &ast.IsTruthExpr{Expr: $1, Not: !$2.(bool), True: int64(1)}
+	}
+|	BoolPri IsOrNotOp falseKwd %prec is
+	{
+		$$ = &ast.IsTruthExpr{Expr: $1, Not: !$2.(bool), True: int64(0)}
+	}
+|	BoolPri IsOrNotOp "UNKNOWN" %prec is
+	{
+		/* https://dev.mysql.com/doc/refman/5.7/en/comparison-operators.html#operator_is */
+		$$ = &ast.IsNullExpr{Expr: $1, Not: !$2.(bool)}
+	}
+|	BoolPri
+
+MaxValueOrExpression:
+	"MAXVALUE"
+	{
+		$$ = &ast.MaxValueExpr{}
+	}
+|	BitExpr
+
+FulltextSearchModifierOpt:
+	/* empty */
+	{
+		$$ = ast.FulltextSearchModifierNaturalLanguageMode
+	}
+|	"IN" "NATURAL" "LANGUAGE" "MODE"
+	{
+		$$ = ast.FulltextSearchModifierNaturalLanguageMode
+	}
+|	"IN" "NATURAL" "LANGUAGE" "MODE" "WITH" "QUERY" "EXPANSION"
+	{
+		$$ = ast.FulltextSearchModifierNaturalLanguageMode | ast.FulltextSearchModifierWithQueryExpansion
+	}
+|	"IN" "BOOLEAN" "MODE"
+	{
+		$$ = ast.FulltextSearchModifierBooleanMode
+	}
+|	"WITH" "QUERY" "EXPANSION"
+	{
+		$$ = ast.FulltextSearchModifierWithQueryExpansion
+	}
+
+logOr:
+	pipesAsOr
+|	"OR"
+
+logAnd:
+	"&&"
+|	"AND"
+
+ExpressionList:
+	Expression
+	{
+		$$ = []ast.ExprNode{$1}
+	}
+|	ExpressionList ',' Expression
+	{
+		$$ = append($1.([]ast.ExprNode), $3)
+	}
+
+MaxValueOrExpressionList:
+	MaxValueOrExpression
+	{
+		$$ = []ast.ExprNode{$1}
+	}
+|	MaxValueOrExpressionList ',' MaxValueOrExpression
+	{
+		$$ = append($1.([]ast.ExprNode), $3)
+	}
+
+ExpressionListOpt:
+	{
+		$$ = []ast.ExprNode{}
+	}
+|	ExpressionList
+
+FuncDatetimePrecListOpt:
+	{
+		$$ = []ast.ExprNode{}
+	}
+|	FuncDatetimePrecList
+
+FuncDatetimePrecList:
+	intLit
+	{
+		expr := ast.NewValueExpr($1, parser.charset, parser.collation)
+		$$ = []ast.ExprNode{expr}
+	}
+
+BoolPri:
+	BoolPri IsOrNotOp "NULL" %prec is
+	{
+		$$ = &ast.IsNullExpr{Expr: $1, Not: !$2.(bool)}
+	}
+|	BoolPri CompareOp PredicateExpr %prec eq
+	{
+		$$ = &ast.BinaryOperationExpr{Op: $2.(opcode.Op), L: $1, R: $3}
+	}
+|	BoolPri CompareOp AnyOrAll SubSelect %prec eq
+	{
+		sq := $4.(*ast.SubqueryExpr)
+		sq.MultiRows = true
+		$$ = &ast.CompareSubqueryExpr{Op: $2.(opcode.Op), L: $1, R: sq, All: $3.(bool)}
+	}
+|	BoolPri CompareOp singleAtIdentifier assignmentEq PredicateExpr %prec assignmentEq
+	{
+		v := $3
+		v = strings.TrimPrefix(v, "@")
+		variable := &ast.VariableExpr{
+			Name:     v,
+			IsGlobal: false,
+			IsSystem: false,
+			Value:    $5,
+		}
+		$$ = &ast.BinaryOperationExpr{Op: $2.(opcode.Op), L: $1, R: variable}
+	}
+|	PredicateExpr
+
+CompareOp:
+	">="
+	{
+		$$ = opcode.GE
+	}
+|	'>'
+	{
+		$$ = opcode.GT
+	}
+|	"<="
+	{
+		$$ = opcode.LE
+	}
+|	'<'
+	{
+		$$ = opcode.LT
+	}
+|	"!="
+	{
+		$$ = opcode.NE
+	}
+|	"<>"
+	{
+		$$ = opcode.NE
+	}
+|	"="
+	{
+		$$ = opcode.EQ
+	}
+|	"<=>"
+	{
+		$$ = opcode.NullEQ
+	}
+
+BetweenOrNotOp:
+	"BETWEEN"
+	{
+		$$ = true
+	}
+|	NotSym "BETWEEN"
+	{
+		$$ = false
+	}
+
+IsOrNotOp:
+	"IS"
+	{
+		$$ = true
+	}
+|	"IS" NotSym
+	{
+		$$ = false
+	}
+
+InOrNotOp:
+	"IN"
+	{
+		$$ = true
+	}
+|	NotSym "IN"
+	{
+		$$ = false
+	}
+
+LikeOrNotOp:
+	"LIKE"
+	{
+		$$ = true
+	}
+|	NotSym "LIKE"
+	{
+		$$ = false
+	}
+
+RegexpOrNotOp:
+	RegexpSym
+	{
+		$$ = true
+	}
+|	NotSym RegexpSym
+	{
+		$$ = false
+	}
+
+AnyOrAll:
+	"ANY"
+	{
+		$$ = false
+	}
+|	"SOME"
+	{
+		$$ = false
+	}
+|	"ALL"
+	{
+		$$ = true
+	}
+
+PredicateExpr:
+	BitExpr InOrNotOp '(' ExpressionList ')'
+	{
+		$$ = &ast.PatternInExpr{Expr: $1, Not: !$2.(bool), List: $4.([]ast.ExprNode)}
+	}
+|	BitExpr InOrNotOp SubSelect
+	{
+		sq := $3.(*ast.SubqueryExpr)
+		sq.MultiRows = true
+		$$ = &ast.PatternInExpr{Expr: $1, Not: !$2.(bool), Sel: sq}
+	}
+|	BitExpr BetweenOrNotOp BitExpr "AND" PredicateExpr
+	{
+		$$ = &ast.BetweenExpr{
+			Expr:  $1,
+			Left:  $3,
+			Right: $5,
+			Not:   !$2.(bool),
+		}
+	}
+|	BitExpr LikeOrNotOp SimpleExpr LikeEscapeOpt
+	{
+		escape := $4
+		if len(escape) > 1 {
+			yylex.AppendError(ErrWrongArguments.GenWithStackByArgs("ESCAPE"))
+			return 1
+		} else if len(escape) == 0 {
+			escape = "\\"
+		}
+		$$ = &ast.PatternLikeExpr{
+			Expr:    $1,
+			Pattern: $3,
+			Not:     !$2.(bool),
+			Escape:  escape[0],
+		}
+	}
+|	BitExpr RegexpOrNotOp SimpleExpr
+	{
+		$$ = &ast.PatternRegexpExpr{Expr: $1, Pattern: $3, Not: !$2.(bool)}
+	}
+|	BitExpr "MEMBER" "OF" '(' SimpleExpr ')'
+	{
+		$$ = &ast.MemberOfExpr{Expr: $1, Array: $5}
+	}
+|	BitExpr %prec lowerThanEq
+
+RegexpSym:
+	"REGEXP"
+|	"RLIKE"
+
+LikeEscapeOpt:
+	%prec empty
+	{
+		$$ = "\\"
+	}
+|	"ESCAPE" stringLit
+	{
+		$$ = $2
+	}
+
+Field:
+	'*' %prec '*'
+	{
+		$$ = &ast.SelectField{WildCard: &ast.WildCardField{}}
+	}
+|	Identifier '.' '*' %prec '*'
+	{
+		wildCard := &ast.WildCardField{Table: model.NewCIStr($1)}
+		$$ = &ast.SelectField{WildCard: wildCard}
+	}
+|	Identifier '.' Identifier '.' '*' %prec '*'
+	{
+		wildCard := &ast.WildCardField{Schema: model.NewCIStr($1), Table: model.NewCIStr($3)}
+		$$ = &ast.SelectField{WildCard: wildCard}
+	}
+|	Expression FieldAsNameOpt
+	{
+		expr := $1
+		asName := $2
+		$$ = &ast.SelectField{Expr: expr, AsName: model.NewCIStr(asName)}
+	}
+
+FieldAsNameOpt:
+	/* EMPTY */
+	{
+		$$ = ""
+	}
+|	FieldAsName
+
+FieldAsName:
+	Identifier
+|	"AS" Identifier
+	{
+		$$ = $2
+	}
+|	stringLit
+|	"AS" stringLit
+	{
+		$$ = $2
+	}
+
+FieldList:
+	Field
+	{
+		field := $1.(*ast.SelectField)
+		field.Offset = parser.startOffset(&yyS[yypt])
+		$$ = []*ast.SelectField{field}
+	}
+|	FieldList ',' Field
+	{
+		fl := $1.([]*ast.SelectField)
+		last := fl[len(fl)-1]
+		if last.Expr != nil && last.AsName.O == "" {
+			lastEnd := parser.endOffset(&yyS[yypt-1])
+			last.SetText(parser.lexer.client, parser.src[last.Offset:lastEnd])
+		}
+		newField := $3.(*ast.SelectField)
+		newField.Offset = parser.startOffset(&yyS[yypt])
+		$$ = append(fl, newField)
+	}
+
+GroupByClause:
+	"GROUP" "BY" ByList
+	{
+		$$ = &ast.GroupByClause{Items: $3.([]*ast.ByItem)}
+	}
+
+HavingClause:
+	{
+		$$ = nil
+	}
+|	"HAVING" Expression
+	{
+		$$ = &ast.HavingClause{Expr: $2}
+	}
+
+AsOfClauseOpt:
+	%prec empty
+	{
+		$$ = nil
+	}
+|	AsOfClause
+
+AsOfClause:
+	asof "TIMESTAMP" Expression
+	{
+		$$ = &ast.AsOfClause{
+			TsExpr: $3.(ast.ExprNode),
+		}
+	}
+
+IfExists:
+	{
+		$$ = false
+	}
+|	"IF" "EXISTS"
+	{
+		$$ = true
+	}
+
+IfNotExists:
+	{
+		$$ = false
+	}
+|	"IF" NotSym "EXISTS"
+	{
+		$$ = true
+	}
+
+IgnoreOptional:
+	{
+		$$ = false
+	}
+|	"IGNORE"
+	{
+		$$ = true
+	}
+
+IndexName:
+	{
+		$$ = &ast.NullString{
+			String: "",
+			Empty:  false,
+		}
+	}
+|	Identifier
+	{
+		$$ = &ast.NullString{
+			String: $1,
+			Empty:  len($1) == 0,
+		}
+	}
+
+IndexOptionList:
+	{
+		$$ = nil
+	}
+|	IndexOptionList IndexOption
+	{
+		// Merge the options
+		if $1 == nil {
+			$$ = $2
+		} else {
+			opt1 := $1.(*ast.IndexOption)
+			opt2 := $2.(*ast.IndexOption)
+			if len(opt2.Comment) > 0 {
+				opt1.Comment = opt2.Comment
+			} else if opt2.Tp != 0 {
+				opt1.Tp = opt2.Tp
+			} else if opt2.KeyBlockSize > 0 {
+				opt1.KeyBlockSize = opt2.KeyBlockSize
+			} else if len(opt2.ParserName.O) > 0 {
+				opt1.ParserName = opt2.ParserName
+			} else if opt2.Visibility != ast.IndexVisibilityDefault {
+				opt1.Visibility = opt2.Visibility
+			} else if opt2.PrimaryKeyTp != model.PrimaryKeyTypeDefault {
+				opt1.PrimaryKeyTp = opt2.PrimaryKeyTp
+			}
+			$$ = opt1
+		}
+	}
+
+IndexOption:
+	"KEY_BLOCK_SIZE" EqOpt LengthNum
+	{
+		$$ = &ast.IndexOption{
+			KeyBlockSize: $3.(uint64),
+		}
+	}
+|	IndexType
+	{
+		$$ = &ast.IndexOption{
+			Tp: $1.(model.IndexType),
+		}
+	}
+|	"WITH" "PARSER" Identifier
+	{
+		$$ = &ast.IndexOption{
+			ParserName: model.NewCIStr($3),
+		}
+		yylex.AppendError(yylex.Errorf("The WITH PARASER clause is parsed but ignored by all storage engines."))
+		parser.lastErrorAsWarn()
+	}
+|	"COMMENT" stringLit
+	{
+		$$ = &ast.IndexOption{
+			Comment: $2,
+		}
+	}
+|	IndexInvisible
+	{
+		$$ = &ast.IndexOption{
+			Visibility: $1.(ast.IndexVisibility),
+		}
+	}
+|	WithClustered
+	{
+		$$ = &ast.IndexOption{
+			PrimaryKeyTp: $1.(model.PrimaryKeyType),
+		}
+	}
+
+/*
+  See: https://github.com/mysql/mysql-server/blob/8.0/sql/sql_yacc.yy#L7179
+
+  The syntax for defining an index is:
+
+    ... INDEX [index_name] [USING|TYPE] <index_type> ...
+
+  The problem is that whereas USING is a reserved word, TYPE is not. We can
+  still handle it if an index name is supplied, i.e.:
+
+    ... INDEX type TYPE <index_type> ...
+
+  here the index's name is unmbiguously 'type', but for this:
+
+    ... INDEX TYPE <index_type> ...
+
+  it's impossible to know what this actually mean - is 'type' the name or the
+  type? For this reason we accept the TYPE syntax only if a name is supplied.
+*/
+IndexNameAndTypeOpt:
+	IndexName
+	{
+		$$ = []interface{}{$1, nil}
+	}
+|	IndexName "USING" IndexTypeName
+	{
+		$$ = []interface{}{$1, $3}
+	}
+|	Identifier "TYPE" IndexTypeName
+	{
+		$$ = []interface{}{&ast.NullString{String: $1, Empty: len($1) == 0}, $3}
+	}
+
+IndexTypeOpt:
+	{
+		$$ = nil
+	}
+|	IndexType
+
+IndexType:
+	"USING" IndexTypeName
+	{
+		$$ = $2
+	}
+|	"TYPE" IndexTypeName
+	{
+		$$ = $2
+	}
+
+IndexTypeName:
+	"BTREE"
+	{
+		$$ = model.IndexTypeBtree
+	}
+|	"HASH"
+	{
+		$$ = model.IndexTypeHash
+	}
+|	"RTREE"
+	{
+		$$ = model.IndexTypeRtree
+	}
+
+IndexInvisible:
+	"VISIBLE"
+	{
+		$$ = ast.IndexVisibilityVisible
+	}
+|	"INVISIBLE"
+	{
+		$$ = ast.IndexVisibilityInvisible
+	}
+
+/**********************************Identifier********************************************/
+Identifier:
+	identifier
+|	UnReservedKeyword
+|	NotKeywordToken
+|	TiDBKeyword
+
+UnReservedKeyword:
+	"ACTION"
+|	"ADVISE"
+|	"ASCII"
+|	"ATTRIBUTES"
+|	"STATS_OPTIONS"
+|	"STATS_SAMPLE_RATE"
+|	"STATS_COL_CHOICE"
+|	"STATS_COL_LIST"
+|	"AUTO_ID_CACHE"
+|	"AUTO_INCREMENT"
+|	"AFTER"
+|	"ALWAYS"
+|	"AVG"
+|	"BEGIN"
+|	"BIT"
+|	"BOOL"
+|	"BOOLEAN"
+|	"BTREE"
+|	"BYTE"
+|	"CAPTURE"
+|	"CAUSAL"
+|	"CLEANUP"
+|	"CHAIN"
+|	"CHARSET"
+|	"COLUMNS"
+|	"CONFIG"
+|	"SAN"
+|	"COMMIT"
+|	"COMPACT"
+|	"COMPRESSED"
+|	"CONSISTENCY"
+|	"CONSISTENT"
+|	"CURRENT"
+|	"DATA"
+|	"DATE" %prec lowerThanStringLitToken
+|	"DATETIME"
+|	"DAY"
+|	"DEALLOCATE"
+|	"DO"
+|	"DUPLICATE"
+|	"DYNAMIC"
+|	"ENCRYPTION"
+|	"END"
+|	"ENFORCED"
+|	"ENGINE"
+|	"ENGINES"
+|	"ENUM"
+|	"ERROR"
+|	"ERRORS"
+|	"ESCAPE"
+|	"EVOLVE"
+|	"EXECUTE"
+|	"EXTENDED"
+|	"FIELDS"
+|	"FILE"
+|	"FIRST"
+|	"FIXED"
+|	"FLUSH"
+|	"FOLLOWING"
+|	"FORMAT"
+|	"FULL"
+|	"GENERAL"
+|	"GLOBAL"
+|	"HASH"
+|	"HELP"
+|	"HOUR"
+|	"INSERT_METHOD"
+|	"LESS"
+|	"LOCAL"
+|	"LAST"
+|	"NAMES"
+|	"NVARCHAR"
+|	"OFFSET"
+|	"PACK_KEYS"
+|	"PARSER"
+|	"PASSWORD" %prec lowerThanEq
+|	"PREPARE"
+|	"PRE_SPLIT_REGIONS"
+|	"PROXY"
+|	"QUICK"
+|	"REBUILD"
+|	"REDUNDANT"
+|	"REORGANIZE"
+|	"RESTART"
+|	"ROLE"
+|	"ROLLBACK"
+|	"SESSION"
+|	"SIGNED"
+|	"SHARD_ROW_ID_BITS"
+|	"SHUTDOWN"
+|	"SNAPSHOT"
+|	"START"
+|	"STATUS"
+|	"OPEN"
+|	"SUBPARTITIONS"
+|	"SUBPARTITION"
+|	"DBPARTITIONS"
+|	"TBPARTITIONS"
+|	"TBPARTITION"
+|	"TABLES"
+|	"TABLESPACE"
+|	"TEXT"
+|	"THAN"
+|	"TIME" %prec lowerThanStringLitToken
+|	"TIMESTAMP" %prec lowerThanStringLitToken
+|	"TRACE"
+|	"TRANSACTION"
+|	"TRUNCATE"
+|	"UNBOUNDED"
+|	"UNKNOWN"
+|	"VALUE" %prec lowerThanValueKeyword
+|	"WARNINGS"
+|	"YEAR"
+|	"MODE"
+|	"WEEK"
+|	"WEIGHT_STRING"
+|	"ANY"
+|	"SOME"
+|	"USER"
+|	"IDENTIFIED"
+|	"COLLATION"
+|	"COMMENT"
+|	"AVG_ROW_LENGTH"
+|	"CONNECTION"
+|	"CHECKSUM"
+|	"COMPRESSION"
+|	"KEY_BLOCK_SIZE"
+|	"MASTER"
+|	"MAX_ROWS"
+|	"MIN_ROWS"
+|	"NATIONAL"
+|	"NCHAR"
+|	"ROW_FORMAT"
+|	"QUARTER"
+|	"GRANTS"
+|	"TRIGGERS"
+|	"DELAY_KEY_WRITE"
+|	"ISOLATION"
+|	"JSON"
+|	"REPEATABLE"
+|	"RESPECT"
+|	"COMMITTED"
+|	"UNCOMMITTED"
+|	"ONLY"
+|	"SERIAL"
+|	"SERIALIZABLE"
+|	"LEVEL"
+|	"VARIABLES"
+|	"SQL_CACHE"
+|	"INDEXES"
+|	"PROCESSLIST"
+|	"SQL_NO_CACHE"
+|	"DISABLE"
+|	"ENABLE"
+|	"REVERSE"
+|	"PRIVILEGES"
+|	"NO"
+|	"BINLOG"
+|	"FUNCTION"
+|	"VIEW"
+|	"BINDING"
+|	"BINDINGS"
+|	"MODIFY"
+|	"EVENTS"
+|	"PARTITIONS"
+|	"NONE"
+|	"NULLS"
+|	"SUPER"
+|	"EXCLUSIVE"
+|	"STATS_PERSISTENT"
+|	"STATS_AUTO_RECALC"
+|	"ROW_COUNT"
+|	"COALESCE"
+|	"MONTH"
+|	"PROCESS"
+|	"PROFILE"
+|	"PROFILES"
+|	"MICROSECOND"
+|	"MINUTE"
+|	"PLUGINS"
+|	"PRECEDING"
+|	"QUERY"
+|	"QUERIES"
+|	"SECOND"
+|	"SEPARATOR"
+|	"SHARE"
+|	"SHARED"
+|	"SLOW"
+|	"MAX_CONNECTIONS_PER_HOUR"
+|	"MAX_QUERIES_PER_HOUR"
+|	"MAX_UPDATES_PER_HOUR"
+|	"MAX_USER_CONNECTIONS"
+|	"REPLICATION"
+|	"CLIENT"
+|	"SLAVE"
+|	"RELOAD"
+|	"TEMPORARY"
+|	"ROUTINE"
+|	"EVENT"
+|	"ALGORITHM"
+|	"DEFINER"
+|	"INVOKER"
+|	"MERGE"
+|	"TEMPTABLE"
+|	"UNDEFINED"
+|	"SECURITY"
+|	"CASCADED"
+|	"RECOVER"
+|	"CIPHER"
+|	"SUBJECT"
+|	"ISSUER"
+|	"X509"
+|	"NEVER"
+|	"EXPIRE"
+|	"ACCOUNT"
+|	"INCREMENTAL"
+|	"CPU"
+|	"MEMORY"
+|	"BLOCK"
+|	"IO"
+|	"CONTEXT"
+|	"SWITCHES"
+|	"PAGE"
+|	"FAULTS"
+|	"IPC"
+|	"SWAPS"
+|	"SOURCE"
+|	"TRADITIONAL"
+|	"SQL_BUFFER_RESULT"
+|	"DIRECTORY"
+|	"HISTOGRAM"
+|	"HISTORY"
+|	"LIST"
+|	"NODEGROUP"
+|	"SYSTEM_TIME"
+|	"PARTIAL"
+|	"SIMPLE"
+|	"REMOVE"
+|	"PARTITIONING"
+|	"STORAGE"
+|	"DISK"
+|	"STATS_SAMPLE_PAGES"
+|	"SECONDARY_ENGINE"
+|	"SECONDARY_LOAD"
+|	"SECONDARY_UNLOAD"
+|	"VALIDATION"
+|	"WITHOUT"
+|	"RTREE"
+|	"EXCHANGE"
+|	"COLUMN_FORMAT"
+|	"REPAIR"
+|	"IMPORT"
+|	"IMPORTS"
+|	"DISCARD"
+|	"TABLE_CHECKSUM"
+|	"UNICODE"
+|	"AUTO_RANDOM"
+|	"AUTO_RANDOM_BASE"
+|	"SQL_TSI_DAY"
+|	"SQL_TSI_HOUR"
+|	"SQL_TSI_MINUTE"
+|	"SQL_TSI_MONTH"
+|	"SQL_TSI_QUARTER"
+|	"SQL_TSI_SECOND"
+|	"LANGUAGE"
+|	"SQL_TSI_WEEK"
+|	"SQL_TSI_YEAR"
+|	"INVISIBLE"
+|	"VISIBLE"
+|	"TYPE"
+|	"NOWAIT"
+|	"INSTANCE"
+|	"REPLICA"
+|	"LOCATION"
+|	"LABELS"
+|	"LOGS"
+|	"HOSTS"
+|	"AGAINST"
+|	"EXPANSION"
+|	"INCREMENT"
+|	"MINVALUE"
+|	"NOMAXVALUE"
+|	"NOMINVALUE"
+|	"NOCACHE"
+|	"CACHE"
+|	"CYCLE"
+|	"NOCYCLE"
+|	"SEQUENCE"
+|	"TOPOLOGY"
+|	"USERS"
+|	"NODES"
+|	"MAX_MINUTES"
+|	"MAX_IDXNUM"
+|	"PER_TABLE"
+|	"PER_DB"
+|	"NEXT"
+|	"NEXTVAL"
+|	"LASTVAL"
+|	"SETVAL"
+|	"AGO"
+|	"BACKUP"
+|	"BACKUPS"
+|	"CONCURRENCY"
+|	"MB"
+|	"ONLINE"
+|	"RATE_LIMIT"
+|	"RESTORE"
+|	"RESTORES"
+|	"RULES"
+|	"SEND_CREDENTIALS_TO_TIKV"
+|	"LAST_BACKUP"
+|	"CHECKPOINT"
+|	"SKIP_SCHEMA_FILES"
+|	"STRICT_FORMAT"
+|	"BACKEND"
+|	"CSV_BACKSLASH_ESCAPE"
+|	"CSV_NOT_NULL"
+|	"CSV_TRIM_LAST_SEPARATORS"
+|	"CSV_DELIMITER"
+|	"CSV_HEADER"
+|	"CSV_NULL"
+|	"CSV_SEPARATOR"
+|	"ON_DUPLICATE"
+|	"TIKV_IMPORTER"
+|	"REPLICAS"
+|	"POLICY"
+|	"WAIT"
+|	"CLIENT_ERRORS_SUMMARY"
+|	"BERNOULLI"
+|	"SYSTEM"
+|	"PERCENT"
+|	"RESUME"
+|	"OFF"
+|	"OPTIONAL"
+|	"REQUIRED"
+|	"PURGE"
+|	"SKIP"
+|	"LOCKED"
+|	"CLUSTERED"
+|	"NONCLUSTERED"
+|	"TABLE_RULES"
+|	"SHARDING"
+|	"PRESERVE"
+|	"FOLLOWS"
+|	"PRECEDES"
+|	"CLOSE"
+|	"CONTAINS"
+|	"FOUND"
+|	"HANDLER"
+|	"RETURNS"
+|	"UNTIL"
+|	"CATALOG_NAME"
+|	"CLASS_ORIGIN"
+|	"COLUMN_NAME"
+|	"CONSTRAINT_CATALOG"
+|	"CONSTRAINT_NAME"
+|	"CONSTRAINT_SCHEMA"
+|	"CURSOR_NAME"
+|	"DIAGNOSTICS"
+|	"MESSAGE_TEXT"
+|	"MYSQL_ERRNO"
+|	"NUMBER"
+|	"RETURNED_SQLSTATE"
+|	"SCHEMA_NAME"
+|	"STACKED"
+|	"SUBCLASS_ORIGIN"
+|	"TABLE_NAME"
+|	"SAVEPOINT"
+|	"XA"
+|	"SUSPEND"
+|	"MIGRATE"
+|	"ONE"
+|	"PHASE"
+|	"XID"
+|	"ORDINALITY"
+|	"NESTED"
+|	"PATH"
+|	"EMPTY"
+|	"AT"
+|	"EVERY"
+|	"STARTS"
+|	"ENDS"
+|	"COMPLETION"
+|	"GEOMETRY"
+|	"POINT"
+|	"LINESTRING"
+|	"POLYGON"
+|	"MULTIPOINT"
+|	"MULTILINESTRING"
+|	"MULTIPOLYGON"
+|	"GEOMETRYCOLLECTION"
+|	"GEOMCOLLECTION"
+|	"SRID"
+|	"MEMBER"
+|	"ARRAY"
+|	"SOURCE_BIND"
+|	"MASTER_BIND"
+|	"SOURCE_HOST"
+|	"MASTER_HOST"
+|	"SOURCE_USER"
+|	"MASTER_USER"
+|	"SOURCE_PASSWORD"
+|	"MASTER_PASSWORD"
+|	"SOURCE_PORT"
+|	"MASTER_PORT"
+|	"SOURCE_LOG_FILE"
+|	"MASTER_LOG_FILE"
+|	"SOURCE_LOG_POS"
+|	"MASTER_LOG_POS"
+|	"SOURCE_AUTO_POSITION"
+|	"MASTER_AUTO_POSITION"
+|	"SOURCE_HEARTBEAT_PERIOD"
+|	"MASTER_HEARTBEAT_PERIOD"
+|	"SOURCE_CONNECT_RETRY"
+|	"MASTER_CONNECT_RETRY"
+|	"SOURCE_RETRY_COUNT"
+|	"MASTER_RETRY_COUNT"
+|	"SOURCE_DELAY"
+|	"MASTER_DELAY"
+|	"SOURCE_COMPRESSION_ALGORITHMS"
+|	"MASTER_COMPRESSION_ALGORITHMS"
+|	"SOURCE_ZSTD_COMPRESSION_LEVEL"
+|	"MASTER_ZSTD_COMPRESSION_LEVEL"
+|	"SOURCE_SSL"
+|	"MASTER_SSL"
+|	"SOURCE_SSL_CA"
+|	"MASTER_SSL_CA"
+|	"SOURCE_SSL_CAPATH"
+|	"MASTER_SSL_CAPATH"
+|	"SOURCE_SSL_CERT"
+|	"MASTER_SSL_CERT"
+|	"SOURCE_SSL_CRL"
+|	"MASTER_SSL_CRL"
+|	"SOURCE_SSL_CRLPATH"
+|	"MASTER_SSL_CRLPATH"
+|	"SOURCE_SSL_KEY"
+|	"MASTER_SSL_KEY"
+|	"SOURCE_SSL_CIPHER"
+|	"MASTER_SSL_CIPHER"
+|	"SOURCE_SSL_VERIFY_SERVER_CERT"
+|	"MASTER_SSL_VERIFY_SERVER_CERT"
+|	"SOURCE_TLS_VERSION"
+|	"MASTER_TLS_VERSION"
+|	"SOURCE_TLS_CIPHERSUITES"
+|	"MASTER_TLS_CIPHERSUITES"
+|	"SOURCE_PUBLIC_KEY_PATH"
+|	"MASTER_PUBLIC_KEY_PATH"
+|	"GET_SOURCE_PUBLIC_KEY"
+|	"GET_MASTER_PUBLIC_KEY"
+|	"SOURCE_CONNECTION_AUTO_FAILOVER"
+|	"CHANNEL"
+|	"IO_THREAD"
+|	"SQL_THREAD"
+|	"RELAY_THREAD"
+|	"SQL_BEFORE_GTIDS"
+|	"SQL_AFTER_GTIDS"
+|	"SQL_AFTER_MTS_GAPS"
+|	"DEFAULT_AUTH"
+|	"PLUGIN_DIR"
+|	"RELAY_LOG_FILE"
+|	"RELAY_LOG_POS"
+|	"PRIVILEGE_CHECKS_USER"
+|	"REQUIRE_ROW_FORMAT"
+|	"REQUIRE_TABLE_PRIMARY_KEY_CHECK"
+|	"ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS"
+|	"NETWORK_NAMESPACE"
+|	"IGNORE_SERVER_IDS"
+|	"GTID_ONLY"
+|	"PERSIST"
+|	"PERSIST_ONLY"
+|	"RULE"
+|	"SHARDING_COLUMNS"
+|	"DATABASE_ALGORITHM"
+|	"TABLE_ALGORITHM"
+|	"KEY_GENERATOR"
+|	"PROPERTIES"
+|	"TENANT"
+|	"NODE"
+|	"HOST"
+|	"PORT"
+|	"USERNAME"
+|	"WEIGHT"
+|	"DB"
+|	"LOAD_BALANCE"
+|	"TRANSACTION_ROUTING"
+|	"SHADOW"
+|	"SHADOW_GROUP"
+|	"HINT"
+|	"PREVIEW"
+|	"SEQUENCES"
+|	"SNOWFLAKE"
+|	"SEGMENT"
+|	"STEP"
+|	"WORKER_ID"
+|	"AGGREGATE"
+|	"SONAME"
+|	"STRING"
+|	"WORK"
+|	"RELAYLOG"
+
+TiDBKeyword:
+	"ADMIN"
+|	"BUCKETS"
+|	"BUILTINS"
+|	"CANCEL"
 |	"CARDINALITY"
 |	"CMSKETCH"
 |	"COLUMN_STATS_USAGE"
@@ -6209,3449 +7868,5046 @@ TiDBKeyword:
 |	"REGION"
 |	"RESET"
 
-NotKeywordToken:
-	"ADDDATE"
-|	"APPROX_COUNT_DISTINCT"
-|	"APPROX_PERCENTILE"
-|	"BIT_AND"
-|	"BIT_OR"
-|	"BIT_XOR"
-|	"BRIEF"
-|	"CAST"
-|	"COPY"
-|	"CURTIME"
-|	"DATE_ADD"
-|	"DATE_SUB"
-|	"DOT"
-|	"DUMP"
-|	"EXTRACT"
-|	"GET_FORMAT"
-|	"GROUP_CONCAT"
-|	"INPLACE"
-|	"INSTANT"
-|	"INTERNAL"
-|	"MIN"
-|	"MAX"
-|	"NOW"
-|	"RECENT"
-|	"REPLAYER"
-|	"RUNNING"
-|	"PLACEMENT"
-|	"PLAN"
-|	"PLAN_CACHE"
-|	"POSITION"
-|	"PREDICATE"
-|	"S3"
-|	"STRICT"
-|	"SUBDATE"
-|	"SUBSTRING"
-|	"SUM"
-|	"STD"
-|	"STDDEV"
-|	"STDDEV_POP"
-|	"STDDEV_SAMP"
-|	"STOP"
-|	"VARIANCE"
-|	"VAR_POP"
-|	"VAR_SAMP"
-|	"TARGET"
-|	"TIMESTAMPADD"
-|	"TIMESTAMPDIFF"
-|	"TOKUDB_DEFAULT"
-|	"TOKUDB_FAST"
-|	"TOKUDB_LZMA"
-|	"TOKUDB_QUICKLZ"
-|	"TOKUDB_SNAPPY"
-|	"TOKUDB_SMALL"
-|	"TOKUDB_UNCOMPRESSED"
-|	"TOKUDB_ZLIB"
-|	"TOP"
-|	"TRIM"
-|	"NEXT_ROW_ID"
-|	"EXPR_PUSHDOWN_BLACKLIST"
-|	"OPT_RULE_BLACKLIST"
-|	"BOUND"
-|	"EXACT" %prec lowerThanStringLitToken
-|	"STALENESS"
-|	"STRONG"
-|	"FLASHBACK"
-|	"JSON_OBJECTAGG"
-|	"JSON_ARRAYAGG"
-|	"TLS"
-|	"FOLLOWER"
-|	"FOLLOWERS"
-|	"LEADER"
-|	"LEARNER"
-|	"LEARNERS"
-|	"VERBOSE"
-|	"VOTER"
-|	"VOTERS"
-|	"CONSTRAINTS"
-|	"PRIMARY_REGION"
-|	"SCHEDULE"
-|	"LEADER_CONSTRAINTS"
-|	"FOLLOWER_CONSTRAINTS"
-|	"LEARNER_CONSTRAINTS"
-|	"VOTER_CONSTRAINTS"
+NotKeywordToken:
+	"ADDDATE"
+|	"APPROX_COUNT_DISTINCT"
+|	"APPROX_PERCENTILE"
+|	"BIT_AND"
+|	"BIT_OR"
+|	"BIT_XOR"
+|	"BRIEF"
+|	"CAST"
+|	"COPY"
+|	"CURTIME"
+|	"DATE_ADD"
+|	"DATE_SUB"
+|	"DOT"
+|	"DUMP"
+|	"EXTRACT"
+|	"GET_FORMAT"
+|	"GROUP_CONCAT"
+|	"INPLACE"
+|	"INSTANT"
+|	"INTERNAL"
+|	"MIN"
+|	"MAX"
+|	"NOW"
+|	"RECENT"
+|	"REPLAYER"
+|	"RUNNING"
+|	"PLACEMENT"
+|	"PLAN"
+|	"PLAN_CACHE"
+|	"POSITION"
+|	"PREDICATE"
+|	"S3"
+|	"STRICT"
+|	"SUBDATE"
+|	"SUBSTRING"
+|	"SUM"
+|	"STD"
+|	"STDDEV"
+|	"STDDEV_POP"
+|	"STDDEV_SAMP"
+|	"STOP"
+|	"VARIANCE"
+|	"VAR_POP"
+|	"VAR_SAMP"
+|	"TARGET"
+|	"TIMESTAMPADD"
+|	"TIMESTAMPDIFF"
+|	"TOKUDB_DEFAULT"
+|	"TOKUDB_FAST"
+|	"TOKUDB_LZMA"
+|	"TOKUDB_QUICKLZ"
+|	"TOKUDB_SNAPPY"
+|	"TOKUDB_SMALL"
+|	"TOKUDB_UNCOMPRESSED"
+|	"TOKUDB_ZLIB"
+|	"TOP"
+|	"TRIM"
+|	"NEXT_ROW_ID"
+|	"EXPR_PUSHDOWN_BLACKLIST"
+|	"OPT_RULE_BLACKLIST"
+|	"BOUND"
+|	"EXACT" %prec lowerThanStringLitToken
+|	"STALENESS"
+|	"STRONG"
+|	"FLASHBACK"
+|	"JSON_OBJECTAGG"
+|	"JSON_ARRAYAGG"
+|	"TLS"
+|	"FOLLOWER"
+|	"FOLLOWERS"
+|	"LEADER"
+|	"LEARNER"
+|	"LEARNERS"
+|	"VERBOSE"
+|	"VOTER"
+|	"VOTERS"
+|	"CONSTRAINTS"
+|	"PRIMARY_REGION"
+|	"SCHEDULE"
+|	"LEADER_CONSTRAINTS"
+|	"FOLLOWER_CONSTRAINTS"
+|	"LEARNER_CONSTRAINTS"
+|	"VOTER_CONSTRAINTS"
+
+/************************************************************************************
+ *
+ *  Call Statements
+ *
+ **********************************************************************************/
+CallStmt:
+	"CALL" ProcedureCall
+	{
+		$$ = &ast.CallStmt{
+			Procedure: $2.(*ast.FuncCallExpr),
+		}
+	}
+
+ProcedureCall:
+	identifier
+	{
+		$$ = &ast.FuncCallExpr{
+			Tp:     ast.FuncCallExprTypeGeneric,
+			FnName: model.NewCIStr($1),
+			Args:   []ast.ExprNode{},
+		}
+	}
+|	Identifier '.' Identifier
+	{
+		$$ = &ast.FuncCallExpr{
+			Tp:     ast.FuncCallExprTypeGeneric,
+			Schema: model.NewCIStr($1),
+			FnName: model.NewCIStr($3),
+			Args:   []ast.ExprNode{},
+		}
+	}
+|	identifier '(' ExpressionListOpt ')'
+	{
+		$$ = &ast.FuncCallExpr{
+			Tp:     ast.FuncCallExprTypeGeneric,
+			FnName: model.NewCIStr($1),
+			Args:   $3.([]ast.ExprNode),
+		}
+	}
+|	Identifier '.' Identifier '(' ExpressionListOpt ')'
+	{
+		$$ = &ast.FuncCallExpr{
+			Tp:     ast.FuncCallExprTypeGeneric,
+			Schema: model.NewCIStr($1),
+			FnName: model.NewCIStr($3),
+			Args:   $5.([]ast.ExprNode),
+		}
+	}
+
+/************************************************************************************
+ *
+ *  Insert Statements
+ *
+ **********************************************************************************/
+InsertIntoStmt:
+	"INSERT" TableOptimizerHintsOpt PriorityOpt IgnoreOptional IntoOpt TableName PartitionNameListOpt InsertValues OnDuplicateKeyUpdate
+	{
+		x := $8.(*ast.InsertStmt)
+		x.Priority = $3.(mysql.PriorityEnum)
+		x.IgnoreErr = $4.(bool)
+		// Wraps many layers here so that it can be processed the same way as select statement.
+		ts := &ast.TableSource{Source: $6.(*ast.TableName)}
+		x.Table = &ast.TableRefsClause{TableRefs: &ast.Join{Left: ts}}
+		if $9 != nil {
+			x.OnDuplicate = $9.([]*ast.Assignment)
+			markRowAliasColumns(x)
+		}
+		if $2 != nil {
+			x.TableHints = $2.([]*ast.TableOptimizerHint)
+		}
+		x.PartitionNames = $7.([]model.CIStr)
+		$$ = x
+	}
+
+IntoOpt:
+	{}
+|	"INTO"
+
+InsertValues:
+	'(' ColumnNameListOpt ')' ValueSym ValuesList InsertRowAliasOpt
+	{
+		x := &ast.InsertStmt{
+			Columns: $2.([]*ast.ColumnName),
+			Lists:   $5.([][]ast.ExprNode),
+		}
+		if $6 != nil {
+			alias := $6.(*ast.InsertStmt)
+			x.RowAlias, x.RowAliasColumns = alias.RowAlias, alias.RowAliasColumns
+		}
+		$$ = x
+	}
+|	'(' ColumnNameListOpt ')' "VALUES" ValuesStmtList InsertRowAlias
+	{
+		alias := $6.(*ast.InsertStmt)
+		$$ = &ast.InsertStmt{
+			Columns:         $2.([]*ast.ColumnName),
+			Select:          newValuesSelectStmt($5.([]*ast.RowExpr)),
+			RowAlias:        alias.RowAlias,
+			RowAliasColumns: alias.RowAliasColumns,
+		}
+	}
+|	'(' ColumnNameListOpt ')' SetOprStmt
+	{
+		$$ = &ast.InsertStmt{Columns: $2.([]*ast.ColumnName), Select: $4.(ast.ResultSetNode)}
+	}
+|	'(' ColumnNameListOpt ')' SelectStmt
+	{
+		$$ = &ast.InsertStmt{Columns: $2.([]*ast.ColumnName), Select: $4.(ast.ResultSetNode)}
+	}
+|	'(' ColumnNameListOpt ')' SelectStmtWithClause
+	{
+		$$ = &ast.InsertStmt{Columns: $2.([]*ast.ColumnName), Select: $4.(ast.ResultSetNode)}
+	}
+|	'(' ColumnNameListOpt ')' SubSelect
+	{
+		var sel ast.ResultSetNode
+		switch x := $4.(*ast.SubqueryExpr).Query.(type) {
+		case *ast.SelectStmt:
+			x.IsInBraces = true
+			sel = x
+		case *ast.SetOprStmt:
+			x.IsInBraces = true
+			sel = x
+		}
+		$$ = &ast.InsertStmt{Columns: $2.([]*ast.ColumnName), Select: sel}
+	}
+|	ValueSym ValuesList InsertRowAliasOpt
+	{
+		x := &ast.InsertStmt{Lists: $2.([][]ast.ExprNode)}
+		if $3 != nil {
+			alias := $3.(*ast.InsertStmt)
+			x.RowAlias, x.RowAliasColumns = alias.RowAlias, alias.RowAliasColumns
+		}
+		$$ = x
+	}
+|	"VALUES" ValuesStmtList InsertRowAlias
+	{
+		alias := $3.(*ast.InsertStmt)
+		$$ = &ast.InsertStmt{
+			Select:          newValuesSelectStmt($2.([]*ast.RowExpr)),
+			RowAlias:        alias.RowAlias,
+			RowAliasColumns: alias.RowAliasColumns,
+		}
+	}
+|	SetOprStmt
+	{
+		$$ = &ast.InsertStmt{Select: $1.(ast.ResultSetNode)}
+	}
+|	SelectStmt
+	{
+		$$ = &ast.InsertStmt{Select: $1.(ast.ResultSetNode)}
+	}
+|	SelectStmtWithClause
+	{
+		$$ = &ast.InsertStmt{Select: $1.(ast.ResultSetNode)}
+	}
+|	SubSelect
+	{
+		var sel ast.ResultSetNode
+		switch x := $1.(*ast.SubqueryExpr).Query.(type) {
+		case *ast.SelectStmt:
+			x.IsInBraces = true
+			sel = x
+		case *ast.SetOprStmt:
+			x.IsInBraces = true
+			sel = x
+		}
+		$$ = &ast.InsertStmt{Select: sel}
+	}
+|	"SET" ColumnSetValueList InsertRowAliasOpt
+	{
+		x := &ast.InsertStmt{Setlist: $2.([]*ast.Assignment)}
+		if $3 != nil {
+			alias := $3.(*ast.InsertStmt)
+			x.RowAlias, x.RowAliasColumns = alias.RowAlias, alias.RowAliasColumns
+		}
+		$$ = x
+	}
+
+/*
+ * [AS row_alias[(col_alias [, col_alias] ...)]]
+ * See https://dev.mysql.com/doc/refman/8.0/en/insert-on-duplicate.html
+ */
+InsertRowAliasOpt:
+	%prec insertValues
+	{
+		$$ = nil
+	}
+|	InsertRowAlias
+
+InsertRowAlias:
+	"AS" Identifier
+	{
+		$$ = &ast.InsertStmt{RowAlias: model.NewCIStr($2)}
+	}
+|	"AS" Identifier '(' IdentList ')'
+	{
+		$$ = &ast.InsertStmt{RowAlias: model.NewCIStr($2), RowAliasColumns: $4.([]model.CIStr)}
+	}
+
+ValueSym:
+	"VALUE"
+|	"VALUES"
+
+ValuesList:
+	RowValue
+	{
+		$$ = [][]ast.ExprNode{$1.([]ast.ExprNode)}
+	}
+|	ValuesList ',' RowValue
+	{
+		$$ = append($1.([][]ast.ExprNode), $3.([]ast.ExprNode))
+	}
+
+RowValue:
+	'(' ValuesOpt ')'
+	{
+		$$ = $2
+	}
+
+ValuesOpt:
+	{
+		$$ = []ast.ExprNode{}
+	}
+|	Values
+
+Values:
+	Values ',' ExprOrDefault
+	{
+		$$ = append($1.([]ast.ExprNode), $3)
+	}
+|	ExprOrDefault
+	{
+		$$ = []ast.ExprNode{$1}
+	}
+
+ExprOrDefault:
+	Expression
+|	"DEFAULT"
+	{
+		$$ = &ast.DefaultExpr{}
+	}
+
+ColumnSetValue:
+	ColumnName eq ExprOrDefault
+	{
+		$$ = &ast.Assignment{
+			Column: $1.(*ast.ColumnName),
+			Expr:   $3,
+		}
+	}
+
+ColumnSetValueList:
+	{
+		$$ = []*ast.Assignment{}
+	}
+|	ColumnSetValue
+	{
+		$$ = []*ast.Assignment{$1.(*ast.Assignment)}
+	}
+|	ColumnSetValueList ',' ColumnSetValue
+	{
+		$$ = append($1.([]*ast.Assignment), $3.(*ast.Assignment))
+	}
+
+/*
+ * ON DUPLICATE KEY UPDATE col_name=expr [, col_name=expr] ...
+ * See https://dev.mysql.com/doc/refman/5.7/en/insert-on-duplicate.html
+ */
+OnDuplicateKeyUpdate:
+	{
+		$$ = nil
+	}
+|	"ON" "DUPLICATE" "KEY" "UPDATE" AssignmentList
+	{
+		$$ = $5
+	}
+
+/************************************************************************************
+ *  Replace Statements
+ *  See https://dev.mysql.com/doc/refman/5.7/en/replace.html
+ *
+ **********************************************************************************/
+ReplaceIntoStmt:
+	"REPLACE" PriorityOpt IntoOpt TableName PartitionNameListOpt InsertValues
+	{
+		x := $6.(*ast.InsertStmt)
+		if x.RowAlias.L != "" {
+			yylex.AppendError(yylex.Errorf("Row alias is not supported by REPLACE statement"))
+			return 1
+		}
+		x.IsReplace = true
+		x.Priority = $2.(mysql.PriorityEnum)
+		ts := &ast.TableSource{Source: $4.(*ast.TableName)}
+		x.Table = &ast.TableRefsClause{TableRefs: &ast.Join{Left: ts}}
+		x.PartitionNames = $5.([]model.CIStr)
+		$$ = x
+	}
+
+Literal:
+	"FALSE"
+	{
+		$$ = ast.NewValueExpr(false, parser.charset, parser.collation)
+	}
+|	"NULL"
+	{
+		$$ = ast.NewValueExpr(nil, parser.charset, parser.collation)
+	}
+|	"TRUE"
+	{
+		$$ = ast.NewValueExpr(true, parser.charset, parser.collation)
+	}
+|	floatLit
+	{
+		$$ = ast.NewValueExpr($1, parser.charset, parser.collation)
+	}
+|	decLit
+	{
+		$$ = ast.NewValueExpr($1, parser.charset, parser.collation)
+	}
+|	intLit
+	{
+		$$ = ast.NewValueExpr($1, parser.charset, parser.collation)
+	}
+|	StringLiteral %prec lowerThanStringLitToken
+|	"UNDERSCORE_CHARSET" stringLit
+	{
+		// See https://dev.mysql.com/doc/refman/5.7/en/charset-literal.html
+		co, err := charset.GetDefaultCollationLegacy($1)
+		if err != nil {
+			yylex.AppendError(ast.ErrUnknownCharacterSet.GenWithStack("Unsupported character introducer: '%-.64s'", $1))
+			return 1
+		}
+		expr := ast.NewValueExpr($2, $1, co)
+		tp := expr.GetType()
+		tp.Charset = $1
+		tp.Collate = co
+		if tp.Collate == charset.CollationBin {
+			tp.Flag |= mysql.BinaryFlag
+		}
+		$$ = expr
+	}
+|	hexLit
+	{
+		$$ = ast.NewValueExpr($1, parser.charset, parser.collation)
+	}
+|	bitLit
+	{
+		$$ = ast.NewValueExpr($1, parser.charset, parser.collation)
+	}
+|	"UNDERSCORE_CHARSET" hexLit
+	{
+		co, err := charset.GetDefaultCollationLegacy($1)
+		if err != nil {
+			yylex.AppendError(ast.ErrUnknownCharacterSet.GenWithStack("Unsupported character introducer: '%-.64s'", $1))
+			return 1
+		}
+		expr := ast.NewValueExpr($2, $1, co)
+		tp := expr.GetType()
+		tp.Charset = $1
+		tp.Collate = co
+		if tp.Collate == charset.CollationBin {
+			tp.Flag |= mysql.BinaryFlag
+		}
+		$$ = expr
+	}
+|	"UNDERSCORE_CHARSET" bitLit
+	{
+		co, err := charset.GetDefaultCollationLegacy($1)
+		if err != nil {
+			yylex.AppendError(ast.ErrUnknownCharacterSet.GenWithStack("Unsupported character introducer: '%-.64s'", $1))
+			return 1
+		}
+		expr := ast.NewValueExpr($2, $1, co)
+		tp := expr.GetType()
+		tp.Charset = $1
+		tp.Collate = co
+		if tp.Collate == charset.CollationBin {
+			tp.Flag |= mysql.BinaryFlag
+		}
+		$$ = expr
+	}
+
+StringLiteral:
+	stringLit
+	{
+		expr := ast.NewValueExpr($1, parser.charset, parser.collation)
+		$$ = expr
+	}
+|	StringLiteral stringLit
+	{
+		valExpr := $1.(ast.ValueExpr)
+		strLit := valExpr.GetString()
+		expr := ast.NewValueExpr(strLit+$2, parser.charset, parser.collation)
+		// Fix #4239, use first string literal as projection name.
+		if valExpr.GetProjectionOffset() >= 0 {
+			expr.SetProjectionOffset(valExpr.GetProjectionOffset())
+		} else {
+			expr.SetProjectionOffset(len(strLit))
+		}
+		$$ = expr
+	}
+
+AlterOrderList:
+	AlterOrderItem
+	{
+		$$ = []*ast.AlterOrderItem{$1.(*ast.AlterOrderItem)}
+	}
+|	AlterOrderList ',' AlterOrderItem
+	{
+		$$ = append($1.([]*ast.AlterOrderItem), $3.(*ast.AlterOrderItem))
+	}
+
+AlterOrderItem:
+	ColumnName OptOrder
+	{
+		$$ = &ast.AlterOrderItem{Column: $1.(*ast.ColumnName), Desc: $2.(bool)}
+	}
+
+OrderBy:
+	"ORDER" "BY" ByList
+	{
+		$$ = &ast.OrderByClause{Items: $3.([]*ast.ByItem)}
+	}
+
+ByList:
+	ByItem
+	{
+		$$ = []*ast.ByItem{$1.(*ast.ByItem)}
+	}
+|	ByList ',' ByItem
+	{
+		$$ = append($1.([]*ast.ByItem), $3.(*ast.ByItem))
+	}
+
+ByItem:
+	Expression
+	{
+		expr := $1
+		valueExpr, ok := expr.(ast.ValueExpr)
+		if ok {
+			position, isPosition := valueExpr.GetValue().(int64)
+			if isPosition {
+				expr = &ast.PositionExpr{N: int(position)}
+			}
+		}
+		$$ = &ast.ByItem{Expr: expr, NullOrder: true}
+	}
+|	Expression Order
+	{
+		expr := $1
+		valueExpr, ok := expr.(ast.ValueExpr)
+		if ok {
+			position, isPosition := valueExpr.GetValue().(int64)
+			if isPosition {
+				expr = &ast.PositionExpr{N: int(position)}
+			}
+		}
+		$$ = &ast.ByItem{Expr: expr, Desc: $2.(bool)}
+	}
+
+Order:
+	"ASC"
+	{
+		$$ = false
+	}
+|	"DESC"
+	{
+		$$ = true
+	}
+
+OptOrder:
+	/* EMPTY */
+	{
+		$$ = false // ASC by default
+	}
+|	"ASC"
+	{
+		$$ = false
+	}
+|	"DESC"
+	{
+		$$ = true
+	}
+
+OrderByOptional:
+	{
+		$$ = nil
+	}
+|	OrderBy
+
+BitExpr:
+	BitExpr '|' BitExpr %prec '|'
+	{
+		$$ = &ast.BinaryOperationExpr{Op: opcode.Or, L: $1, R: $3}
+	}
+|	BitExpr '&' BitExpr %prec '&'
+	{
+		$$ = &ast.BinaryOperationExpr{Op: opcode.And, L: $1, R: $3}
+	}
+|	BitExpr "<<" BitExpr %prec lsh
+	{
+		$$ = &ast.BinaryOperationExpr{Op: opcode.LeftShift, L: $1, R: $3}
+	}
+|	BitExpr ">>" BitExpr %prec rsh
+	{
+		$$ = &ast.BinaryOperationExpr{Op: opcode.RightShift, L: $1, R: $3}
+	}
+|	BitExpr '+' BitExpr %prec '+'
+	{
+		$$ = &ast.BinaryOperationExpr{Op: opcode.Plus, L: $1, R: $3}
+	}
+|	BitExpr '-' BitExpr %prec '-'
+	{
+		$$ = &ast.BinaryOperationExpr{Op: opcode.Minus, L: $1, R: $3}
+	}
+|	BitExpr '+' "INTERVAL" Expression TimeUnit %prec '+'
+	{
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr("DATE_ADD"),
+			Args: []ast.ExprNode{
+				$1,
+				$4,
+				&ast.TimeUnitExpr{Unit: $5.(ast.TimeUnitType)},
+			},
+		}
+	}
+|	BitExpr '-' "INTERVAL" Expression TimeUnit %prec '+'
+	{
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr("DATE_SUB"),
+			Args: []ast.ExprNode{
+				$1,
+				$4,
+				&ast.TimeUnitExpr{Unit: $5.(ast.TimeUnitType)},
+			},
+		}
+	}
+|	BitExpr '*' BitExpr %prec '*'
+	{
+		$$ = &ast.BinaryOperationExpr{Op: opcode.Mul, L: $1, R: $3}
+	}
+|	BitExpr '/' BitExpr %prec '/'
+	{
+		$$ = &ast.BinaryOperationExpr{Op: opcode.Div, L: $1, R: $3}
+	}
+|	BitExpr '%' BitExpr %prec '%'
+	{
+		$$ = &ast.BinaryOperationExpr{Op: opcode.Mod, L: $1, R: $3}
+	}
+|	BitExpr "DIV" BitExpr %prec div
+	{
+		$$ = &ast.BinaryOperationExpr{Op: opcode.IntDiv, L: $1, R: $3}
+	}
+|	BitExpr "MOD" BitExpr %prec mod
+	{
+		$$ = &ast.BinaryOperationExpr{Op: opcode.Mod, L: $1, R: $3}
+	}
+|	BitExpr '^' BitExpr
+	{
+		$$ = &ast.BinaryOperationExpr{Op: opcode.Xor, L: $1, R: $3}
+	}
+|	SimpleExpr
+
+SimpleIdent:
+	Identifier
+	{
+		parser.seqNextVal = false
+		$$ = &ast.ColumnNameExpr{Name: &ast.ColumnName{
+			Name: model.NewCIStr($1),
+		}}
+	}
+|	Identifier '.' Identifier
+	{
+		parser.seqNextVal = parser.isUnquotedNextVal($3, &yyS[yypt])
+		$$ = &ast.ColumnNameExpr{Name: &ast.ColumnName{
+			Table: model.NewCIStr($1),
+			Name:  model.NewCIStr($3),
+		}}
+	}
+|	Identifier '.' Identifier '.' Identifier
+	{
+		parser.seqNextVal = parser.isUnquotedNextVal($5, &yyS[yypt])
+		$$ = &ast.ColumnNameExpr{Name: &ast.ColumnName{
+			Schema: model.NewCIStr($1),
+			Table:  model.NewCIStr($3),
+			Name:   model.NewCIStr($5),
+		}}
+	}
+
+SimpleExpr:
+	SimpleIdent
+	{
+		// seq.NEXTVAL is the same as NEXTVAL(seq), a quoted `nextval` is still a column.
+		$$ = $1
+		if parser.seqNextVal {
+			parser.seqNextVal = false
+			col := $1.(*ast.ColumnNameExpr).Name
+			objNameExpr := &ast.TableNameExpr{
+				Name: &ast.TableName{Schema: col.Schema, Name: col.Table},
+			}
+			$$ = &ast.FuncCallExpr{
+				FnName: model.NewCIStr(ast.NextVal),
+				Args:   []ast.ExprNode{objNameExpr},
+			}
+		}
+	}
+|	FunctionCallKeyword
+|	FunctionCallNonKeyword
+|	FunctionCallGeneric
+|	SimpleExpr "COLLATE" CollationName
+	{
+		$$ = &ast.SetCollationExpr{Expr: $1, Collate: $3}
+	}
+|	WindowFuncCall
+|	Literal
+|	paramMarker
+	{
+		paramExpr := ast.NewParamMarkerExpr(yyS[yypt].offset)
+		paramExpr.SetOrder(parser.paramMarkerCursor)
+		parser.paramMarkerCursor++
+		$$ = paramExpr
+	}
+|	Variable
+|	SumExpr
+|	'!' SimpleExpr %prec neg
+	{
+		$$ = &ast.UnaryOperationExpr{Op: opcode.Not2, V: $2}
+	}
+|	'~' SimpleExpr %prec neg
+	{
+		$$ = &ast.UnaryOperationExpr{Op: opcode.BitNeg, V: $2}
+	}
+|	'-' SimpleExpr %prec neg
+	{
+		$$ = &ast.UnaryOperationExpr{Op: opcode.Minus, V: $2}
+	}
+|	'+' SimpleExpr %prec neg
+	{
+		$$ = &ast.UnaryOperationExpr{Op: opcode.Plus, V: $2}
+	}
+|	SimpleExpr pipes SimpleExpr
+	{
+		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr(ast.Concat), Args: []ast.ExprNode{$1, $3}}
+	}
+|	not2 SimpleExpr %prec neg
+	{
+		$$ = &ast.UnaryOperationExpr{Op: opcode.Not2, V: $2}
+	}
+|	SubSelect %prec neg
+|	'(' Expression ')'
+	{
+		startOffset := parser.startOffset(&yyS[yypt-1])
+		endOffset := parser.endOffset(&yyS[yypt])
+		expr := $2
+		expr.SetText(parser.lexer.client, parser.src[startOffset:endOffset])
+		$$ = &ast.ParenthesesExpr{Expr: expr}
+	}
+|	'(' ExpressionList ',' Expression ')'
+	{
+		values := append($2.([]ast.ExprNode), $4)
+		$$ = &ast.RowExpr{Values: values}
+	}
+|	"ROW" '(' ExpressionList ',' Expression ')'
+	{
+		values := append($3.([]ast.ExprNode), $5)
+		$$ = &ast.RowExpr{Values: values}
+	}
+|	"EXISTS" SubSelect
+	{
+		sq := $2.(*ast.SubqueryExpr)
+		sq.Exists = true
+		$$ = &ast.ExistsSubqueryExpr{Sel: sq}
+	}
+|	'{' Identifier Expression '}'
+	{
+		/*
+		 * ODBC escape syntax.
+		 * See https://dev.mysql.com/doc/refman/5.7/en/expressions.html
+		 */
+		tp := $3.GetType()
+		switch $2 {
+		case "d":
+			tp.Charset = ""
+			tp.Collate = ""
+			$$ = &ast.FuncCallExpr{FnName: model.NewCIStr(ast.DateLiteral), Args: []ast.ExprNode{$3}}
+		case "t":
+			tp.Charset = ""
+			tp.Collate = ""
+			$$ = &ast.FuncCallExpr{FnName: model.NewCIStr(ast.TimeLiteral), Args: []ast.ExprNode{$3}}
+		case "ts":
+			tp.Charset = ""
+			tp.Collate = ""
+			$$ = &ast.FuncCallExpr{FnName: model.NewCIStr(ast.TimestampLiteral), Args: []ast.ExprNode{$3}}
+		default:
+			$$ = $3
+		}
+	}
+|	"BINARY" SimpleExpr %prec neg
+	{
+		// See https://dev.mysql.com/doc/refman/5.7/en/cast-functions.html#operator_binary
+		x := types.NewFieldType(mysql.TypeString)
+		x.Charset = charset.CharsetBin
+		x.Collate = charset.CharsetBin
+		x.Flag |= mysql.BinaryFlag
+		$$ = &ast.FuncCastExpr{
+			Expr:         $2,
+			Tp:           x,
+			FunctionType: ast.CastBinaryOperator,
+		}
+	}
+|	builtinCast '(' Expression "AS" CastType CastArrayOpt ')'
+	{
+		/* See https://dev.mysql.com/doc/refman/5.7/en/cast-functions.html#function_cast */
+		tp := $5.(*types.FieldType)
+		defaultFlen, defaultDecimal := mysql.GetDefaultFieldLengthAndDecimalForCast(tp.Tp)
+		if tp.Flen == types.UnspecifiedLength {
+			tp.Flen = defaultFlen
+		}
+		if tp.Decimal == types.UnspecifiedLength {
+			tp.Decimal = defaultDecimal
+		}
+		explicitCharset := parser.explicitCharset
+		parser.explicitCharset = false
+		if $6.(bool) && !isArrayCastType(tp) {
+			yylex.AppendError(yylex.Errorf("CAST-ing data to array of %s is not supported", strings.ToUpper(types.TypeStr(tp.Tp))))
+			return 1
+		}
+		$$ = &ast.FuncCastExpr{
+			Expr:            $3,
+			Tp:              tp,
+			FunctionType:    ast.CastFunction,
+			ExplicitCharSet: explicitCharset,
+			Array:           $6.(bool),
+		}
+	}
+|	"CASE" ExpressionOpt WhenClauseList ElseOpt "END"
+	{
+		x := &ast.CaseExpr{WhenClauses: $3.([]*ast.WhenClause)}
+		if $2 != nil {
+			x.Value = $2
+		}
+		if $4 != nil {
+			x.ElseClause = $4.(ast.ExprNode)
+		}
+		$$ = x
+	}
+|	"CONVERT" '(' Expression ',' CastType CastArrayOpt ')'
+	{
+		// See https://dev.mysql.com/doc/refman/5.7/en/cast-functions.html#function_convert
+		tp := $5.(*types.FieldType)
+		defaultFlen, defaultDecimal := mysql.GetDefaultFieldLengthAndDecimalForCast(tp.Tp)
+		if tp.Flen == types.UnspecifiedLength {
+			tp.Flen = defaultFlen
+		}
+		if tp.Decimal == types.UnspecifiedLength {
+			tp.Decimal = defaultDecimal
+		}
+		explicitCharset := parser.explicitCharset
+		parser.explicitCharset = false
+		if $6.(bool) && !isArrayCastType(tp) {
+			yylex.AppendError(yylex.Errorf("CAST-ing data to array of %s is not supported", strings.ToUpper(types.TypeStr(tp.Tp))))
+			return 1
+		}
+		$$ = &ast.FuncCastExpr{
+			Expr:            $3,
+			Tp:              tp,
+			FunctionType:    ast.CastConvertFunction,
+			ExplicitCharSet: explicitCharset,
+			Array:           $6.(bool),
+		}
+	}
+|	"CONVERT" '(' Expression "USING" CharsetName ')'
+	{
+		// See https://dev.mysql.com/doc/refman/5.7/en/cast-functions.html#function_convert
+		charset1 := ast.NewValueExpr($5, "", "")
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr($1),
+			Args:   []ast.ExprNode{$3, charset1},
+		}
+	}
+|	"DEFAULT" '(' SimpleIdent ')'
+	{
+		$$ = &ast.DefaultExpr{Name: $3.(*ast.ColumnNameExpr).Name}
+	}
+|	"VALUES" '(' SimpleIdent ')' %prec lowerThanInsertValues
+	{
+		$$ = &ast.ValuesExpr{Column: $3.(*ast.ColumnNameExpr)}
+	}
+|	SimpleIdent jss stringLit
+	{
+		expr := ast.NewValueExpr($3, parser.charset, parser.collation)
+		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr(ast.JSONExtract), Args: []ast.ExprNode{$1, expr}}
+	}
+|	SimpleIdent juss stringLit
+	{
+		expr := ast.NewValueExpr($3, parser.charset, parser.collation)
+		extract := &ast.FuncCallExpr{FnName: model.NewCIStr(ast.JSONExtract), Args: []ast.ExprNode{$1, expr}}
+		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr(ast.JSONUnquote), Args: []ast.ExprNode{extract}}
+	}
+
+DistinctKwd:
+	"DISTINCT"
+|	"DISTINCTROW"
+
+DistinctOpt:
+	"ALL"
+	{
+		$$ = false
+	}
+|	DistinctKwd
+	{
+		$$ = true
+	}
+
+DefaultFalseDistinctOpt:
+	{
+		$$ = false
+	}
+|	DistinctOpt
+
+DefaultTrueDistinctOpt:
+	{
+		$$ = true
+	}
+|	DistinctOpt
+
+BuggyDefaultFalseDistinctOpt:
+	DefaultFalseDistinctOpt
+|	DistinctKwd "ALL"
+	{
+		$$ = true
+	}
+
+FunctionNameConflict:
+	"ASCII"
+|	"POINT"
+|	"LINESTRING"
+|	"POLYGON"
+|	"MULTIPOINT"
+|	"MULTILINESTRING"
+|	"MULTIPOLYGON"
+|	"GEOMETRYCOLLECTION"
+|	"GEOMCOLLECTION"
+|	"CHARSET"
+|	"COALESCE"
+|	"COLLATION"
+|	"DATE"
+|	"DATABASE"
+|	"DAY"
+|	"HOUR"
+|	"IF"
+|	"INTERVAL"
+|	"FORMAT"
+|	"LEFT"
+|	"MICROSECOND"
+|	"MINUTE"
+|	"MONTH"
+|	builtinNow
+|	"QUARTER"
+|	"REPEAT"
+|	"REPLACE"
+|	"REVERSE"
+|	"RIGHT"
+|	"ROW_COUNT"
+|	"SECOND"
+|	"TIME"
+|	"TIMESTAMP"
+|	"TRUNCATE"
+|	"USER"
+|	"WEEK"
+|	"YEAR"
+
+OptionalBraces:
+	{}
+|	'(' ')'
+	{}
+
+FunctionNameOptionalBraces:
+	"CURRENT_USER"
+|	"CURRENT_DATE"
+|	"CURRENT_ROLE"
+|	"UTC_DATE"
+
+FunctionNameDatetimePrecision:
+	"CURRENT_TIME"
+|	"CURRENT_TIMESTAMP"
+|	"LOCALTIME"
+|	"LOCALTIMESTAMP"
+|	"UTC_TIME"
+|	"UTC_TIMESTAMP"
+
+FunctionCallKeyword:
+	FunctionNameConflict '(' ExpressionListOpt ')'
+	{
+		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr($1), Args: $3.([]ast.ExprNode)}
+	}
+|	builtinUser '(' ExpressionListOpt ')'
+	{
+		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr($1), Args: $3.([]ast.ExprNode)}
+	}
+|	FunctionNameOptionalBraces OptionalBraces
+	{
+		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr($1)}
+	}
+|	builtinCurDate '(' ')'
+	{
+		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr($1)}
+	}
+|	FunctionNameDatetimePrecision FuncDatetimePrec
+	{
+		args := []ast.ExprNode{}
+		if $2 != nil {
+			args = append(args, $2.(ast.ExprNode))
+		}
+		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr($1), Args: args}
+	}
+|	"CHAR" '(' ExpressionList ')'
+	{
+		nilVal := ast.NewValueExpr(nil, parser.charset, parser.collation)
+		args := $3.([]ast.ExprNode)
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr(ast.CharFunc),
+			Args:   append(args, nilVal),
+		}
+	}
+|	"CHAR" '(' ExpressionList "USING" CharsetName ')'
+	{
+		charset1 := ast.NewValueExpr($5, "", "")
+		args := $3.([]ast.ExprNode)
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr(ast.CharFunc),
+			Args:   append(args, charset1),
+		}
+	}
+|	"DATE" stringLit
+	{
+		expr := ast.NewValueExpr($2, "", "")
+		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr(ast.DateLiteral), Args: []ast.ExprNode{expr}}
+	}
+|	"TIME" stringLit
+	{
+		expr := ast.NewValueExpr($2, "", "")
+		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr(ast.TimeLiteral), Args: []ast.ExprNode{expr}}
+	}
+|	"TIMESTAMP" stringLit
+	{
+		expr := ast.NewValueExpr($2, "", "")
+		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr(ast.TimestampLiteral), Args: []ast.ExprNode{expr}}
+	}
+|	"INSERT" '(' ExpressionListOpt ')'
+	{
+		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr(ast.InsertFunc), Args: $3.([]ast.ExprNode)}
+	}
+|	"MOD" '(' BitExpr ',' BitExpr ')'
+	{
+		$$ = &ast.BinaryOperationExpr{Op: opcode.Mod, L: $3, R: $5}
+	}
+|	"PASSWORD" '(' ExpressionListOpt ')'
+	{
+		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr(ast.PasswordFunc), Args: $3.([]ast.ExprNode)}
+	}
+
+FunctionCallNonKeyword:
+	builtinCurTime '(' FuncDatetimePrecListOpt ')'
+	{
+		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr($1), Args: $3.([]ast.ExprNode)}
+	}
+|	builtinSysDate '(' FuncDatetimePrecListOpt ')'
+	{
+		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr($1), Args: $3.([]ast.ExprNode)}
+	}
+|	FunctionNameDateArithMultiForms '(' Expression ',' Expression ')'
+	{
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr($1),
+			Args: []ast.ExprNode{
+				$3,
+				$5,
+				&ast.TimeUnitExpr{Unit: ast.TimeUnitDay},
+			},
+		}
+	}
+|	FunctionNameDateArithMultiForms '(' Expression ',' "INTERVAL" Expression TimeUnit ')'
+	{
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr($1),
+			Args: []ast.ExprNode{
+				$3,
+				$6,
+				&ast.TimeUnitExpr{Unit: $7.(ast.TimeUnitType)},
+			},
+		}
+	}
+|	FunctionNameDateArith '(' Expression ',' "INTERVAL" Expression TimeUnit ')'
+	{
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr($1),
+			Args: []ast.ExprNode{
+				$3,
+				$6,
+				&ast.TimeUnitExpr{Unit: $7.(ast.TimeUnitType)},
+			},
+		}
+	}
+|	builtinExtract '(' TimeUnit "FROM" Expression ')'
+	{
+		timeUnit := &ast.TimeUnitExpr{Unit: $3.(ast.TimeUnitType)}
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr($1),
+			Args:   []ast.ExprNode{timeUnit, $5},
+		}
+	}
+|	"GET_FORMAT" '(' GetFormatSelector ',' Expression ')'
+	{
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr($1),
+			Args: []ast.ExprNode{
+				&ast.GetFormatSelectorExpr{Selector: $3.(ast.GetFormatSelectorType)},
+				$5,
+			},
+		}
+	}
+|	builtinPosition '(' BitExpr "IN" Expression ')'
+	{
+		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr($1), Args: []ast.ExprNode{$3, $5}}
+	}
+|	builtinSubstring '(' Expression ',' Expression ')'
+	{
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr($1),
+			Args:   []ast.ExprNode{$3, $5},
+		}
+	}
+|	builtinSubstring '(' Expression "FROM" Expression ')'
+	{
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr($1),
+			Args:   []ast.ExprNode{$3, $5},
+		}
+	}
+|	builtinSubstring '(' Expression ',' Expression ',' Expression ')'
+	{
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr($1),
+			Args:   []ast.ExprNode{$3, $5, $7},
+		}
+	}
+|	builtinSubstring '(' Expression "FROM" Expression "FOR" Expression ')'
+	{
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr($1),
+			Args:   []ast.ExprNode{$3, $5, $7},
+		}
+	}
+|	"TIMESTAMPADD" '(' TimestampUnit ',' Expression ',' Expression ')'
+	{
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr($1),
+			Args:   []ast.ExprNode{&ast.TimeUnitExpr{Unit: $3.(ast.TimeUnitType)}, $5, $7},
+		}
+	}
+|	"TIMESTAMPDIFF" '(' TimestampUnit ',' Expression ',' Expression ')'
+	{
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr($1),
+			Args:   []ast.ExprNode{&ast.TimeUnitExpr{Unit: $3.(ast.TimeUnitType)}, $5, $7},
+		}
+	}
+|	builtinTrim '(' Expression ')'
+	{
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr($1),
+			Args:   []ast.ExprNode{$3},
+		}
+	}
+|	builtinTrim '(' Expression "FROM" Expression ')'
+	{
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr($1),
+			Args:   []ast.ExprNode{$5, $3},
+		}
+	}
+|	builtinTrim '(' TrimDirection "FROM" Expression ')'
+	{
+		spaceVal := ast.NewValueExpr(" ", parser.charset, parser.collation)
+		direction := &ast.TrimDirectionExpr{Direction: $3.(ast.TrimDirectionType)}
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr($1),
+			Args:   []ast.ExprNode{$5, spaceVal, direction},
+		}
+	}
+|	builtinTrim '(' TrimDirection Expression "FROM" Expression ')'
+	{
+		direction := &ast.TrimDirectionExpr{Direction: $3.(ast.TrimDirectionType)}
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr($1),
+			Args:   []ast.ExprNode{$6, $4, direction},
+		}
+	}
+|	weightString '(' Expression ')'
+	{
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr($1),
+			Args:   []ast.ExprNode{$3},
+		}
+	}
+|	weightString '(' Expression "AS" Char FieldLen ')'
+	{
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr($1),
+			Args:   []ast.ExprNode{$3, ast.NewValueExpr("CHAR", parser.charset, parser.collation), ast.NewValueExpr($6, parser.charset, parser.collation)},
+		}
+	}
+|	weightString '(' Expression "AS" "BINARY" FieldLen ')'
+	{
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr($1),
+			Args:   []ast.ExprNode{$3, ast.NewValueExpr("BINARY", parser.charset, parser.collation), ast.NewValueExpr($6, parser.charset, parser.collation)},
+		}
+	}
+|	FunctionNameSequence
+|	builtinTranslate '(' Expression ',' Expression ',' Expression ')'
+	{
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr($1),
+			Args:   []ast.ExprNode{$3, $5, $7},
+		}
+	}
+
+GetFormatSelector:
+	"DATE"
+	{
+		$$ = ast.GetFormatSelectorDate
+	}
+|	"DATETIME"
+	{
+		$$ = ast.GetFormatSelectorDatetime
+	}
+|	"TIME"
+	{
+		$$ = ast.GetFormatSelectorTime
+	}
+|	"TIMESTAMP"
+	{
+		$$ = ast.GetFormatSelectorDatetime
+	}
+
+FunctionNameDateArith:
+	builtinDateAdd
+|	builtinDateSub
+
+FunctionNameDateArithMultiForms:
+	addDate
+|	subDate
+
+TrimDirection:
+	"BOTH"
+	{
+		$$ = ast.TrimBoth
+	}
+|	"LEADING"
+	{
+		$$ = ast.TrimLeading
+	}
+|	"TRAILING"
+	{
+		$$ = ast.TrimTrailing
+	}
+
+FunctionNameSequence:
+	"LASTVAL" '(' TableName ')'
+	{
+		objNameExpr := &ast.TableNameExpr{
+			Name: $3.(*ast.TableName),
+		}
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr(ast.LastVal),
+			Args:   []ast.ExprNode{objNameExpr},
+		}
+	}
+|	"SETVAL" '(' TableName ',' SignedNum ')'
+	{
+		objNameExpr := &ast.TableNameExpr{
+			Name: $3.(*ast.TableName),
+		}
+		valueExpr := ast.NewValueExpr($5, parser.charset, parser.collation)
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr(ast.SetVal),
+			Args:   []ast.ExprNode{objNameExpr, valueExpr},
+		}
+	}
+|	NextValueForSequence
+
+SumExpr:
+	"AVG" '(' BuggyDefaultFalseDistinctOpt Expression ')' OptWindowingClause
+	{
+		if $6 != nil {
+			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool), Spec: *($6.(*ast.WindowSpec))}
+		} else {
+			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool)}
+		}
+	}
+|	builtinApproxCountDistinct '(' ExpressionList ')'
+	{
+		$$ = &ast.AggregateFuncExpr{F: $1, Args: $3.([]ast.ExprNode), Distinct: false}
+	}
+|	builtinApproxPercentile '(' ExpressionList ')'
+	{
+		$$ = &ast.AggregateFuncExpr{F: $1, Args: $3.([]ast.ExprNode)}
+	}
+|	builtinBitAnd '(' Expression ')' OptWindowingClause
+	{
+		if $5 != nil {
+			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$3}, Spec: *($5.(*ast.WindowSpec))}
+		} else {
+			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$3}}
+		}
+	}
+|	builtinBitAnd '(' "ALL" Expression ')' OptWindowingClause
+	{
+		if $6 != nil {
+			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Spec: *($6.(*ast.WindowSpec))}
+		} else {
+			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}}
+		}
+	}
+|	builtinBitOr '(' Expression ')' OptWindowingClause
+	{
+		if $5 != nil {
+			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$3}, Spec: *($5.(*ast.WindowSpec))}
+		} else {
+			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$3}}
+		}
+	}
+|	builtinBitOr '(' "ALL" Expression ')' OptWindowingClause
+	{
+		if $6 != nil {
+			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Spec: *($6.(*ast.WindowSpec))}
+		} else {
+			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}}
+		}
+	}
+|	builtinBitXor '(' Expression ')' OptWindowingClause
+	{
+		if $5 != nil {
+			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$3}, Spec: *($5.(*ast.WindowSpec))}
+		} else {
+			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$3}}
+		}
+	}
+|	builtinBitXor '(' "ALL" Expression ')' OptWindowingClause
+	{
+		if $6 != nil {
+			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Spec: *($6.(*ast.WindowSpec))}
+		} else {
+			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}}
+		}
+	}
+|	builtinCount '(' DistinctKwd ExpressionList ')'
+	{
+		$$ = &ast.AggregateFuncExpr{F: $1, Args: $4.([]ast.ExprNode), Distinct: true}
+	}
+|	builtinCount '(' "ALL" Expression ')' OptWindowingClause
+	{
+		if $6 != nil {
+			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Spec: *($6.(*ast.WindowSpec))}
+		} else {
+			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}}
+		}
+	}
+|	builtinCount '(' Expression ')' OptWindowingClause
+	{
+		if $5 != nil {
+			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$3}, Spec: *($5.(*ast.WindowSpec))}
+		} else {
+			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$3}}
+		}
+	}
+|	builtinCount '(' '*' ')' OptWindowingClause
+	{
+		args := []ast.ExprNode{ast.NewValueExpr(1, parser.charset, parser.collation)}
+		if $5 != nil {
+			$$ = &ast.WindowFuncExpr{F: $1, Args: args, Spec: *($5.(*ast.WindowSpec))}
+		} else {
+			$$ = &ast.AggregateFuncExpr{F: $1, Args: args}
+		}
+	}
+|	builtinGroupConcat '(' BuggyDefaultFalseDistinctOpt ExpressionList OrderByOptional OptGConcatSeparator ')' OptWindowingClause
+	{
+		args := $4.([]ast.ExprNode)
+		args = append(args, $6.(ast.ExprNode))
+		if $8 != nil {
+			$$ = &ast.WindowFuncExpr{F: $1, Args: args, Distinct: $3.(bool), Spec: *($8.(*ast.WindowSpec))}
+		} else {
+			agg := &ast.AggregateFuncExpr{F: $1, Args: args, Distinct: $3.(bool)}
+			if $5 != nil {
+				agg.Order = $5.(*ast.OrderByClause)
+			}
+			$$ = agg
+		}
+	}
+|	builtinMax '(' BuggyDefaultFalseDistinctOpt Expression ')' OptWindowingClause
+	{
+		if $6 != nil {
+			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool), Spec: *($6.(*ast.WindowSpec))}
+		} else {
+			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool)}
+		}
+	}
+|	builtinMin '(' BuggyDefaultFalseDistinctOpt Expression ')' OptWindowingClause
+	{
+		if $6 != nil {
+			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool), Spec: *($6.(*ast.WindowSpec))}
+		} else {
+			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool)}
+		}
+	}
+|	builtinSum '(' BuggyDefaultFalseDistinctOpt Expression ')' OptWindowingClause
+	{
+		if $6 != nil {
+			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool), Spec: *($6.(*ast.WindowSpec))}
+		} else {
+			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool)}
+		}
+	}
+|	builtinStddevPop '(' BuggyDefaultFalseDistinctOpt Expression ')' OptWindowingClause
+	{
+		if $6 != nil {
+			$$ = &ast.WindowFuncExpr{F: ast.AggFuncStddevPop, Args: []ast.ExprNode{$4}, Distinct: $3.(bool), Spec: *($6.(*ast.WindowSpec))}
+		} else {
+			$$ = &ast.AggregateFuncExpr{F: ast.AggFuncStddevPop, Args: []ast.ExprNode{$4}, Distinct: $3.(bool)}
+		}
+	}
+|	builtinStddevSamp '(' BuggyDefaultFalseDistinctOpt Expression ')' OptWindowingClause
+	{
+		if $6 != nil {
+			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool), Spec: *($6.(*ast.WindowSpec))}
+		} else {
+			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool)}
+		}
+	}
+|	builtinVarPop '(' BuggyDefaultFalseDistinctOpt Expression ')' OptWindowingClause
+	{
+		if $6 != nil {
+			$$ = &ast.WindowFuncExpr{F: ast.AggFuncVarPop, Args: []ast.ExprNode{$4}, Distinct: $3.(bool), Spec: *($6.(*ast.WindowSpec))}
+		} else {
+			$$ = &ast.AggregateFuncExpr{F: ast.AggFuncVarPop, Args: []ast.ExprNode{$4}, Distinct: $3.(bool)}
+		}
+	}
+|	builtinVarSamp '(' BuggyDefaultFalseDistinctOpt Expression ')' OptWindowingClause
+	{
+		$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool)}
+	}
+|	"JSON_ARRAYAGG" '(' Expression ')' OptWindowingClause
+	{
+		if $5 != nil {
+			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$3}, Spec: *($5.(*ast.WindowSpec))}
+		} else {
+			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$3}}
+		}
+	}
+|	"JSON_ARRAYAGG" '(' "ALL" Expression ')' OptWindowingClause
+	{
+		if $6 != nil {
+			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Spec: *($6.(*ast.WindowSpec))}
+		} else {
+			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}}
+		}
+	}
+|	"JSON_OBJECTAGG" '(' Expression ',' Expression ')' OptWindowingClause
+	{
+		if $7 != nil {
+			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$3, $5}, Spec: *($7.(*ast.WindowSpec))}
+		} else {
+			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$3, $5}}
+		}
+	}
+|	"JSON_OBJECTAGG" '(' "ALL" Expression ',' Expression ')' OptWindowingClause
+	{
+		if $8 != nil {
+			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$4, $6}, Spec: *($8.(*ast.WindowSpec))}
+		} else {
+			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4, $6}}
+		}
+	}
+|	"JSON_OBJECTAGG" '(' Expression ',' "ALL" Expression ')' OptWindowingClause
+	{
+		if $8 != nil {
+			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$3, $6}, Spec: *($8.(*ast.WindowSpec))}
+		} else {
+			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$3, $6}}
+		}
+	}
+|	"JSON_OBJECTAGG" '(' "ALL" Expression ',' "ALL" Expression ')' OptWindowingClause
+	{
+		if $9 != nil {
+			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$4, $7}, Spec: *($9.(*ast.WindowSpec))}
+		} else {
+			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4, $7}}
+		}
+	}
+
+OptGConcatSeparator:
+	{
+		$$ = ast.NewValueExpr(",", "", "")
+	}
+|	"SEPARATOR" stringLit
+	{
+		$$ = ast.NewValueExpr($2, "", "")
+	}
+
+FunctionCallGeneric:
+	identifier '(' ExpressionListOpt ')'
+	{
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr($1),
+			Args:   $3.([]ast.ExprNode),
+		}
+	}
+|	Identifier '.' Identifier '(' ExpressionListOpt ')'
+	{
+		var tp ast.FuncCallExprType
+		if isInTokenMap($3) {
+			tp = ast.FuncCallExprTypeKeyword
+		} else {
+			tp = ast.FuncCallExprTypeGeneric
+		}
+		$$ = &ast.FuncCallExpr{
+			Tp:     tp,
+			Schema: model.NewCIStr($1),
+			FnName: model.NewCIStr($3),
+			Args:   $5.([]ast.ExprNode),
+		}
+	}
+
+FuncDatetimePrec:
+	{
+		$$ = nil
+	}
+|	'(' ')'
+	{
+		$$ = nil
+	}
+|	'(' intLit ')'
+	{
+		expr := ast.NewValueExpr($2, parser.charset, parser.collation)
+		$$ = expr
+	}
+
+TimeUnit:
+	TimestampUnit
+|	"SECOND_MICROSECOND"
+	{
+		$$ = ast.TimeUnitSecondMicrosecond
+	}
+|	"MINUTE_MICROSECOND"
+	{
+		$$ = ast.TimeUnitMinuteMicrosecond
+	}
+|	"MINUTE_SECOND"
+	{
+		$$ = ast.TimeUnitMinuteSecond
+	}
+|	"HOUR_MICROSECOND"
+	{
+		$$ = ast.TimeUnitHourMicrosecond
+	}
+|	"HOUR_SECOND"
+	{
+		$$ = ast.TimeUnitHourSecond
+	}
+|	"HOUR_MINUTE"
+	{
+		$$ = ast.TimeUnitHourMinute
+	}
+|	"DAY_MICROSECOND"
+	{
+		$$ = ast.TimeUnitDayMicrosecond
+	}
+|	"DAY_SECOND"
+	{
+		$$ = ast.TimeUnitDaySecond
+	}
+|	"DAY_MINUTE"
+	{
+		$$ = ast.TimeUnitDayMinute
+	}
+|	"DAY_HOUR"
+	{
+		$$ = ast.TimeUnitDayHour
+	}
+|	"YEAR_MONTH"
+	{
+		$$ = ast.TimeUnitYearMonth
+	}
+
+TimestampUnit:
+	"MICROSECOND"
+	{
+		$$ = ast.TimeUnitMicrosecond
+	}
+|	"SECOND"
+	{
+		$$ = ast.TimeUnitSecond
+	}
+|	"MINUTE"
+	{
+		$$ = ast.TimeUnitMinute
+	}
+|	"HOUR"
+	{
+		$$ = ast.TimeUnitHour
+	}
+|	"DAY"
+	{
+		$$ = ast.TimeUnitDay
+	}
+|	"WEEK"
+	{
+		$$ = ast.TimeUnitWeek
+	}
+|	"MONTH"
+	{
+		$$ = ast.TimeUnitMonth
+	}
+|	"QUARTER"
+	{
+		$$ = ast.TimeUnitQuarter
+	}
+|	"YEAR"
+	{
+		$$ = ast.TimeUnitYear
+	}
+|	"SQL_TSI_SECOND"
+	{
+		$$ = ast.TimeUnitSecond
+	}
+|	"SQL_TSI_MINUTE"
+	{
+		$$ = ast.TimeUnitMinute
+	}
+|	"SQL_TSI_HOUR"
+	{
+		$$ = ast.TimeUnitHour
+	}
+|	"SQL_TSI_DAY"
+	{
+		$$ = ast.TimeUnitDay
+	}
+|	"SQL_TSI_WEEK"
+	{
+		$$ = ast.TimeUnitWeek
+	}
+|	"SQL_TSI_MONTH"
+	{
+		$$ = ast.TimeUnitMonth
+	}
+|	"SQL_TSI_QUARTER"
+	{
+		$$ = ast.TimeUnitQuarter
+	}
+|	"SQL_TSI_YEAR"
+	{
+		$$ = ast.TimeUnitYear
+	}
 
-/************************************************************************************
- *
- *  Call Statements
- *
- **********************************************************************************/
-CallStmt:
-	"CALL" ProcedureCall
+ExpressionOpt:
 	{
-		$$ = &ast.CallStmt{
-			Procedure: $2.(*ast.FuncCallExpr),
-		}
+		$$ = nil
 	}
+|	Expression
 
-ProcedureCall:
-	identifier
+WhenClauseList:
+	WhenClause
 	{
-		$$ = &ast.FuncCallExpr{
-			Tp:     ast.FuncCallExprTypeGeneric,
-			FnName: model.NewCIStr($1),
-			Args:   []ast.ExprNode{},
-		}
+		$$ = []*ast.WhenClause{$1.(*ast.WhenClause)}
 	}
-|	Identifier '.' Identifier
+|	WhenClauseList WhenClause
 	{
-		$$ = &ast.FuncCallExpr{
-			Tp:     ast.FuncCallExprTypeGeneric,
-			Schema: model.NewCIStr($1),
-			FnName: model.NewCIStr($3),
-			Args:   []ast.ExprNode{},
-		}
+		$$ = append($1.([]*ast.WhenClause), $2.(*ast.WhenClause))
 	}
-|	identifier '(' ExpressionListOpt ')'
+
+WhenClause:
+	"WHEN" Expression "THEN" Expression
 	{
-		$$ = &ast.FuncCallExpr{
-			Tp:     ast.FuncCallExprTypeGeneric,
-			FnName: model.NewCIStr($1),
-			Args:   $3.([]ast.ExprNode),
+		$$ = &ast.WhenClause{
+			Expr:   $2,
+			Result: $4,
 		}
 	}
-|	Identifier '.' Identifier '(' ExpressionListOpt ')'
+
+ElseOpt:
+	/* empty */
 	{
-		$$ = &ast.FuncCallExpr{
-			Tp:     ast.FuncCallExprTypeGeneric,
-			Schema: model.NewCIStr($1),
-			FnName: model.NewCIStr($3),
-			Args:   $5.([]ast.ExprNode),
-		}
+		$$ = nil
+	}
+|	"ELSE" Expression
+	{
+		$$ = $2
 	}
 
-/************************************************************************************
- *
- *  Insert Statements
- *
- **********************************************************************************/
-InsertIntoStmt:
-	"INSERT" TableOptimizerHintsOpt PriorityOpt IgnoreOptional IntoOpt TableName PartitionNameListOpt InsertValues OnDuplicateKeyUpdate
+CastArrayOpt:
 	{
-		x := $8.(*ast.InsertStmt)
-		x.Priority = $3.(mysql.PriorityEnum)
-		x.IgnoreErr = $4.(bool)
-		// Wraps many layers here so that it can be processed the same way as select statement.
-		ts := &ast.TableSource{Source: $6.(*ast.TableName)}
-		x.Table = &ast.TableRefsClause{TableRefs: &ast.Join{Left: ts}}
-		if $9 != nil {
-			x.OnDuplicate = $9.([]*ast.Assignment)
-		}
-		if $2 != nil {
-			x.TableHints = $2.([]*ast.TableOptimizerHint)
+		$$ = false
+	}
+|	"ARRAY"
+	{
+		$$ = true
+	}
+
+CastType:
+	"BINARY" OptFieldLen
+	{
+		x := types.NewFieldType(mysql.TypeVarString)
+		x.Flen = $2.(int) // TODO: Flen should be the flen of expression
+		if x.Flen != types.UnspecifiedLength {
+			x.Tp = mysql.TypeString
 		}
-		x.PartitionNames = $7.([]model.CIStr)
+		x.Charset = charset.CharsetBin
+		x.Collate = charset.CollationBin
+		x.Flag |= mysql.BinaryFlag
 		$$ = x
 	}
-
-IntoOpt:
-	{}
-|	"INTO"
-
-InsertValues:
-	'(' ColumnNameListOpt ')' ValueSym ValuesList
+|	Char OptFieldLen OptBinary
 	{
-		$$ = &ast.InsertStmt{
-			Columns: $2.([]*ast.ColumnName),
-			Lists:   $5.([][]ast.ExprNode),
+		x := types.NewFieldType(mysql.TypeVarString)
+		x.Flen = $2.(int) // TODO: Flen should be the flen of expression
+		x.Charset = $3.(*ast.OptBinary).Charset
+		if $3.(*ast.OptBinary).IsBinary {
+			x.Flag |= mysql.BinaryFlag
+			x.Charset = charset.CharsetBin
+			x.Collate = charset.CollationBin
+		} else if x.Charset != "" {
+			co, err := charset.GetDefaultCollation(x.Charset)
+			if err != nil {
+				yylex.AppendError(yylex.Errorf("Get collation error for charset: %s", x.Charset))
+				return 1
+			}
+			x.Collate = co
+			parser.explicitCharset = true
+		} else {
+			x.Charset = parser.charset
+			x.Collate = parser.collation
 		}
+		$$ = x
 	}
-|	'(' ColumnNameListOpt ')' SetOprStmt
+|	"DATE"
 	{
-		$$ = &ast.InsertStmt{Columns: $2.([]*ast.ColumnName), Select: $4.(ast.ResultSetNode)}
+		x := types.NewFieldType(mysql.TypeDate)
+		x.Charset = charset.CharsetBin
+		x.Collate = charset.CollationBin
+		x.Flag |= mysql.BinaryFlag
+		$$ = x
 	}
-|	'(' ColumnNameListOpt ')' SelectStmt
+|	"YEAR"
 	{
-		$$ = &ast.InsertStmt{Columns: $2.([]*ast.ColumnName), Select: $4.(ast.ResultSetNode)}
+		x := types.NewFieldType(mysql.TypeYear)
+		x.Charset = charset.CharsetBin
+		x.Collate = charset.CollationBin
+		x.Flag |= mysql.BinaryFlag
+		$$ = x
 	}
-|	'(' ColumnNameListOpt ')' SelectStmtWithClause
+|	"DATETIME" OptFieldLen
 	{
-		$$ = &ast.InsertStmt{Columns: $2.([]*ast.ColumnName), Select: $4.(ast.ResultSetNode)}
+		x := types.NewFieldType(mysql.TypeDatetime)
+		x.Flen, _ = mysql.GetDefaultFieldLengthAndDecimalForCast(mysql.TypeDatetime)
+		x.Decimal = $2.(int)
+		if x.Decimal > 0 {
+			x.Flen = x.Flen + 1 + x.Decimal
+		}
+		x.Charset = charset.CharsetBin
+		x.Collate = charset.CollationBin
+		x.Flag |= mysql.BinaryFlag
+		$$ = x
 	}
-|	'(' ColumnNameListOpt ')' SubSelect
+|	"DECIMAL" FloatOpt
 	{
-		var sel ast.ResultSetNode
-		switch x := $4.(*ast.SubqueryExpr).Query.(type) {
-		case *ast.SelectStmt:
-			x.IsInBraces = true
-			sel = x
-		case *ast.SetOprStmt:
-			x.IsInBraces = true
-			sel = x
+		fopt := $2.(*ast.FloatOpt)
+		x := types.NewFieldType(mysql.TypeNewDecimal)
+		x.Flen = fopt.Flen
+		x.Decimal = fopt.Decimal
+		x.Charset = charset.CharsetBin
+		x.Collate = charset.CollationBin
+		x.Flag |= mysql.BinaryFlag
+		$$ = x
+	}
+|	"TIME" OptFieldLen
+	{
+		x := types.NewFieldType(mysql.TypeDuration)
+		x.Flen, _ = mysql.GetDefaultFieldLengthAndDecimalForCast(mysql.TypeDuration)
+		x.Decimal = $2.(int)
+		if x.Decimal > 0 {
+			x.Flen = x.Flen + 1 + x.Decimal
 		}
-		$$ = &ast.InsertStmt{Columns: $2.([]*ast.ColumnName), Select: sel}
+		x.Charset = charset.CharsetBin
+		x.Collate = charset.CollationBin
+		x.Flag |= mysql.BinaryFlag
+		$$ = x
 	}
-|	ValueSym ValuesList %prec insertValues
+|	"SIGNED" OptInteger
 	{
-		$$ = &ast.InsertStmt{Lists: $2.([][]ast.ExprNode)}
+		x := types.NewFieldType(mysql.TypeLonglong)
+		x.Charset = charset.CharsetBin
+		x.Collate = charset.CollationBin
+		x.Flag |= mysql.BinaryFlag
+		$$ = x
 	}
-|	SetOprStmt
+|	"UNSIGNED" OptInteger
 	{
-		$$ = &ast.InsertStmt{Select: $1.(ast.ResultSetNode)}
+		x := types.NewFieldType(mysql.TypeLonglong)
+		x.Flag |= mysql.UnsignedFlag | mysql.BinaryFlag
+		x.Charset = charset.CharsetBin
+		x.Collate = charset.CollationBin
+		$$ = x
 	}
-|	SelectStmt
+|	"JSON"
 	{
-		$$ = &ast.InsertStmt{Select: $1.(ast.ResultSetNode)}
+		x := types.NewFieldType(mysql.TypeJSON)
+		x.Flag |= mysql.BinaryFlag | (mysql.ParseToJSONFlag)
+		x.Charset = mysql.DefaultCharset
+		x.Collate = mysql.DefaultCollationName
+		$$ = x
 	}
-|	SelectStmtWithClause
+|	"DOUBLE"
 	{
-		$$ = &ast.InsertStmt{Select: $1.(ast.ResultSetNode)}
+		x := types.NewFieldType(mysql.TypeDouble)
+		x.Flen, x.Decimal = mysql.GetDefaultFieldLengthAndDecimalForCast(mysql.TypeDouble)
+		x.Flag |= mysql.BinaryFlag
+		x.Charset = charset.CharsetBin
+		x.Collate = charset.CollationBin
+		$$ = x
 	}
-|	SubSelect
+|	"FLOAT" FloatOpt
 	{
-		var sel ast.ResultSetNode
-		switch x := $1.(*ast.SubqueryExpr).Query.(type) {
-		case *ast.SelectStmt:
-			x.IsInBraces = true
-			sel = x
-		case *ast.SetOprStmt:
-			x.IsInBraces = true
-			sel = x
+		x := types.NewFieldType(mysql.TypeFloat)
+		fopt := $2.(*ast.FloatOpt)
+		if fopt.Flen >= 54 {
+			yylex.AppendError(ErrTooBigPrecision.GenWithStackByArgs(fopt.Flen, "CAST", 53))
+		} else if fopt.Flen >= 25 {
+			x = types.NewFieldType(mysql.TypeDouble)
 		}
-		$$ = &ast.InsertStmt{Select: sel}
+		x.Flen, x.Decimal = mysql.GetDefaultFieldLengthAndDecimalForCast(x.Tp)
+		x.Flag |= mysql.BinaryFlag
+		x.Charset = charset.CharsetBin
+		x.Collate = charset.CollationBin
+		$$ = x
 	}
-|	"SET" ColumnSetValueList
+|	"REAL"
 	{
-		$$ = &ast.InsertStmt{Setlist: $2.([]*ast.Assignment)}
+		var x *types.FieldType
+		if parser.lexer.GetSQLMode().HasRealAsFloatMode() {
+			x = types.NewFieldType(mysql.TypeFloat)
+		} else {
+			x = types.NewFieldType(mysql.TypeDouble)
+		}
+		x.Flen, x.Decimal = mysql.GetDefaultFieldLengthAndDecimalForCast(x.Tp)
+		x.Flag |= mysql.BinaryFlag
+		x.Charset = charset.CharsetBin
+		x.Collate = charset.CollationBin
+		$$ = x
 	}
 
-ValueSym:
-	"VALUE"
-|	"VALUES"
-
-ValuesList:
-	RowValue
+Priority:
+	"LOW_PRIORITY"
 	{
-		$$ = [][]ast.ExprNode{$1.([]ast.ExprNode)}
+		$$ = mysql.LowPriority
 	}
-|	ValuesList ',' RowValue
+|	"HIGH_PRIORITY"
 	{
-		$$ = append($1.([][]ast.ExprNode), $3.([]ast.ExprNode))
+		$$ = mysql.HighPriority
 	}
-
-RowValue:
-	'(' ValuesOpt ')'
+|	"DELAYED"
 	{
-		$$ = $2
+		$$ = mysql.DelayedPriority
 	}
 
-ValuesOpt:
+PriorityOpt:
 	{
-		$$ = []ast.ExprNode{}
+		$$ = mysql.NoPriority
 	}
-|	Values
+|	Priority
 
-Values:
-	Values ',' ExprOrDefault
+TableName:
+	Identifier
 	{
-		$$ = append($1.([]ast.ExprNode), $3)
+		$$ = &ast.TableName{Name: model.NewCIStr($1)}
 	}
-|	ExprOrDefault
+|	Identifier '.' Identifier
 	{
-		$$ = []ast.ExprNode{$1}
+		$$ = &ast.TableName{Schema: model.NewCIStr($1), Name: model.NewCIStr($3)}
 	}
 
-ExprOrDefault:
-	Expression
-|	"DEFAULT"
+TableNameList:
+	TableName
 	{
-		$$ = &ast.DefaultExpr{}
+		tbl := []*ast.TableName{$1.(*ast.TableName)}
+		$$ = tbl
 	}
-
-ColumnSetValue:
-	ColumnName eq ExprOrDefault
+|	TableNameList ',' TableName
 	{
-		$$ = &ast.Assignment{
-			Column: $1.(*ast.ColumnName),
-			Expr:   $3,
-		}
+		$$ = append($1.([]*ast.TableName), $3.(*ast.TableName))
 	}
 
-ColumnSetValueList:
-	{
-		$$ = []*ast.Assignment{}
-	}
-|	ColumnSetValue
+TableNameOptWild:
+	Identifier OptWild
 	{
-		$$ = []*ast.Assignment{$1.(*ast.Assignment)}
+		$$ = &ast.TableName{Name: model.NewCIStr($1)}
 	}
-|	ColumnSetValueList ',' ColumnSetValue
+|	Identifier '.' Identifier OptWild
 	{
-		$$ = append($1.([]*ast.Assignment), $3.(*ast.Assignment))
+		$$ = &ast.TableName{Schema: model.NewCIStr($1), Name: model.NewCIStr($3)}
 	}
 
-/*
- * ON DUPLICATE KEY UPDATE col_name=expr [, col_name=expr] ...
- * See https://dev.mysql.com/doc/refman/5.7/en/insert-on-duplicate.html
- */
-OnDuplicateKeyUpdate:
+TableAliasRefList:
+	TableNameOptWild
 	{
-		$$ = nil
+		tbl := []*ast.TableName{$1.(*ast.TableName)}
+		$$ = tbl
 	}
-|	"ON" "DUPLICATE" "KEY" "UPDATE" AssignmentList
+|	TableAliasRefList ',' TableNameOptWild
 	{
-		$$ = $5
+		$$ = append($1.([]*ast.TableName), $3.(*ast.TableName))
 	}
 
-/************************************************************************************
- *  Replace Statements
- *  See https://dev.mysql.com/doc/refman/5.7/en/replace.html
- *
- **********************************************************************************/
-ReplaceIntoStmt:
-	"REPLACE" PriorityOpt IntoOpt TableName PartitionNameListOpt InsertValues
-	{
-		x := $6.(*ast.InsertStmt)
-		x.IsReplace = true
-		x.Priority = $2.(mysql.PriorityEnum)
-		ts := &ast.TableSource{Source: $4.(*ast.TableName)}
-		x.Table = &ast.TableRefsClause{TableRefs: &ast.Join{Left: ts}}
-		x.PartitionNames = $5.([]model.CIStr)
-		$$ = x
-	}
+OptWild:
+	%prec empty
+	{}
+|	'.' '*'
+	{}
 
-Literal:
-	"FALSE"
+QuickOptional:
+	%prec empty
 	{
-		$$ = ast.NewValueExpr(false, parser.charset, parser.collation)
+		$$ = false
 	}
-|	"NULL"
+|	"QUICK"
 	{
-		$$ = ast.NewValueExpr(nil, parser.charset, parser.collation)
+		$$ = true
 	}
-|	"TRUE"
+
+/***************************Prepared Statement Start******************************
+ * See https://dev.mysql.com/doc/refman/5.7/en/prepare.html
+ * Example:
+ * PREPARE stmt_name FROM 'SELECT SQRT(POW(?,2) + POW(?,2)) AS hypotenuse';
+ * OR
+ * SET @s = 'SELECT SQRT(POW(?,2) + POW(?,2)) AS hypotenuse';
+ * PREPARE stmt_name FROM @s;
+ */
+PreparedStmt:
+	"PREPARE" Identifier "FROM" PrepareSQL
 	{
-		$$ = ast.NewValueExpr(true, parser.charset, parser.collation)
+		var sqlText string
+		var sqlVar *ast.VariableExpr
+		switch x := $4.(type) {
+		case string:
+			sqlText = x
+		case *ast.VariableExpr:
+			sqlVar = x
+		}
+		$$ = &ast.PrepareStmt{
+			Name:    $2,
+			SQLText: sqlText,
+			SQLVar:  sqlVar,
+		}
 	}
-|	floatLit
+
+PrepareSQL:
+	stringLit
 	{
-		$$ = ast.NewValueExpr($1, parser.charset, parser.collation)
+		$$ = $1
 	}
-|	decLit
+|	UserVariable
 	{
-		$$ = ast.NewValueExpr($1, parser.charset, parser.collation)
+		$$ = $1
 	}
-|	intLit
+
+/*
+ * See https://dev.mysql.com/doc/refman/5.7/en/execute.html
+ * Example:
+ * EXECUTE stmt1 USING @a, @b;
+ * OR
+ * EXECUTE stmt1;
+ */
+ExecuteStmt:
+	"EXECUTE" Identifier
 	{
-		$$ = ast.NewValueExpr($1, parser.charset, parser.collation)
+		$$ = &ast.ExecuteStmt{Name: $2}
 	}
-|	StringLiteral %prec lowerThanStringLitToken
-|	"UNDERSCORE_CHARSET" stringLit
+|	"EXECUTE" Identifier "USING" UserVariableList
 	{
-		// See https://dev.mysql.com/doc/refman/5.7/en/charset-literal.html
-		co, err := charset.GetDefaultCollationLegacy($1)
-		if err != nil {
-			yylex.AppendError(ast.ErrUnknownCharacterSet.GenWithStack("Unsupported character introducer: '%-.64s'", $1))
-			return 1
-		}
-		expr := ast.NewValueExpr($2, $1, co)
-		tp := expr.GetType()
-		tp.Charset = $1
-		tp.Collate = co
-		if tp.Collate == charset.CollationBin {
-			tp.Flag |= mysql.BinaryFlag
+		$$ = &ast.ExecuteStmt{
+			Name:      $2,
+			UsingVars: $4.([]ast.ExprNode),
 		}
-		$$ = expr
-	}
-|	hexLit
-	{
-		$$ = ast.NewValueExpr($1, parser.charset, parser.collation)
 	}
-|	bitLit
+
+UserVariableList:
+	UserVariable
 	{
-		$$ = ast.NewValueExpr($1, parser.charset, parser.collation)
+		$$ = []ast.ExprNode{$1}
 	}
-|	"UNDERSCORE_CHARSET" hexLit
+|	UserVariableList ',' UserVariable
 	{
-		co, err := charset.GetDefaultCollationLegacy($1)
-		if err != nil {
-			yylex.AppendError(ast.ErrUnknownCharacterSet.GenWithStack("Unsupported character introducer: '%-.64s'", $1))
-			return 1
-		}
-		expr := ast.NewValueExpr($2, $1, co)
-		tp := expr.GetType()
-		tp.Charset = $1
-		tp.Collate = co
-		if tp.Collate == charset.CollationBin {
-			tp.Flag |= mysql.BinaryFlag
-		}
-		$$ = expr
+		$$ = append($1.([]ast.ExprNode), $3)
 	}
-|	"UNDERSCORE_CHARSET" bitLit
-	{
-		co, err := charset.GetDefaultCollationLegacy($1)
-		if err != nil {
-			yylex.AppendError(ast.ErrUnknownCharacterSet.GenWithStack("Unsupported character introducer: '%-.64s'", $1))
-			return 1
-		}
-		expr := ast.NewValueExpr($2, $1, co)
-		tp := expr.GetType()
-		tp.Charset = $1
-		tp.Collate = co
-		if tp.Collate == charset.CollationBin {
-			tp.Flag |= mysql.BinaryFlag
-		}
-		$$ = expr
+
+DeallocateStmt:
+	DeallocateSym "PREPARE" Identifier
+	{
+		$$ = &ast.DeallocateStmt{Name: $3}
 	}
 
-StringLiteral:
-	stringLit
+DeallocateSym:
+	"DEALLOCATE"
+|	"DROP"
+
+RollbackStmt:
+	"ROLLBACK" WorkOpt
 	{
-		expr := ast.NewValueExpr($1, parser.charset, parser.collation)
-		$$ = expr
+		$$ = &ast.RollbackStmt{}
 	}
-|	StringLiteral stringLit
+|	"ROLLBACK" WorkOpt CompletionTypeWithinTransaction
 	{
-		valExpr := $1.(ast.ValueExpr)
-		strLit := valExpr.GetString()
-		expr := ast.NewValueExpr(strLit+$2, parser.charset, parser.collation)
-		// Fix #4239, use first string literal as projection name.
-		if valExpr.GetProjectionOffset() >= 0 {
-			expr.SetProjectionOffset(valExpr.GetProjectionOffset())
-		} else {
-			expr.SetProjectionOffset(len(strLit))
-		}
-		$$ = expr
+		$$ = &ast.RollbackStmt{CompletionType: $3.(ast.CompletionType)}
 	}
-
-AlterOrderList:
-	AlterOrderItem
+|	"ROLLBACK" WorkOpt "TO" Identifier
 	{
-		$$ = []*ast.AlterOrderItem{$1.(*ast.AlterOrderItem)}
+		$$ = &ast.RollbackStmt{SavepointName: $4}
 	}
-|	AlterOrderList ',' AlterOrderItem
+|	"ROLLBACK" WorkOpt "TO" "SAVEPOINT" Identifier
 	{
-		$$ = append($1.([]*ast.AlterOrderItem), $3.(*ast.AlterOrderItem))
+		$$ = &ast.RollbackStmt{SavepointName: $5}
 	}
 
-AlterOrderItem:
-	ColumnName OptOrder
+SavepointStmt:
+	"SAVEPOINT" Identifier
 	{
-		$$ = &ast.AlterOrderItem{Column: $1.(*ast.ColumnName), Desc: $2.(bool)}
+		$$ = &ast.SavepointStmt{Name: $2}
 	}
 
-OrderBy:
-	"ORDER" "BY" ByList
+ReleaseSavepointStmt:
+	"RELEASE" "SAVEPOINT" Identifier
 	{
-		$$ = &ast.OrderByClause{Items: $3.([]*ast.ByItem)}
+		$$ = &ast.ReleaseSavepointStmt{Name: $3}
 	}
 
-ByList:
-	ByItem
+/*******************************************************************
+ *
+ *  XA Statements
+ *
+ *  See https://dev.mysql.com/doc/refman/8.0/en/xa-statements.html
+ *******************************************************************/
+XAStmt:
+	"XA" XABeginOrStart XID
 	{
-		$$ = []*ast.ByItem{$1.(*ast.ByItem)}
+		$$ = &ast.XAStmt{Tp: ast.XAStart, XID: $3.(*ast.XID)}
 	}
-|	ByList ',' ByItem
+|	"XA" XABeginOrStart XID "JOIN"
 	{
-		$$ = append($1.([]*ast.ByItem), $3.(*ast.ByItem))
+		$$ = &ast.XAStmt{Tp: ast.XAStart, XID: $3.(*ast.XID), Join: true}
 	}
-
-ByItem:
-	Expression
+|	"XA" XABeginOrStart XID "RESUME"
 	{
-		expr := $1
-		valueExpr, ok := expr.(ast.ValueExpr)
-		if ok {
-			position, isPosition := valueExpr.GetValue().(int64)
-			if isPosition {
-				expr = &ast.PositionExpr{N: int(position)}
-			}
-		}
-		$$ = &ast.ByItem{Expr: expr, NullOrder: true}
+		$$ = &ast.XAStmt{Tp: ast.XAStart, XID: $3.(*ast.XID), Resume: true}
 	}
-|	Expression Order
+|	"XA" "END" XID
 	{
-		expr := $1
-		valueExpr, ok := expr.(ast.ValueExpr)
-		if ok {
-			position, isPosition := valueExpr.GetValue().(int64)
-			if isPosition {
-				expr = &ast.PositionExpr{N: int(position)}
-			}
-		}
-		$$ = &ast.ByItem{Expr: expr, Desc: $2.(bool)}
+		$$ = &ast.XAStmt{Tp: ast.XAEnd, XID: $3.(*ast.XID)}
 	}
-
-Order:
-	"ASC"
+|	"XA" "END" XID "SUSPEND"
 	{
-		$$ = false
+		$$ = &ast.XAStmt{Tp: ast.XAEnd, XID: $3.(*ast.XID), Suspend: true}
 	}
-|	"DESC"
+|	"XA" "END" XID "SUSPEND" "FOR" "MIGRATE"
 	{
-		$$ = true
+		$$ = &ast.XAStmt{Tp: ast.XAEnd, XID: $3.(*ast.XID), Suspend: true, ForMigrate: true}
 	}
-
-OptOrder:
-	/* EMPTY */
+|	"XA" "PREPARE" XID
 	{
-		$$ = false // ASC by default
+		$$ = &ast.XAStmt{Tp: ast.XAPrepare, XID: $3.(*ast.XID)}
 	}
-|	"ASC"
+|	"XA" "COMMIT" XID
 	{
-		$$ = false
+		$$ = &ast.XAStmt{Tp: ast.XACommit, XID: $3.(*ast.XID)}
 	}
-|	"DESC"
+|	"XA" "COMMIT" XID "ONE" "PHASE"
 	{
-		$$ = true
+		$$ = &ast.XAStmt{Tp: ast.XACommit, XID: $3.(*ast.XID), OnePhase: true}
 	}
-
-OrderByOptional:
+|	"XA" "ROLLBACK" XID
 	{
-		$$ = nil
+		$$ = &ast.XAStmt{Tp: ast.XARollback, XID: $3.(*ast.XID)}
 	}
-|	OrderBy
-
-BitExpr:
-	BitExpr '|' BitExpr %prec '|'
+|	"XA" "RECOVER"
 	{
-		$$ = &ast.BinaryOperationExpr{Op: opcode.Or, L: $1, R: $3}
+		$$ = &ast.XAStmt{Tp: ast.XARecover}
 	}
-|	BitExpr '&' BitExpr %prec '&'
+|	"XA" "RECOVER" "CONVERT" "XID"
 	{
-		$$ = &ast.BinaryOperationExpr{Op: opcode.And, L: $1, R: $3}
+		$$ = &ast.XAStmt{Tp: ast.XARecover, ConvertXID: true}
 	}
-|	BitExpr "<<" BitExpr %prec lsh
+
+XABeginOrStart:
+	"BEGIN"
+	{}
+|	"START"
+	{}
+
+XID:
+	TextString
 	{
-		$$ = &ast.BinaryOperationExpr{Op: opcode.LeftShift, L: $1, R: $3}
+		$$ = &ast.XID{Gtrid: $1.(*ast.TextString), FormatID: ast.XIDDefaultFormatID}
 	}
-|	BitExpr ">>" BitExpr %prec rsh
+|	TextString ',' TextString
 	{
-		$$ = &ast.BinaryOperationExpr{Op: opcode.RightShift, L: $1, R: $3}
+		$$ = &ast.XID{Gtrid: $1.(*ast.TextString), Bqual: $3.(*ast.TextString), FormatID: ast.XIDDefaultFormatID}
 	}
-|	BitExpr '+' BitExpr %prec '+'
+|	TextString ',' TextString ',' XIDFormatID
 	{
-		$$ = &ast.BinaryOperationExpr{Op: opcode.Plus, L: $1, R: $3}
+		$$ = &ast.XID{Gtrid: $1.(*ast.TextString), Bqual: $3.(*ast.TextString), FormatID: $5.(uint64)}
 	}
-|	BitExpr '-' BitExpr %prec '-'
+
+XIDFormatID:
+	LengthNum
+|	hexLit
 	{
-		$$ = &ast.BinaryOperationExpr{Op: opcode.Minus, L: $1, R: $3}
+		formatID, ok := binaryLiteralToUint64($1.(ast.BinaryLiteral))
+		if !ok {
+			yylex.AppendError(yylex.Errorf("The formatID of XID is out of range"))
+			return 1
+		}
+		$$ = formatID
 	}
-|	BitExpr '+' "INTERVAL" Expression TimeUnit %prec '+'
+|	bitLit
 	{
-		$$ = &ast.FuncCallExpr{
-			FnName: model.NewCIStr("DATE_ADD"),
-			Args: []ast.ExprNode{
-				$1,
-				$4,
-				&ast.TimeUnitExpr{Unit: $5.(ast.TimeUnitType)},
-			},
+		formatID, ok := binaryLiteralToUint64($1.(ast.BinaryLiteral))
+		if !ok {
+			yylex.AppendError(yylex.Errorf("The formatID of XID is out of range"))
+			return 1
 		}
+		$$ = formatID
 	}
-|	BitExpr '-' "INTERVAL" Expression TimeUnit %prec '+'
+
+CompletionTypeWithinTransaction:
+	"AND" "CHAIN" "NO" "RELEASE"
 	{
-		$$ = &ast.FuncCallExpr{
-			FnName: model.NewCIStr("DATE_SUB"),
-			Args: []ast.ExprNode{
-				$1,
-				$4,
-				&ast.TimeUnitExpr{Unit: $5.(ast.TimeUnitType)},
-			},
-		}
+		$$ = ast.CompletionTypeChain
 	}
-|	BitExpr '*' BitExpr %prec '*'
+|	"AND" "NO" "CHAIN" "RELEASE"
 	{
-		$$ = &ast.BinaryOperationExpr{Op: opcode.Mul, L: $1, R: $3}
+		$$ = ast.CompletionTypeRelease
 	}
-|	BitExpr '/' BitExpr %prec '/'
+|	"AND" "NO" "CHAIN" "NO" "RELEASE"
 	{
-		$$ = &ast.BinaryOperationExpr{Op: opcode.Div, L: $1, R: $3}
+		$$ = ast.CompletionTypeDefault
 	}
-|	BitExpr '%' BitExpr %prec '%'
+|	"AND" "CHAIN"
 	{
-		$$ = &ast.BinaryOperationExpr{Op: opcode.Mod, L: $1, R: $3}
+		$$ = ast.CompletionTypeChain
 	}
-|	BitExpr "DIV" BitExpr %prec div
+|	"AND" "NO" "CHAIN"
 	{
-		$$ = &ast.BinaryOperationExpr{Op: opcode.IntDiv, L: $1, R: $3}
+		$$ = ast.CompletionTypeDefault
 	}
-|	BitExpr "MOD" BitExpr %prec mod
+|	"RELEASE"
 	{
-		$$ = &ast.BinaryOperationExpr{Op: opcode.Mod, L: $1, R: $3}
+		$$ = ast.CompletionTypeRelease
 	}
-|	BitExpr '^' BitExpr
+|	"NO" "RELEASE"
 	{
-		$$ = &ast.BinaryOperationExpr{Op: opcode.Xor, L: $1, R: $3}
+		$$ = ast.CompletionTypeDefault
 	}
-|	SimpleExpr
 
-SimpleIdent:
-	Identifier
+ShutdownStmt:
+	"SHUTDOWN"
 	{
-		$$ = &ast.ColumnNameExpr{Name: &ast.ColumnName{
-			Name: model.NewCIStr($1),
-		}}
+		$$ = &ast.ShutdownStmt{}
 	}
-|	Identifier '.' Identifier
+
+RestartStmt:
+	"RESTART"
 	{
-		$$ = &ast.ColumnNameExpr{Name: &ast.ColumnName{
-			Table: model.NewCIStr($1),
-			Name:  model.NewCIStr($3),
-		}}
+		$$ = &ast.RestartStmt{}
 	}
-|	Identifier '.' Identifier '.' Identifier
+
+HelpStmt:
+	"HELP" stringLit
 	{
-		$$ = &ast.ColumnNameExpr{Name: &ast.ColumnName{
-			Schema: model.NewCIStr($1),
-			Table:  model.NewCIStr($3),
-			Name:   model.NewCIStr($5),
-		}}
+		$$ = &ast.HelpStmt{Topic: $2}
 	}
 
-SimpleExpr:
-	SimpleIdent
-|	FunctionCallKeyword
-|	FunctionCallNonKeyword
-|	FunctionCallGeneric
-|	SimpleExpr "COLLATE" CollationName
+SelectStmtBasic:
+	"SELECT" SelectStmtOpts SelectStmtFieldList %prec lowerThanInto
+	{
+		st := &ast.SelectStmt{
+			SelectStmtOpts: $2.(*ast.SelectStmtOpts),
+			Distinct:       $2.(*ast.SelectStmtOpts).Distinct,
+			Fields:         $3.(*ast.FieldList),
+			Kind:           ast.SelectStmtKindSelect,
+		}
+		if st.SelectStmtOpts.TableHints != nil {
+			st.TableHints = st.SelectStmtOpts.TableHints
+		}
+		$$ = st
+	}
+|	"SELECT" SelectStmtOpts SelectStmtFieldList SelectIntoClause
+	{
+		// The INTO clause right after the select fields, such as `SELECT a INTO @x FROM t`.
+		st := &ast.SelectStmt{
+			SelectStmtOpts: $2.(*ast.SelectStmtOpts),
+			Distinct:       $2.(*ast.SelectStmtOpts).Distinct,
+			Fields:         $3.(*ast.FieldList),
+			Kind:           ast.SelectStmtKindSelect,
+			SelectIntoOpt:  $4.(*ast.SelectIntoOption),
+		}
+		if st.SelectStmtOpts.TableHints != nil {
+			st.TableHints = st.SelectStmtOpts.TableHints
+		}
+		lastField := st.Fields.Fields[len(st.Fields.Fields)-1]
+		if lastField.Expr != nil && lastField.AsName.O == "" {
+			lastEnd := parser.endOffset(&yyS[yypt])
+			lastField.SetText(parser.lexer.client, parser.src[lastField.Offset:lastEnd])
+		}
+		$$ = st
+	}
+
+SelectStmtFromDualTable:
+	SelectStmtBasic FromDual WhereClauseOptional
+	{
+		st := $1.(*ast.SelectStmt)
+		lastField := st.Fields.Fields[len(st.Fields.Fields)-1]
+		if lastField.Expr != nil && lastField.AsName.O == "" && st.SelectIntoOpt == nil {
+			lastEnd := yyS[yypt-1].offset - 1
+			lastField.SetText(parser.lexer.client, parser.src[lastField.Offset:lastEnd])
+		}
+		if $3 != nil {
+			st.Where = $3.(ast.ExprNode)
+		}
+	}
+
+SelectStmtFromTable:
+	SelectStmtBasic "FROM" TableRefsClause WhereClauseOptional SelectStmtGroup HavingClause WindowClauseOptional
+	{
+		st := $1.(*ast.SelectStmt)
+		st.From = $3.(*ast.TableRefsClause)
+		lastField := st.Fields.Fields[len(st.Fields.Fields)-1]
+		if lastField.Expr != nil && lastField.AsName.O == "" && st.SelectIntoOpt == nil {
+			lastEnd := parser.endOffset(&yyS[yypt-5])
+			lastField.SetText(parser.lexer.client, parser.src[lastField.Offset:lastEnd])
+		}
+		if $4 != nil {
+			st.Where = $4.(ast.ExprNode)
+		}
+		if $5 != nil {
+			st.GroupBy = $5.(*ast.GroupByClause)
+		}
+		if $6 != nil {
+			st.Having = $6.(*ast.HavingClause)
+		}
+		if $7 != nil {
+			st.WindowSpecs = ($7.([]ast.WindowSpec))
+		}
+		$$ = st
+	}
+
+TableSampleOpt:
+	%prec empty
 	{
-		$$ = &ast.SetCollationExpr{Expr: $1, Collate: $3}
+		$$ = nil
 	}
-|	WindowFuncCall
-|	Literal
-|	paramMarker
+|	"TABLESAMPLE" TableSampleMethodOpt '(' Expression TableSampleUnitOpt ')' RepeatableOpt
 	{
-		paramExpr := ast.NewParamMarkerExpr(yyS[yypt].offset)
-		paramExpr.SetOrder(parser.paramMarkerCursor)
-		parser.paramMarkerCursor++
-		$$ = paramExpr
+		var repSeed ast.ExprNode
+		if $7 != nil {
+			repSeed = ast.NewValueExpr($7, parser.charset, parser.collation)
+		}
+		$$ = &ast.TableSample{
+			SampleMethod:     $2.(ast.SampleMethodType),
+			Expr:             ast.NewValueExpr($4, parser.charset, parser.collation),
+			SampleClauseUnit: $5.(ast.SampleClauseUnitType),
+			RepeatableSeed:   repSeed,
+		}
 	}
-|	Variable
-|	SumExpr
-|	'!' SimpleExpr %prec neg
+|	"TABLESAMPLE" TableSampleMethodOpt '(' ')' RepeatableOpt
 	{
-		$$ = &ast.UnaryOperationExpr{Op: opcode.Not2, V: $2}
+		var repSeed ast.ExprNode
+		if $5 != nil {
+			repSeed = ast.NewValueExpr($5, parser.charset, parser.collation)
+		}
+		$$ = &ast.TableSample{
+			SampleMethod:   $2.(ast.SampleMethodType),
+			RepeatableSeed: repSeed,
+		}
 	}
-|	'~' SimpleExpr %prec neg
+
+TableSampleMethodOpt:
+	%prec empty
 	{
-		$$ = &ast.UnaryOperationExpr{Op: opcode.BitNeg, V: $2}
+		$$ = ast.SampleMethodTypeNone
 	}
-|	'-' SimpleExpr %prec neg
+|	"SYSTEM"
 	{
-		$$ = &ast.UnaryOperationExpr{Op: opcode.Minus, V: $2}
+		$$ = ast.SampleMethodTypeSystem
 	}
-|	'+' SimpleExpr %prec neg
+|	"BERNOULLI"
 	{
-		$$ = &ast.UnaryOperationExpr{Op: opcode.Plus, V: $2}
+		$$ = ast.SampleMethodTypeBernoulli
 	}
-|	SimpleExpr pipes SimpleExpr
+|	"REGIONS"
 	{
-		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr(ast.Concat), Args: []ast.ExprNode{$1, $3}}
+		$$ = ast.SampleMethodTypeTiDBRegion
 	}
-|	not2 SimpleExpr %prec neg
+
+TableSampleUnitOpt:
+	%prec empty
 	{
-		$$ = &ast.UnaryOperationExpr{Op: opcode.Not2, V: $2}
+		$$ = ast.SampleClauseUnitTypeDefault
 	}
-|	SubSelect %prec neg
-|	'(' Expression ')'
+|	"ROWS"
 	{
-		startOffset := parser.startOffset(&yyS[yypt-1])
-		endOffset := parser.endOffset(&yyS[yypt])
-		expr := $2
-		expr.SetText(parser.lexer.client, parser.src[startOffset:endOffset])
-		$$ = &ast.ParenthesesExpr{Expr: expr}
+		$$ = ast.SampleClauseUnitTypeRow
 	}
-|	'(' ExpressionList ',' Expression ')'
+|	"PERCENT"
 	{
-		values := append($2.([]ast.ExprNode), $4)
-		$$ = &ast.RowExpr{Values: values}
+		$$ = ast.SampleClauseUnitTypePercent
 	}
-|	"ROW" '(' ExpressionList ',' Expression ')'
+
+RepeatableOpt:
+	%prec empty
 	{
-		values := append($3.([]ast.ExprNode), $5)
-		$$ = &ast.RowExpr{Values: values}
+		$$ = nil
 	}
-|	"EXISTS" SubSelect
+|	"REPEATABLE" '(' Expression ')'
 	{
-		sq := $2.(*ast.SubqueryExpr)
-		sq.Exists = true
-		$$ = &ast.ExistsSubqueryExpr{Sel: sq}
+		$$ = $3
 	}
-|	'{' Identifier Expression '}'
+
+SelectStmt:
+	SelectStmtBasic WhereClauseOptional SelectStmtGroup OrderByOptional SelectStmtLimitOpt SelectLockOpt SelectStmtIntoOption
 	{
-		/*
-		 * ODBC escape syntax.
-		 * See https://dev.mysql.com/doc/refman/5.7/en/expressions.html
-		 */
-		tp := $3.GetType()
-		switch $2 {
-		case "d":
-			tp.Charset = ""
-			tp.Collate = ""
-			$$ = &ast.FuncCallExpr{FnName: model.NewCIStr(ast.DateLiteral), Args: []ast.ExprNode{$3}}
-		case "t":
-			tp.Charset = ""
-			tp.Collate = ""
-			$$ = &ast.FuncCallExpr{FnName: model.NewCIStr(ast.TimeLiteral), Args: []ast.ExprNode{$3}}
-		case "ts":
-			tp.Charset = ""
-			tp.Collate = ""
-			$$ = &ast.FuncCallExpr{FnName: model.NewCIStr(ast.TimestampLiteral), Args: []ast.ExprNode{$3}}
-		default:
-			$$ = $3
+		st := $1.(*ast.SelectStmt)
+		if $6 != nil {
+			st.LockInfo = $6.(*ast.SelectLockInfo)
+		}
+		lastField := st.Fields.Fields[len(st.Fields.Fields)-1]
+		if lastField.Expr != nil && lastField.AsName.O == "" && st.SelectIntoOpt == nil {
+			src := parser.src
+			var lastEnd int
+			if $2 != nil {
+				lastEnd = yyS[yypt-5].offset - 1
+			} else if $3 != nil {
+				lastEnd = yyS[yypt-4].offset - 1
+			} else if $4 != nil {
+				lastEnd = yyS[yypt-3].offset - 1
+			} else if $5 != nil {
+				lastEnd = yyS[yypt-2].offset - 1
+			} else if st.LockInfo != nil && st.LockInfo.LockType != ast.SelectLockNone {
+				lastEnd = yyS[yypt-1].offset - 1
+			} else if $7 != nil {
+				lastEnd = yyS[yypt].offset - 1
+			} else {
+				lastEnd = len(src)
+				if src[lastEnd-1] == ';' {
+					lastEnd--
+				}
+			}
+			lastField.SetText(parser.lexer.client, src[lastField.Offset:lastEnd])
+		}
+		if $2 != nil {
+			st.Where = $2.(ast.ExprNode)
+		}
+		if $3 != nil {
+			st.GroupBy = $3.(*ast.GroupByClause)
+		}
+		if $4 != nil {
+			st.OrderBy = $4.(*ast.OrderByClause)
+		}
+		if $5 != nil {
+			st.Limit = $5.(*ast.Limit)
+		}
+		if $7 != nil {
+			if st.SelectIntoOpt != nil {
+				yylex.AppendError(yylex.Errorf("Multiple INTO clauses in one query block."))
+				return 1
+			}
+			st.SelectIntoOpt = $7.(*ast.SelectIntoOption)
 		}
+		$$ = st
 	}
-|	"BINARY" SimpleExpr %prec neg
+|	SelectStmtFromDualTable SelectStmtGroup OrderByOptional SelectStmtLimitOpt SelectLockOpt SelectStmtIntoOption
 	{
-		// See https://dev.mysql.com/doc/refman/5.7/en/cast-functions.html#operator_binary
-		x := types.NewFieldType(mysql.TypeString)
-		x.Charset = charset.CharsetBin
-		x.Collate = charset.CharsetBin
-		x.Flag |= mysql.BinaryFlag
-		$$ = &ast.FuncCastExpr{
-			Expr:         $2,
-			Tp:           x,
-			FunctionType: ast.CastBinaryOperator,
+		st := $1.(*ast.SelectStmt)
+		if $2 != nil {
+			st.GroupBy = $2.(*ast.GroupByClause)
+		}
+		if $3 != nil {
+			st.OrderBy = $3.(*ast.OrderByClause)
+		}
+		if $4 != nil {
+			st.Limit = $4.(*ast.Limit)
+		}
+		if $5 != nil {
+			st.LockInfo = $5.(*ast.SelectLockInfo)
+		}
+		if $6 != nil {
+			if st.SelectIntoOpt != nil {
+				yylex.AppendError(yylex.Errorf("Multiple INTO clauses in one query block."))
+				return 1
+			}
+			st.SelectIntoOpt = $6.(*ast.SelectIntoOption)
 		}
+		$$ = st
 	}
-|	builtinCast '(' Expression "AS" CastType ')'
+|	SelectStmtFromTable OrderByOptional SelectStmtLimitOpt SelectLockOpt SelectStmtIntoOption
 	{
-		/* See https://dev.mysql.com/doc/refman/5.7/en/cast-functions.html#function_cast */
-		tp := $5.(*types.FieldType)
-		defaultFlen, defaultDecimal := mysql.GetDefaultFieldLengthAndDecimalForCast(tp.Tp)
-		if tp.Flen == types.UnspecifiedLength {
-			tp.Flen = defaultFlen
+		st := $1.(*ast.SelectStmt)
+		if $4 != nil {
+			st.LockInfo = $4.(*ast.SelectLockInfo)
 		}
-		if tp.Decimal == types.UnspecifiedLength {
-			tp.Decimal = defaultDecimal
+		if $2 != nil {
+			st.OrderBy = $2.(*ast.OrderByClause)
 		}
-		explicitCharset := parser.explicitCharset
-		parser.explicitCharset = false
-		$$ = &ast.FuncCastExpr{
-			Expr:            $3,
-			Tp:              tp,
-			FunctionType:    ast.CastFunction,
-			ExplicitCharSet: explicitCharset,
+		if $3 != nil {
+			st.Limit = $3.(*ast.Limit)
 		}
+		if $5 != nil {
+			if st.SelectIntoOpt != nil {
+				yylex.AppendError(yylex.Errorf("Multiple INTO clauses in one query block."))
+				return 1
+			}
+			st.SelectIntoOpt = $5.(*ast.SelectIntoOption)
+		}
+		$$ = st
 	}
-|	"CASE" ExpressionOpt WhenClauseList ElseOpt "END"
+|	"TABLE" TableName OrderByOptional SelectStmtLimitOpt SelectLockOpt SelectStmtIntoOption
 	{
-		x := &ast.CaseExpr{WhenClauses: $3.([]*ast.WhenClause)}
-		if $2 != nil {
-			x.Value = $2
+		st := &ast.SelectStmt{
+			Kind:   ast.SelectStmtKindTable,
+			Fields: &ast.FieldList{Fields: []*ast.SelectField{{WildCard: &ast.WildCardField{}}}},
+		}
+		ts := &ast.TableSource{Source: $2.(*ast.TableName)}
+		st.From = &ast.TableRefsClause{TableRefs: &ast.Join{Left: ts}}
+		if $3 != nil {
+			st.OrderBy = $3.(*ast.OrderByClause)
 		}
 		if $4 != nil {
-			x.ElseClause = $4.(ast.ExprNode)
+			st.Limit = $4.(*ast.Limit)
 		}
-		$$ = x
+		if $5 != nil {
+			st.LockInfo = $5.(*ast.SelectLockInfo)
+		}
+		if $6 != nil {
+			st.SelectIntoOpt = $6.(*ast.SelectIntoOption)
+		}
+		$$ = st
 	}
-|	"CONVERT" '(' Expression ',' CastType ')'
+|	"VALUES" ValuesStmtList OrderByOptional SelectStmtLimitOpt SelectLockOpt SelectStmtIntoOption
 	{
-		// See https://dev.mysql.com/doc/refman/5.7/en/cast-functions.html#function_convert
-		tp := $5.(*types.FieldType)
-		defaultFlen, defaultDecimal := mysql.GetDefaultFieldLengthAndDecimalForCast(tp.Tp)
-		if tp.Flen == types.UnspecifiedLength {
-			tp.Flen = defaultFlen
+		st := newValuesSelectStmt($2.([]*ast.RowExpr))
+		if $3 != nil {
+			st.OrderBy = $3.(*ast.OrderByClause)
 		}
-		if tp.Decimal == types.UnspecifiedLength {
-			tp.Decimal = defaultDecimal
+		if $4 != nil {
+			st.Limit = $4.(*ast.Limit)
 		}
-		explicitCharset := parser.explicitCharset
-		parser.explicitCharset = false
-		$$ = &ast.FuncCastExpr{
-			Expr:            $3,
-			Tp:              tp,
-			FunctionType:    ast.CastConvertFunction,
-			ExplicitCharSet: explicitCharset,
+		if $5 != nil {
+			st.LockInfo = $5.(*ast.SelectLockInfo)
 		}
-	}
-|	"CONVERT" '(' Expression "USING" CharsetName ')'
-	{
-		// See https://dev.mysql.com/doc/refman/5.7/en/cast-functions.html#function_convert
-		charset1 := ast.NewValueExpr($5, "", "")
-		$$ = &ast.FuncCallExpr{
-			FnName: model.NewCIStr($1),
-			Args:   []ast.ExprNode{$3, charset1},
+		if $6 != nil {
+			st.SelectIntoOpt = $6.(*ast.SelectIntoOption)
 		}
+		$$ = st
 	}
-|	"DEFAULT" '(' SimpleIdent ')'
+
+SelectStmtWithClause:
+	WithClause SelectStmt
 	{
-		$$ = &ast.DefaultExpr{Name: $3.(*ast.ColumnNameExpr).Name}
+		sel := $2.(*ast.SelectStmt)
+		sel.With = $1.(*ast.WithClause)
+		$$ = sel
 	}
-|	"VALUES" '(' SimpleIdent ')' %prec lowerThanInsertValues
+|	WithClause SubSelect
 	{
-		$$ = &ast.ValuesExpr{Column: $3.(*ast.ColumnNameExpr)}
+		var sel ast.StmtNode
+		switch x := $2.(*ast.SubqueryExpr).Query.(type) {
+		case *ast.SelectStmt:
+			x.IsInBraces = true
+			x.WithBeforeBraces = true
+			x.With = $1.(*ast.WithClause)
+			sel = x
+		case *ast.SetOprStmt:
+			x.IsInBraces = true
+			x.With = $1.(*ast.WithClause)
+			sel = x
+		}
+		$$ = sel
 	}
-|	SimpleIdent jss stringLit
+
+WithClause:
+	"WITH" WithList
 	{
-		expr := ast.NewValueExpr($3, parser.charset, parser.collation)
-		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr(ast.JSONExtract), Args: []ast.ExprNode{$1, expr}}
+		$$ = $2
 	}
-|	SimpleIdent juss stringLit
+|	"WITH" recursive WithList
 	{
-		expr := ast.NewValueExpr($3, parser.charset, parser.collation)
-		extract := &ast.FuncCallExpr{FnName: model.NewCIStr(ast.JSONExtract), Args: []ast.ExprNode{$1, expr}}
-		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr(ast.JSONUnquote), Args: []ast.ExprNode{extract}}
+		ws := $3.(*ast.WithClause)
+		ws.IsRecursive = true
+		$$ = ws
 	}
 
-DistinctKwd:
-	"DISTINCT"
-|	"DISTINCTROW"
-
-DistinctOpt:
-	"ALL"
+WithList:
+	WithList ',' CommonTableExpr
 	{
-		$$ = false
+		ws := $1.(*ast.WithClause)
+		ws.CTEs = append(ws.CTEs, $3.(*ast.CommonTableExpression))
+		$$ = ws
 	}
-|	DistinctKwd
+|	CommonTableExpr
 	{
-		$$ = true
+		ws := &ast.WithClause{}
+		ws.CTEs = make([]*ast.CommonTableExpression, 0, 4)
+		ws.CTEs = append(ws.CTEs, $1.(*ast.CommonTableExpression))
+		$$ = ws
 	}
 
-DefaultFalseDistinctOpt:
+CommonTableExpr:
+	Identifier IdentListWithParenOpt "AS" SubSelect
 	{
-		$$ = false
+		cte := &ast.CommonTableExpression{}
+		cte.Name = model.NewCIStr($1)
+		cte.ColNameList = $2.([]model.CIStr)
+		cte.Query = $4.(*ast.SubqueryExpr)
+		$$ = cte
 	}
-|	DistinctOpt
 
-DefaultTrueDistinctOpt:
+FromDual:
+	"FROM" "DUAL"
+
+WindowClauseOptional:
 	{
-		$$ = true
+		$$ = nil
 	}
-|	DistinctOpt
-
-BuggyDefaultFalseDistinctOpt:
-	DefaultFalseDistinctOpt
-|	DistinctKwd "ALL"
+|	"WINDOW" WindowDefinitionList
 	{
-		$$ = true
+		$$ = $2.([]ast.WindowSpec)
 	}
 
-FunctionNameConflict:
-	"ASCII"
-|	"CHARSET"
-|	"COALESCE"
-|	"COLLATION"
-|	"DATE"
-|	"DATABASE"
-|	"DAY"
-|	"HOUR"
-|	"IF"
-|	"INTERVAL"
-|	"FORMAT"
-|	"LEFT"
-|	"MICROSECOND"
-|	"MINUTE"
-|	"MONTH"
-|	builtinNow
-|	"QUARTER"
-|	"REPEAT"
-|	"REPLACE"
-|	"REVERSE"
-|	"RIGHT"
-|	"ROW_COUNT"
-|	"SECOND"
-|	"TIME"
-|	"TIMESTAMP"
-|	"TRUNCATE"
-|	"USER"
-|	"WEEK"
-|	"YEAR"
-
-OptionalBraces:
-	{}
-|	'(' ')'
-	{}
-
-FunctionNameOptionalBraces:
-	"CURRENT_USER"
-|	"CURRENT_DATE"
-|	"CURRENT_ROLE"
-|	"UTC_DATE"
-
-FunctionNameDatetimePrecision:
-	"CURRENT_TIME"
-|	"CURRENT_TIMESTAMP"
-|	"LOCALTIME"
-|	"LOCALTIMESTAMP"
-|	"UTC_TIME"
-|	"UTC_TIMESTAMP"
-
-FunctionCallKeyword:
-	FunctionNameConflict '(' ExpressionListOpt ')'
+WindowDefinitionList:
+	WindowDefinition
 	{
-		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr($1), Args: $3.([]ast.ExprNode)}
+		$$ = []ast.WindowSpec{$1.(ast.WindowSpec)}
 	}
-|	builtinUser '(' ExpressionListOpt ')'
+|	WindowDefinitionList ',' WindowDefinition
 	{
-		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr($1), Args: $3.([]ast.ExprNode)}
+		$$ = append($1.([]ast.WindowSpec), $3.(ast.WindowSpec))
 	}
-|	FunctionNameOptionalBraces OptionalBraces
+
+WindowDefinition:
+	WindowName "AS" WindowSpec
 	{
-		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr($1)}
+		var spec = $3.(ast.WindowSpec)
+		spec.Name = $1.(model.CIStr)
+		$$ = spec
 	}
-|	builtinCurDate '(' ')'
+
+WindowName:
+	Identifier
 	{
-		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr($1)}
+		$$ = model.NewCIStr($1)
 	}
-|	FunctionNameDatetimePrecision FuncDatetimePrec
+
+WindowSpec:
+	'(' WindowSpecDetails ')'
 	{
-		args := []ast.ExprNode{}
-		if $2 != nil {
-			args = append(args, $2.(ast.ExprNode))
-		}
-		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr($1), Args: args}
+		$$ = $2.(ast.WindowSpec)
 	}
-|	"CHAR" '(' ExpressionList ')'
+
+WindowSpecDetails:
+	OptExistingWindowName OptPartitionClause OptWindowOrderByClause OptWindowFrameClause
 	{
-		nilVal := ast.NewValueExpr(nil, parser.charset, parser.collation)
-		args := $3.([]ast.ExprNode)
-		$$ = &ast.FuncCallExpr{
-			FnName: model.NewCIStr(ast.CharFunc),
-			Args:   append(args, nilVal),
+		spec := ast.WindowSpec{Ref: $1.(model.CIStr)}
+		if $2 != nil {
+			spec.PartitionBy = $2.(*ast.PartitionByClause)
 		}
-	}
-|	"CHAR" '(' ExpressionList "USING" CharsetName ')'
-	{
-		charset1 := ast.NewValueExpr($5, "", "")
-		args := $3.([]ast.ExprNode)
-		$$ = &ast.FuncCallExpr{
-			FnName: model.NewCIStr(ast.CharFunc),
-			Args:   append(args, charset1),
+		if $3 != nil {
+			spec.OrderBy = $3.(*ast.OrderByClause)
 		}
+		if $4 != nil {
+			spec.Frame = $4.(*ast.FrameClause)
+		}
+		$$ = spec
 	}
-|	"DATE" stringLit
-	{
-		expr := ast.NewValueExpr($2, "", "")
-		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr(ast.DateLiteral), Args: []ast.ExprNode{expr}}
-	}
-|	"TIME" stringLit
-	{
-		expr := ast.NewValueExpr($2, "", "")
-		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr(ast.TimeLiteral), Args: []ast.ExprNode{expr}}
-	}
-|	"TIMESTAMP" stringLit
-	{
-		expr := ast.NewValueExpr($2, "", "")
-		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr(ast.TimestampLiteral), Args: []ast.ExprNode{expr}}
-	}
-|	"INSERT" '(' ExpressionListOpt ')'
-	{
-		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr(ast.InsertFunc), Args: $3.([]ast.ExprNode)}
-	}
-|	"MOD" '(' BitExpr ',' BitExpr ')'
-	{
-		$$ = &ast.BinaryOperationExpr{Op: opcode.Mod, L: $3, R: $5}
-	}
-|	"PASSWORD" '(' ExpressionListOpt ')'
+
+OptExistingWindowName:
 	{
-		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr(ast.PasswordFunc), Args: $3.([]ast.ExprNode)}
+		$$ = model.CIStr{}
 	}
+|	WindowName
 
-FunctionCallNonKeyword:
-	builtinCurTime '(' FuncDatetimePrecListOpt ')'
+OptPartitionClause:
 	{
-		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr($1), Args: $3.([]ast.ExprNode)}
+		$$ = nil
 	}
-|	builtinSysDate '(' FuncDatetimePrecListOpt ')'
+|	"PARTITION" "BY" ByList
 	{
-		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr($1), Args: $3.([]ast.ExprNode)}
+		$$ = &ast.PartitionByClause{Items: $3.([]*ast.ByItem)}
 	}
-|	FunctionNameDateArithMultiForms '(' Expression ',' Expression ')'
+
+OptWindowOrderByClause:
 	{
-		$$ = &ast.FuncCallExpr{
-			FnName: model.NewCIStr($1),
-			Args: []ast.ExprNode{
-				$3,
-				$5,
-				&ast.TimeUnitExpr{Unit: ast.TimeUnitDay},
-			},
-		}
+		$$ = nil
 	}
-|	FunctionNameDateArithMultiForms '(' Expression ',' "INTERVAL" Expression TimeUnit ')'
+|	"ORDER" "BY" ByList
 	{
-		$$ = &ast.FuncCallExpr{
-			FnName: model.NewCIStr($1),
-			Args: []ast.ExprNode{
-				$3,
-				$6,
-				&ast.TimeUnitExpr{Unit: $7.(ast.TimeUnitType)},
-			},
-		}
+		$$ = &ast.OrderByClause{Items: $3.([]*ast.ByItem)}
 	}
-|	FunctionNameDateArith '(' Expression ',' "INTERVAL" Expression TimeUnit ')'
+
+OptWindowFrameClause:
 	{
-		$$ = &ast.FuncCallExpr{
-			FnName: model.NewCIStr($1),
-			Args: []ast.ExprNode{
-				$3,
-				$6,
-				&ast.TimeUnitExpr{Unit: $7.(ast.TimeUnitType)},
-			},
-		}
+		$$ = nil
 	}
-|	builtinExtract '(' TimeUnit "FROM" Expression ')'
+|	WindowFrameUnits WindowFrameExtent
 	{
-		timeUnit := &ast.TimeUnitExpr{Unit: $3.(ast.TimeUnitType)}
-		$$ = &ast.FuncCallExpr{
-			FnName: model.NewCIStr($1),
-			Args:   []ast.ExprNode{timeUnit, $5},
+		$$ = &ast.FrameClause{
+			Type:   $1.(ast.FrameType),
+			Extent: $2.(ast.FrameExtent),
 		}
 	}
-|	"GET_FORMAT" '(' GetFormatSelector ',' Expression ')'
+
+WindowFrameUnits:
+	"ROWS"
 	{
-		$$ = &ast.FuncCallExpr{
-			FnName: model.NewCIStr($1),
-			Args: []ast.ExprNode{
-				&ast.GetFormatSelectorExpr{Selector: $3.(ast.GetFormatSelectorType)},
-				$5,
-			},
-		}
+		$$ = ast.FrameType(ast.Rows)
 	}
-|	builtinPosition '(' BitExpr "IN" Expression ')'
+|	"RANGE"
 	{
-		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr($1), Args: []ast.ExprNode{$3, $5}}
+		$$ = ast.FrameType(ast.Ranges)
 	}
-|	builtinSubstring '(' Expression ',' Expression ')'
+|	"GROUPS"
 	{
-		$$ = &ast.FuncCallExpr{
-			FnName: model.NewCIStr($1),
-			Args:   []ast.ExprNode{$3, $5},
-		}
+		$$ = ast.FrameType(ast.Groups)
 	}
-|	builtinSubstring '(' Expression "FROM" Expression ')'
+
+WindowFrameExtent:
+	WindowFrameStart
 	{
-		$$ = &ast.FuncCallExpr{
-			FnName: model.NewCIStr($1),
-			Args:   []ast.ExprNode{$3, $5},
+		$$ = ast.FrameExtent{
+			Start: $1.(ast.FrameBound),
+			End:   ast.FrameBound{Type: ast.CurrentRow},
 		}
 	}
-|	builtinSubstring '(' Expression ',' Expression ',' Expression ')'
+|	WindowFrameBetween
+
+WindowFrameStart:
+	"UNBOUNDED" "PRECEDING"
 	{
-		$$ = &ast.FuncCallExpr{
-			FnName: model.NewCIStr($1),
-			Args:   []ast.ExprNode{$3, $5, $7},
-		}
+		$$ = ast.FrameBound{Type: ast.Preceding, UnBounded: true}
 	}
-|	builtinSubstring '(' Expression "FROM" Expression "FOR" Expression ')'
+|	NumLiteral "PRECEDING"
 	{
-		$$ = &ast.FuncCallExpr{
-			FnName: model.NewCIStr($1),
-			Args:   []ast.ExprNode{$3, $5, $7},
-		}
+		$$ = ast.FrameBound{Type: ast.Preceding, Expr: ast.NewValueExpr($1, parser.charset, parser.collation)}
 	}
-|	"TIMESTAMPADD" '(' TimestampUnit ',' Expression ',' Expression ')'
+|	paramMarker "PRECEDING"
 	{
-		$$ = &ast.FuncCallExpr{
-			FnName: model.NewCIStr($1),
-			Args:   []ast.ExprNode{&ast.TimeUnitExpr{Unit: $3.(ast.TimeUnitType)}, $5, $7},
-		}
+		$$ = ast.FrameBound{Type: ast.Preceding, Expr: ast.NewParamMarkerExpr(yyS[yypt].offset)}
 	}
-|	"TIMESTAMPDIFF" '(' TimestampUnit ',' Expression ',' Expression ')'
+|	"INTERVAL" Expression TimeUnit "PRECEDING"
 	{
-		$$ = &ast.FuncCallExpr{
-			FnName: model.NewCIStr($1),
-			Args:   []ast.ExprNode{&ast.TimeUnitExpr{Unit: $3.(ast.TimeUnitType)}, $5, $7},
-		}
+		$$ = ast.FrameBound{Type: ast.Preceding, Expr: $2, Unit: $3.(ast.TimeUnitType)}
 	}
-|	builtinTrim '(' Expression ')'
+|	"CURRENT" "ROW"
 	{
-		$$ = &ast.FuncCallExpr{
-			FnName: model.NewCIStr($1),
-			Args:   []ast.ExprNode{$3},
-		}
+		$$ = ast.FrameBound{Type: ast.CurrentRow}
 	}
-|	builtinTrim '(' Expression "FROM" Expression ')'
+
+WindowFrameBetween:
+	"BETWEEN" WindowFrameBound "AND" WindowFrameBound
 	{
-		$$ = &ast.FuncCallExpr{
-			FnName: model.NewCIStr($1),
-			Args:   []ast.ExprNode{$5, $3},
-		}
+		$$ = ast.FrameExtent{Start: $2.(ast.FrameBound), End: $4.(ast.FrameBound)}
 	}
-|	builtinTrim '(' TrimDirection "FROM" Expression ')'
+
+WindowFrameBound:
+	WindowFrameStart
+|	"UNBOUNDED" "FOLLOWING"
 	{
-		spaceVal := ast.NewValueExpr(" ", parser.charset, parser.collation)
-		direction := &ast.TrimDirectionExpr{Direction: $3.(ast.TrimDirectionType)}
-		$$ = &ast.FuncCallExpr{
-			FnName: model.NewCIStr($1),
-			Args:   []ast.ExprNode{$5, spaceVal, direction},
-		}
+		$$ = ast.FrameBound{Type: ast.Following, UnBounded: true}
 	}
-|	builtinTrim '(' TrimDirection Expression "FROM" Expression ')'
+|	NumLiteral "FOLLOWING"
 	{
-		direction := &ast.TrimDirectionExpr{Direction: $3.(ast.TrimDirectionType)}
-		$$ = &ast.FuncCallExpr{
-			FnName: model.NewCIStr($1),
-			Args:   []ast.ExprNode{$6, $4, direction},
-		}
+		$$ = ast.FrameBound{Type: ast.Following, Expr: ast.NewValueExpr($1, parser.charset, parser.collation)}
 	}
-|	weightString '(' Expression ')'
+|	paramMarker "FOLLOWING"
 	{
-		$$ = &ast.FuncCallExpr{
-			FnName: model.NewCIStr($1),
-			Args:   []ast.ExprNode{$3},
-		}
+		$$ = ast.FrameBound{Type: ast.Following, Expr: ast.NewParamMarkerExpr(yyS[yypt].offset)}
 	}
-|	weightString '(' Expression "AS" Char FieldLen ')'
+|	"INTERVAL" Expression TimeUnit "FOLLOWING"
 	{
-		$$ = &ast.FuncCallExpr{
-			FnName: model.NewCIStr($1),
-			Args:   []ast.ExprNode{$3, ast.NewValueExpr("CHAR", parser.charset, parser.collation), ast.NewValueExpr($6, parser.charset, parser.collation)},
-		}
+		$$ = ast.FrameBound{Type: ast.Following, Expr: $2, Unit: $3.(ast.TimeUnitType)}
 	}
-|	weightString '(' Expression "AS" "BINARY" FieldLen ')'
+
+OptWindowingClause:
 	{
-		$$ = &ast.FuncCallExpr{
-			FnName: model.NewCIStr($1),
-			Args:   []ast.ExprNode{$3, ast.NewValueExpr("BINARY", parser.charset, parser.collation), ast.NewValueExpr($6, parser.charset, parser.collation)},
-		}
+		$$ = nil
 	}
-|	FunctionNameSequence
-|	builtinTranslate '(' Expression ',' Expression ',' Expression ')'
+|	WindowingClause
 	{
-		$$ = &ast.FuncCallExpr{
-			FnName: model.NewCIStr($1),
-			Args:   []ast.ExprNode{$3, $5, $7},
-		}
+		spec := $1.(ast.WindowSpec)
+		$$ = &spec
 	}
 
-GetFormatSelector:
-	"DATE"
+WindowingClause:
+	"OVER" WindowNameOrSpec
 	{
-		$$ = ast.GetFormatSelectorDate
+		$$ = $2.(ast.WindowSpec)
 	}
-|	"DATETIME"
+
+WindowNameOrSpec:
+	WindowName
 	{
-		$$ = ast.GetFormatSelectorDatetime
+		$$ = ast.WindowSpec{Name: $1.(model.CIStr), OnlyAlias: true}
 	}
-|	"TIME"
+|	WindowSpec
+
+WindowFuncCall:
+	"ROW_NUMBER" '(' ')' WindowingClause
 	{
-		$$ = ast.GetFormatSelectorTime
+		$$ = &ast.WindowFuncExpr{F: $1, Spec: $4.(ast.WindowSpec)}
 	}
-|	"TIMESTAMP"
+|	"RANK" '(' ')' WindowingClause
 	{
-		$$ = ast.GetFormatSelectorDatetime
+		$$ = &ast.WindowFuncExpr{F: $1, Spec: $4.(ast.WindowSpec)}
 	}
-
-FunctionNameDateArith:
-	builtinDateAdd
-|	builtinDateSub
-
-FunctionNameDateArithMultiForms:
-	addDate
-|	subDate
-
-TrimDirection:
-	"BOTH"
+|	"DENSE_RANK" '(' ')' WindowingClause
 	{
-		$$ = ast.TrimBoth
+		$$ = &ast.WindowFuncExpr{F: $1, Spec: $4.(ast.WindowSpec)}
 	}
-|	"LEADING"
+|	"CUME_DIST" '(' ')' WindowingClause
 	{
-		$$ = ast.TrimLeading
+		$$ = &ast.WindowFuncExpr{F: $1, Spec: $4.(ast.WindowSpec)}
 	}
-|	"TRAILING"
+|	"PERCENT_RANK" '(' ')' WindowingClause
 	{
-		$$ = ast.TrimTrailing
+		$$ = &ast.WindowFuncExpr{F: $1, Spec: $4.(ast.WindowSpec)}
 	}
-
-FunctionNameSequence:
-	"LASTVAL" '(' TableName ')'
+|	"NTILE" '(' SimpleExpr ')' WindowingClause
 	{
-		objNameExpr := &ast.TableNameExpr{
-			Name: $3.(*ast.TableName),
-		}
-		$$ = &ast.FuncCallExpr{
-			FnName: model.NewCIStr(ast.LastVal),
-			Args:   []ast.ExprNode{objNameExpr},
-		}
+		$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$3}, Spec: $5.(ast.WindowSpec)}
 	}
-|	"SETVAL" '(' TableName ',' SignedNum ')'
+|	"LEAD" '(' Expression OptLeadLagInfo ')' OptNullTreatment WindowingClause
 	{
-		objNameExpr := &ast.TableNameExpr{
-			Name: $3.(*ast.TableName),
-		}
-		valueExpr := ast.NewValueExpr($5, parser.charset, parser.collation)
-		$$ = &ast.FuncCallExpr{
-			FnName: model.NewCIStr(ast.SetVal),
-			Args:   []ast.ExprNode{objNameExpr, valueExpr},
+		args := []ast.ExprNode{$3}
+		if $4 != nil {
+			args = append(args, $4.([]ast.ExprNode)...)
 		}
+		$$ = &ast.WindowFuncExpr{F: $1, Args: args, IgnoreNull: $6.(bool), Spec: $7.(ast.WindowSpec)}
 	}
-|	NextValueForSequence
-
-SumExpr:
-	"AVG" '(' BuggyDefaultFalseDistinctOpt Expression ')' OptWindowingClause
+|	"LAG" '(' Expression OptLeadLagInfo ')' OptNullTreatment WindowingClause
 	{
-		if $6 != nil {
-			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool), Spec: *($6.(*ast.WindowSpec))}
-		} else {
-			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool)}
+		args := []ast.ExprNode{$3}
+		if $4 != nil {
+			args = append(args, $4.([]ast.ExprNode)...)
 		}
+		$$ = &ast.WindowFuncExpr{F: $1, Args: args, IgnoreNull: $6.(bool), Spec: $7.(ast.WindowSpec)}
 	}
-|	builtinApproxCountDistinct '(' ExpressionList ')'
+|	"FIRST_VALUE" '(' Expression ')' OptNullTreatment WindowingClause
+	{
+		$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$3}, IgnoreNull: $5.(bool), Spec: $6.(ast.WindowSpec)}
+	}
+|	"LAST_VALUE" '(' Expression ')' OptNullTreatment WindowingClause
+	{
+		$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$3}, IgnoreNull: $5.(bool), Spec: $6.(ast.WindowSpec)}
+	}
+|	"NTH_VALUE" '(' Expression ',' SimpleExpr ')' OptFromFirstLast OptNullTreatment WindowingClause
 	{
-		$$ = &ast.AggregateFuncExpr{F: $1, Args: $3.([]ast.ExprNode), Distinct: false}
+		$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$3, $5}, FromLast: $7.(bool), IgnoreNull: $8.(bool), Spec: $9.(ast.WindowSpec)}
 	}
-|	builtinApproxPercentile '(' ExpressionList ')'
+
+OptLeadLagInfo:
 	{
-		$$ = &ast.AggregateFuncExpr{F: $1, Args: $3.([]ast.ExprNode)}
+		$$ = nil
 	}
-|	builtinBitAnd '(' Expression ')' OptWindowingClause
+|	',' NumLiteral OptLLDefault
 	{
-		if $5 != nil {
-			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$3}, Spec: *($5.(*ast.WindowSpec))}
-		} else {
-			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$3}}
+		args := []ast.ExprNode{ast.NewValueExpr($2, parser.charset, parser.collation)}
+		if $3 != nil {
+			args = append(args, $3.(ast.ExprNode))
 		}
+		$$ = args
 	}
-|	builtinBitAnd '(' "ALL" Expression ')' OptWindowingClause
+|	',' paramMarker OptLLDefault
 	{
-		if $6 != nil {
-			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Spec: *($6.(*ast.WindowSpec))}
-		} else {
-			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}}
+		args := []ast.ExprNode{ast.NewValueExpr($2, parser.charset, parser.collation)}
+		if $3 != nil {
+			args = append(args, $3.(ast.ExprNode))
 		}
+		$$ = args
 	}
-|	builtinBitOr '(' Expression ')' OptWindowingClause
+
+OptLLDefault:
 	{
-		if $5 != nil {
-			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$3}, Spec: *($5.(*ast.WindowSpec))}
-		} else {
-			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$3}}
-		}
+		$$ = nil
 	}
-|	builtinBitOr '(' "ALL" Expression ')' OptWindowingClause
+|	',' Expression
 	{
-		if $6 != nil {
-			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Spec: *($6.(*ast.WindowSpec))}
-		} else {
-			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}}
-		}
+		$$ = $2
 	}
-|	builtinBitXor '(' Expression ')' OptWindowingClause
+
+OptNullTreatment:
 	{
-		if $5 != nil {
-			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$3}, Spec: *($5.(*ast.WindowSpec))}
-		} else {
-			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$3}}
-		}
+		$$ = false
 	}
-|	builtinBitXor '(' "ALL" Expression ')' OptWindowingClause
+|	"RESPECT" "NULLS"
 	{
-		if $6 != nil {
-			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Spec: *($6.(*ast.WindowSpec))}
-		} else {
-			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}}
-		}
+		$$ = false
 	}
-|	builtinCount '(' DistinctKwd ExpressionList ')'
+|	"IGNORE" "NULLS"
 	{
-		$$ = &ast.AggregateFuncExpr{F: $1, Args: $4.([]ast.ExprNode), Distinct: true}
+		$$ = true
 	}
-|	builtinCount '(' "ALL" Expression ')' OptWindowingClause
+
+OptFromFirstLast:
 	{
-		if $6 != nil {
-			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Spec: *($6.(*ast.WindowSpec))}
-		} else {
-			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}}
-		}
+		$$ = false
 	}
-|	builtinCount '(' Expression ')' OptWindowingClause
+|	"FROM" "FIRST"
 	{
-		if $5 != nil {
-			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$3}, Spec: *($5.(*ast.WindowSpec))}
-		} else {
-			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$3}}
-		}
+		$$ = false
 	}
-|	builtinCount '(' '*' ')' OptWindowingClause
+|	"FROM" "LAST"
 	{
-		args := []ast.ExprNode{ast.NewValueExpr(1, parser.charset, parser.collation)}
-		if $5 != nil {
-			$$ = &ast.WindowFuncExpr{F: $1, Args: args, Spec: *($5.(*ast.WindowSpec))}
-		} else {
-			$$ = &ast.AggregateFuncExpr{F: $1, Args: args}
-		}
+		$$ = true
 	}
-|	builtinGroupConcat '(' BuggyDefaultFalseDistinctOpt ExpressionList OrderByOptional OptGConcatSeparator ')' OptWindowingClause
+
+TableRefsClause:
+	TableRefs
 	{
-		args := $4.([]ast.ExprNode)
-		args = append(args, $6.(ast.ExprNode))
-		if $8 != nil {
-			$$ = &ast.WindowFuncExpr{F: $1, Args: args, Distinct: $3.(bool), Spec: *($8.(*ast.WindowSpec))}
-		} else {
-			agg := &ast.AggregateFuncExpr{F: $1, Args: args, Distinct: $3.(bool)}
-			if $5 != nil {
-				agg.Order = $5.(*ast.OrderByClause)
-			}
-			$$ = agg
-		}
+		$$ = &ast.TableRefsClause{TableRefs: $1.(*ast.Join)}
 	}
-|	builtinMax '(' BuggyDefaultFalseDistinctOpt Expression ')' OptWindowingClause
+
+TableRefs:
+	EscapedTableRef
 	{
-		if $6 != nil {
-			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool), Spec: *($6.(*ast.WindowSpec))}
+		if j, ok := $1.(*ast.Join); ok {
+			// if $1 is Join, use it directly
+			$$ = j
 		} else {
-			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool)}
+			$$ = &ast.Join{Left: $1.(ast.ResultSetNode), Right: nil}
 		}
 	}
-|	builtinMin '(' BuggyDefaultFalseDistinctOpt Expression ')' OptWindowingClause
+|	TableRefs ',' EscapedTableRef
 	{
-		if $6 != nil {
-			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool), Spec: *($6.(*ast.WindowSpec))}
-		} else {
-			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool)}
-		}
+		/* from a, b is default cross join */
+		$$ = &ast.Join{Left: $1.(ast.ResultSetNode), Right: $3.(ast.ResultSetNode), Tp: ast.CrossJoin}
 	}
-|	builtinSum '(' BuggyDefaultFalseDistinctOpt Expression ')' OptWindowingClause
+
+EscapedTableRef:
+	TableRef %prec lowerThanSetKeyword
+|	'{' Identifier TableRef '}'
 	{
-		if $6 != nil {
-			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool), Spec: *($6.(*ast.WindowSpec))}
-		} else {
-			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool)}
-		}
+		/*
+		 * ODBC escape syntax for outer join is { OJ join_table }
+		 * Use an Identifier for OJ
+		 */
+		$$ = $3
 	}
-|	builtinStddevPop '(' BuggyDefaultFalseDistinctOpt Expression ')' OptWindowingClause
+
+TableRef:
+	TableFactor
+|	JoinTable
+
+TableFactor:
+	TableName PartitionNameListOpt TableAsNameOpt AsOfClauseOpt IndexHintListOpt TableSampleOpt
 	{
+		tn := $1.(*ast.TableName)
+		tn.PartitionNames = $2.([]model.CIStr)
+		tn.IndexHints = $5.([]*ast.IndexHint)
 		if $6 != nil {
-			$$ = &ast.WindowFuncExpr{F: ast.AggFuncStddevPop, Args: []ast.ExprNode{$4}, Distinct: $3.(bool), Spec: *($6.(*ast.WindowSpec))}
-		} else {
-			$$ = &ast.AggregateFuncExpr{F: ast.AggFuncStddevPop, Args: []ast.ExprNode{$4}, Distinct: $3.(bool)}
+			tn.TableSample = $6.(*ast.TableSample)
+		}
+		if $4 != nil {
+			tn.AsOf = $4.(*ast.AsOfClause)
 		}
+		$$ = &ast.TableSource{Source: tn, AsName: $3.(model.CIStr)}
 	}
-|	builtinStddevSamp '(' BuggyDefaultFalseDistinctOpt Expression ')' OptWindowingClause
+|	SubSelect TableAsNameOpt
 	{
-		if $6 != nil {
-			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool), Spec: *($6.(*ast.WindowSpec))}
-		} else {
-			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool)}
-		}
+		resultNode := $1.(*ast.SubqueryExpr).Query
+		$$ = &ast.TableSource{Source: resultNode, AsName: $2.(model.CIStr)}
 	}
-|	builtinVarPop '(' BuggyDefaultFalseDistinctOpt Expression ')' OptWindowingClause
+|	"LATERAL" SubSelect TableAsName
 	{
-		if $6 != nil {
-			$$ = &ast.WindowFuncExpr{F: ast.AggFuncVarPop, Args: []ast.ExprNode{$4}, Distinct: $3.(bool), Spec: *($6.(*ast.WindowSpec))}
-		} else {
-			$$ = &ast.AggregateFuncExpr{F: ast.AggFuncVarPop, Args: []ast.ExprNode{$4}, Distinct: $3.(bool)}
-		}
+		resultNode := $2.(*ast.SubqueryExpr).Query
+		$$ = &ast.TableSource{Source: resultNode, AsName: $3.(model.CIStr), Lateral: true}
 	}
-|	builtinVarSamp '(' BuggyDefaultFalseDistinctOpt Expression ')' OptWindowingClause
+|	'(' TableRefs ')'
 	{
-		$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool)}
+		j := $2.(*ast.Join)
+		j.ExplicitParens = true
+		$$ = $2
 	}
-|	"JSON_ARRAYAGG" '(' Expression ')' OptWindowingClause
+|	JSONTable TableAsName
 	{
-		if $5 != nil {
-			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$3}, Spec: *($5.(*ast.WindowSpec))}
-		} else {
-			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$3}}
-		}
+		$$ = &ast.TableSource{Source: $1.(*ast.JSONTable), AsName: $2.(model.CIStr)}
 	}
-|	"JSON_ARRAYAGG" '(' "ALL" Expression ')' OptWindowingClause
+
+/*******************************************************************
+ *
+ *  JSON_TABLE table function
+ *
+ *  See https://dev.mysql.com/doc/refman/8.0/en/json-table-functions.html
+ *******************************************************************/
+JSONTable:
+	"JSON_TABLE" '(' Expression ',' stringLit JSONTableColumns ')'
 	{
-		if $6 != nil {
-			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Spec: *($6.(*ast.WindowSpec))}
-		} else {
-			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}}
-		}
+		$$ = &ast.JSONTable{Expr: $3, Path: $5, Columns: $6.([]*ast.JSONTableColumn)}
 	}
-|	"JSON_OBJECTAGG" '(' Expression ',' Expression ')' OptWindowingClause
+
+JSONTableColumns:
+	"COLUMNS" '(' JSONTableColumnList ')'
 	{
-		if $7 != nil {
-			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$3, $5}, Spec: *($7.(*ast.WindowSpec))}
-		} else {
-			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$3, $5}}
-		}
+		$$ = $3
 	}
-|	"JSON_OBJECTAGG" '(' "ALL" Expression ',' Expression ')' OptWindowingClause
+
+JSONTableColumnList:
+	JSONTableColumn
 	{
-		if $8 != nil {
-			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$4, $6}, Spec: *($8.(*ast.WindowSpec))}
-		} else {
-			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4, $6}}
-		}
+		$$ = []*ast.JSONTableColumn{$1.(*ast.JSONTableColumn)}
 	}
-|	"JSON_OBJECTAGG" '(' Expression ',' "ALL" Expression ')' OptWindowingClause
+|	JSONTableColumnList ',' JSONTableColumn
 	{
-		if $8 != nil {
-			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$3, $6}, Spec: *($8.(*ast.WindowSpec))}
-		} else {
-			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$3, $6}}
-		}
+		$$ = append($1.([]*ast.JSONTableColumn), $3.(*ast.JSONTableColumn))
 	}
-|	"JSON_OBJECTAGG" '(' "ALL" Expression ',' "ALL" Expression ')' OptWindowingClause
+
+JSONTableColumn:
+	Identifier "FOR" "ORDINALITY"
 	{
-		if $9 != nil {
-			$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$4, $7}, Spec: *($9.(*ast.WindowSpec))}
-		} else {
-			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4, $7}}
+		$$ = &ast.JSONTableColumn{Tp: ast.JSONTableColumnOrdinality, Name: model.NewCIStr($1)}
+	}
+|	Identifier Type "PATH" stringLit JSONTableOnEmptyOnErrorOpt
+	{
+		responses := $5.([]*ast.JSONTableResponse)
+		$$ = &ast.JSONTableColumn{
+			Tp:        ast.JSONTableColumnPath,
+			Name:      model.NewCIStr($1),
+			FieldType: $2.(*types.FieldType),
+			Path:      $4,
+			OnEmpty:   responses[0],
+			OnError:   responses[1],
 		}
 	}
-
-OptGConcatSeparator:
+|	Identifier Type "EXISTS" "PATH" stringLit
 	{
-		$$ = ast.NewValueExpr(",", "", "")
+		$$ = &ast.JSONTableColumn{Tp: ast.JSONTableColumnExistsPath, Name: model.NewCIStr($1), FieldType: $2.(*types.FieldType), Path: $5}
 	}
-|	"SEPARATOR" stringLit
+|	"NESTED" stringLit JSONTableColumns
 	{
-		$$ = ast.NewValueExpr($2, "", "")
+		$$ = &ast.JSONTableColumn{Tp: ast.JSONTableColumnNested, Path: $2, Columns: $3.([]*ast.JSONTableColumn)}
+	}
+|	"NESTED" "PATH" stringLit JSONTableColumns
+	{
+		$$ = &ast.JSONTableColumn{Tp: ast.JSONTableColumnNested, Path: $3, Columns: $4.([]*ast.JSONTableColumn)}
 	}
 
-FunctionCallGeneric:
-	identifier '(' ExpressionListOpt ')'
+JSONTableOnEmptyOnErrorOpt:
 	{
-		$$ = &ast.FuncCallExpr{
-			FnName: model.NewCIStr($1),
-			Args:   $3.([]ast.ExprNode),
-		}
+		$$ = []*ast.JSONTableResponse{nil, nil}
 	}
-|	Identifier '.' Identifier '(' ExpressionListOpt ')'
+|	JSONTableResponse "ON" "EMPTY"
 	{
-		var tp ast.FuncCallExprType
-		if isInTokenMap($3) {
-			tp = ast.FuncCallExprTypeKeyword
-		} else {
-			tp = ast.FuncCallExprTypeGeneric
-		}
-		$$ = &ast.FuncCallExpr{
-			Tp:     tp,
-			Schema: model.NewCIStr($1),
-			FnName: model.NewCIStr($3),
-			Args:   $5.([]ast.ExprNode),
-		}
+		$$ = []*ast.JSONTableResponse{$1.(*ast.JSONTableResponse), nil}
+	}
+|	JSONTableResponse "ON" "ERROR"
+	{
+		$$ = []*ast.JSONTableResponse{nil, $1.(*ast.JSONTableResponse)}
+	}
+|	JSONTableResponse "ON" "EMPTY" JSONTableResponse "ON" "ERROR"
+	{
+		$$ = []*ast.JSONTableResponse{$1.(*ast.JSONTableResponse), $4.(*ast.JSONTableResponse)}
+	}
+|	JSONTableResponse "ON" "ERROR" JSONTableResponse "ON" "EMPTY"
+	{
+		$$ = []*ast.JSONTableResponse{$4.(*ast.JSONTableResponse), $1.(*ast.JSONTableResponse)}
 	}
 
-FuncDatetimePrec:
+JSONTableResponse:
+	"NULL"
 	{
-		$$ = nil
+		$$ = &ast.JSONTableResponse{Tp: ast.JSONTableResponseNull}
 	}
-|	'(' ')'
+|	"ERROR"
 	{
-		$$ = nil
+		$$ = &ast.JSONTableResponse{Tp: ast.JSONTableResponseError}
 	}
-|	'(' intLit ')'
+|	"DEFAULT" SignedLiteral
 	{
-		expr := ast.NewValueExpr($2, parser.charset, parser.collation)
-		$$ = expr
+		$$ = &ast.JSONTableResponse{Tp: ast.JSONTableResponseDefault, Default: $2}
 	}
 
-TimeUnit:
-	TimestampUnit
-|	"SECOND_MICROSECOND"
+PartitionNameListOpt:
+	/* empty */
 	{
-		$$ = ast.TimeUnitSecondMicrosecond
+		$$ = []model.CIStr{}
 	}
-|	"MINUTE_MICROSECOND"
+|	"PARTITION" '(' PartitionNameList ')'
 	{
-		$$ = ast.TimeUnitMinuteMicrosecond
+		$$ = $3
 	}
-|	"MINUTE_SECOND"
+
+TableAsNameOpt:
+	%prec empty
 	{
-		$$ = ast.TimeUnitMinuteSecond
+		$$ = model.CIStr{}
 	}
-|	"HOUR_MICROSECOND"
+|	TableAsName
+
+TableAsName:
+	Identifier
 	{
-		$$ = ast.TimeUnitHourMicrosecond
+		$$ = model.NewCIStr($1)
 	}
-|	"HOUR_SECOND"
+|	"AS" Identifier
 	{
-		$$ = ast.TimeUnitHourSecond
+		$$ = model.NewCIStr($2)
 	}
-|	"HOUR_MINUTE"
+
+IndexHintType:
+	"USE" KeyOrIndex
 	{
-		$$ = ast.TimeUnitHourMinute
+		$$ = ast.HintUse
 	}
-|	"DAY_MICROSECOND"
+|	"IGNORE" KeyOrIndex
 	{
-		$$ = ast.TimeUnitDayMicrosecond
+		$$ = ast.HintIgnore
 	}
-|	"DAY_SECOND"
+|	"FORCE" KeyOrIndex
 	{
-		$$ = ast.TimeUnitDaySecond
+		$$ = ast.HintForce
 	}
-|	"DAY_MINUTE"
+
+IndexHintScope:
 	{
-		$$ = ast.TimeUnitDayMinute
+		$$ = ast.HintForScan
 	}
-|	"DAY_HOUR"
+|	"FOR" "JOIN"
 	{
-		$$ = ast.TimeUnitDayHour
+		$$ = ast.HintForJoin
 	}
-|	"YEAR_MONTH"
+|	"FOR" "ORDER" "BY"
 	{
-		$$ = ast.TimeUnitYearMonth
+		$$ = ast.HintForOrderBy
+	}
+|	"FOR" "GROUP" "BY"
+	{
+		$$ = ast.HintForGroupBy
 	}
 
-TimestampUnit:
-	"MICROSECOND"
+IndexHint:
+	IndexHintType IndexHintScope '(' IndexNameList ')'
 	{
-		$$ = ast.TimeUnitMicrosecond
+		$$ = &ast.IndexHint{
+			IndexNames: $4.([]model.CIStr),
+			HintType:   $1.(ast.IndexHintType),
+			HintScope:  $2.(ast.IndexHintScope),
+		}
 	}
-|	"SECOND"
+
+IndexNameList:
 	{
-		$$ = ast.TimeUnitSecond
+		var nameList []model.CIStr
+		$$ = nameList
 	}
-|	"MINUTE"
+|	Identifier
 	{
-		$$ = ast.TimeUnitMinute
+		$$ = []model.CIStr{model.NewCIStr($1)}
 	}
-|	"HOUR"
+|	IndexNameList ',' Identifier
 	{
-		$$ = ast.TimeUnitHour
+		$$ = append($1.([]model.CIStr), model.NewCIStr($3))
 	}
-|	"DAY"
+|	"PRIMARY"
 	{
-		$$ = ast.TimeUnitDay
+		$$ = []model.CIStr{model.NewCIStr($1)}
 	}
-|	"WEEK"
+|	IndexNameList ',' "PRIMARY"
 	{
-		$$ = ast.TimeUnitWeek
+		$$ = append($1.([]model.CIStr), model.NewCIStr($3))
 	}
-|	"MONTH"
+
+IndexHintList:
+	IndexHint
 	{
-		$$ = ast.TimeUnitMonth
+		$$ = []*ast.IndexHint{$1.(*ast.IndexHint)}
 	}
-|	"QUARTER"
+|	IndexHintList IndexHint
 	{
-		$$ = ast.TimeUnitQuarter
+		$$ = append($1.([]*ast.IndexHint), $2.(*ast.IndexHint))
 	}
-|	"YEAR"
+
+IndexHintListOpt:
 	{
-		$$ = ast.TimeUnitYear
+		$$ = []*ast.IndexHint{}
 	}
-|	"SQL_TSI_SECOND"
+|	IndexHintList
+
+JoinTable:
+	/* Use %prec to evaluate production TableRef before cross join */
+	TableRef CrossOpt TableRef %prec tableRefPriority
 	{
-		$$ = ast.TimeUnitSecond
+		$$ = ast.NewCrossJoin($1.(ast.ResultSetNode), $3.(ast.ResultSetNode))
 	}
-|	"SQL_TSI_MINUTE"
+|	TableRef CrossOpt TableRef "ON" Expression
 	{
-		$$ = ast.TimeUnitMinute
+		on := &ast.OnCondition{Expr: $5}
+		$$ = &ast.Join{Left: $1.(ast.ResultSetNode), Right: $3.(ast.ResultSetNode), Tp: ast.CrossJoin, On: on}
 	}
-|	"SQL_TSI_HOUR"
+|	TableRef CrossOpt TableRef "USING" '(' ColumnNameList ')'
 	{
-		$$ = ast.TimeUnitHour
+		$$ = &ast.Join{Left: $1.(ast.ResultSetNode), Right: $3.(ast.ResultSetNode), Tp: ast.CrossJoin, Using: $6.([]*ast.ColumnName)}
 	}
-|	"SQL_TSI_DAY"
+|	TableRef JoinType OuterOpt "JOIN" TableRef "ON" Expression
 	{
-		$$ = ast.TimeUnitDay
+		on := &ast.OnCondition{Expr: $7}
+		$$ = &ast.Join{Left: $1.(ast.ResultSetNode), Right: $5.(ast.ResultSetNode), Tp: $2.(ast.JoinType), On: on}
 	}
-|	"SQL_TSI_WEEK"
+|	TableRef JoinType OuterOpt "JOIN" TableRef "USING" '(' ColumnNameList ')'
 	{
-		$$ = ast.TimeUnitWeek
+		$$ = &ast.Join{Left: $1.(ast.ResultSetNode), Right: $5.(ast.ResultSetNode), Tp: $2.(ast.JoinType), Using: $8.([]*ast.ColumnName)}
 	}
-|	"SQL_TSI_MONTH"
+|	TableRef "NATURAL" "JOIN" TableRef
 	{
-		$$ = ast.TimeUnitMonth
+		$$ = &ast.Join{Left: $1.(ast.ResultSetNode), Right: $4.(ast.ResultSetNode), NaturalJoin: true}
 	}
-|	"SQL_TSI_QUARTER"
+|	TableRef "NATURAL" JoinType OuterOpt "JOIN" TableRef
 	{
-		$$ = ast.TimeUnitQuarter
+		$$ = &ast.Join{Left: $1.(ast.ResultSetNode), Right: $6.(ast.ResultSetNode), Tp: $3.(ast.JoinType), NaturalJoin: true}
 	}
-|	"SQL_TSI_YEAR"
+|	TableRef "STRAIGHT_JOIN" TableRef
 	{
-		$$ = ast.TimeUnitYear
+		$$ = &ast.Join{Left: $1.(ast.ResultSetNode), Right: $3.(ast.ResultSetNode), StraightJoin: true}
 	}
-
-ExpressionOpt:
+|	TableRef "STRAIGHT_JOIN" TableRef "ON" Expression
 	{
-		$$ = nil
+		on := &ast.OnCondition{Expr: $5}
+		$$ = &ast.Join{Left: $1.(ast.ResultSetNode), Right: $3.(ast.ResultSetNode), StraightJoin: true, On: on}
 	}
-|	Expression
 
-WhenClauseList:
-	WhenClause
+JoinType:
+	"LEFT"
 	{
-		$$ = []*ast.WhenClause{$1.(*ast.WhenClause)}
+		$$ = ast.LeftJoin
 	}
-|	WhenClauseList WhenClause
+|	"RIGHT"
 	{
-		$$ = append($1.([]*ast.WhenClause), $2.(*ast.WhenClause))
+		$$ = ast.RightJoin
 	}
 
-WhenClause:
-	"WHEN" Expression "THEN" Expression
+OuterOpt:
+	{}
+|	"OUTER"
+
+CrossOpt:
+	"JOIN"
+|	"CROSS" "JOIN"
+|	"INNER" "JOIN"
+
+LimitClause:
 	{
-		$$ = &ast.WhenClause{
-			Expr:   $2,
-			Result: $4,
-		}
+		$$ = nil
+	}
+|	"LIMIT" LimitOption
+	{
+		$$ = &ast.Limit{Count: $2.(ast.ValueExpr)}
 	}
 
-ElseOpt:
-	/* empty */
+LimitOption:
+	LengthNum
 	{
-		$$ = nil
+		$$ = ast.NewValueExpr($1, parser.charset, parser.collation)
 	}
-|	"ELSE" Expression
+|	paramMarker
 	{
-		$$ = $2
+		paramExpr := ast.NewParamMarkerExpr(yyS[yypt].offset)
+		paramExpr.SetOrder(parser.paramMarkerCursor)
+		parser.paramMarkerCursor++
+		$$ = paramExpr
 	}
 
-CastType:
-	"BINARY" OptFieldLen
+RowOrRows:
+	"ROW"
+|	"ROWS"
+
+FirstOrNext:
+	"FIRST"
+|	"NEXT"
+
+FetchFirstOpt:
 	{
-		x := types.NewFieldType(mysql.TypeVarString)
-		x.Flen = $2.(int) // TODO: Flen should be the flen of expression
-		if x.Flen != types.UnspecifiedLength {
-			x.Tp = mysql.TypeString
-		}
-		x.Charset = charset.CharsetBin
-		x.Collate = charset.CollationBin
-		x.Flag |= mysql.BinaryFlag
-		$$ = x
+		$$ = ast.NewValueExpr(uint64(1), parser.charset, parser.collation)
 	}
-|	Char OptFieldLen OptBinary
+|	LimitOption
+
+SelectStmtLimit:
+	"LIMIT" LimitOption
 	{
-		x := types.NewFieldType(mysql.TypeVarString)
-		x.Flen = $2.(int) // TODO: Flen should be the flen of expression
-		x.Charset = $3.(*ast.OptBinary).Charset
-		if $3.(*ast.OptBinary).IsBinary {
-			x.Flag |= mysql.BinaryFlag
-			x.Charset = charset.CharsetBin
-			x.Collate = charset.CollationBin
-		} else if x.Charset != "" {
-			co, err := charset.GetDefaultCollation(x.Charset)
-			if err != nil {
-				yylex.AppendError(yylex.Errorf("Get collation error for charset: %s", x.Charset))
-				return 1
-			}
-			x.Collate = co
-			parser.explicitCharset = true
-		} else {
-			x.Charset = parser.charset
-			x.Collate = parser.collation
-		}
-		$$ = x
+		$$ = &ast.Limit{Count: $2.(ast.ExprNode)}
+	}
+|	"LIMIT" LimitOption ',' LimitOption
+	{
+		$$ = &ast.Limit{Offset: $2.(ast.ExprNode), Count: $4.(ast.ExprNode)}
 	}
-|	"DATE"
+|	"LIMIT" LimitOption "OFFSET" LimitOption
 	{
-		x := types.NewFieldType(mysql.TypeDate)
-		x.Charset = charset.CharsetBin
-		x.Collate = charset.CollationBin
-		x.Flag |= mysql.BinaryFlag
-		$$ = x
+		$$ = &ast.Limit{Offset: $4.(ast.ExprNode), Count: $2.(ast.ExprNode)}
 	}
-|	"YEAR"
+|	"FETCH" FirstOrNext FetchFirstOpt RowOrRows "ONLY"
 	{
-		x := types.NewFieldType(mysql.TypeYear)
-		x.Charset = charset.CharsetBin
-		x.Collate = charset.CollationBin
-		x.Flag |= mysql.BinaryFlag
-		$$ = x
+		$$ = &ast.Limit{Count: $3.(ast.ExprNode)}
 	}
-|	"DATETIME" OptFieldLen
+
+SelectStmtLimitOpt:
 	{
-		x := types.NewFieldType(mysql.TypeDatetime)
-		x.Flen, _ = mysql.GetDefaultFieldLengthAndDecimalForCast(mysql.TypeDatetime)
-		x.Decimal = $2.(int)
-		if x.Decimal > 0 {
-			x.Flen = x.Flen + 1 + x.Decimal
-		}
-		x.Charset = charset.CharsetBin
-		x.Collate = charset.CollationBin
-		x.Flag |= mysql.BinaryFlag
-		$$ = x
+		$$ = nil
 	}
-|	"DECIMAL" FloatOpt
+|	SelectStmtLimit
+
+SelectStmtOpt:
+	TableOptimizerHints
 	{
-		fopt := $2.(*ast.FloatOpt)
-		x := types.NewFieldType(mysql.TypeNewDecimal)
-		x.Flen = fopt.Flen
-		x.Decimal = fopt.Decimal
-		x.Charset = charset.CharsetBin
-		x.Collate = charset.CollationBin
-		x.Flag |= mysql.BinaryFlag
-		$$ = x
+		opt := &ast.SelectStmtOpts{}
+		opt.SQLCache = true
+		opt.TableHints = $1.([]*ast.TableOptimizerHint)
+		$$ = opt
 	}
-|	"TIME" OptFieldLen
+|	DistinctOpt
 	{
-		x := types.NewFieldType(mysql.TypeDuration)
-		x.Flen, _ = mysql.GetDefaultFieldLengthAndDecimalForCast(mysql.TypeDuration)
-		x.Decimal = $2.(int)
-		if x.Decimal > 0 {
-			x.Flen = x.Flen + 1 + x.Decimal
+		opt := &ast.SelectStmtOpts{}
+		opt.SQLCache = true
+		if $1.(bool) {
+			opt.Distinct = true
+		} else {
+			opt.Distinct = false
+			opt.ExplicitAll = true
 		}
-		x.Charset = charset.CharsetBin
-		x.Collate = charset.CollationBin
-		x.Flag |= mysql.BinaryFlag
-		$$ = x
+		$$ = opt
 	}
-|	"SIGNED" OptInteger
+|	Priority
 	{
-		x := types.NewFieldType(mysql.TypeLonglong)
-		x.Charset = charset.CharsetBin
-		x.Collate = charset.CollationBin
-		x.Flag |= mysql.BinaryFlag
-		$$ = x
+		opt := &ast.SelectStmtOpts{}
+		opt.SQLCache = true
+		opt.Priority = $1.(mysql.PriorityEnum)
+		$$ = opt
 	}
-|	"UNSIGNED" OptInteger
+|	"SQL_SMALL_RESULT"
 	{
-		x := types.NewFieldType(mysql.TypeLonglong)
-		x.Flag |= mysql.UnsignedFlag | mysql.BinaryFlag
-		x.Charset = charset.CharsetBin
-		x.Collate = charset.CollationBin
-		$$ = x
+		opt := &ast.SelectStmtOpts{}
+		opt.SQLCache = true
+		opt.SQLSmallResult = true
+		$$ = opt
 	}
-|	"JSON"
+|	"SQL_BIG_RESULT"
 	{
-		x := types.NewFieldType(mysql.TypeJSON)
-		x.Flag |= mysql.BinaryFlag | (mysql.ParseToJSONFlag)
-		x.Charset = mysql.DefaultCharset
-		x.Collate = mysql.DefaultCollationName
-		$$ = x
+		opt := &ast.SelectStmtOpts{}
+		opt.SQLCache = true
+		opt.SQLBigResult = true
+		$$ = opt
 	}
-|	"DOUBLE"
+|	"SQL_BUFFER_RESULT"
 	{
-		x := types.NewFieldType(mysql.TypeDouble)
-		x.Flen, x.Decimal = mysql.GetDefaultFieldLengthAndDecimalForCast(mysql.TypeDouble)
-		x.Flag |= mysql.BinaryFlag
-		x.Charset = charset.CharsetBin
-		x.Collate = charset.CollationBin
-		$$ = x
+		opt := &ast.SelectStmtOpts{}
+		opt.SQLCache = true
+		opt.SQLBufferResult = true
+		$$ = opt
 	}
-|	"FLOAT" FloatOpt
+|	SelectStmtSQLCache
 	{
-		x := types.NewFieldType(mysql.TypeFloat)
-		fopt := $2.(*ast.FloatOpt)
-		if fopt.Flen >= 54 {
-			yylex.AppendError(ErrTooBigPrecision.GenWithStackByArgs(fopt.Flen, "CAST", 53))
-		} else if fopt.Flen >= 25 {
-			x = types.NewFieldType(mysql.TypeDouble)
-		}
-		x.Flen, x.Decimal = mysql.GetDefaultFieldLengthAndDecimalForCast(x.Tp)
-		x.Flag |= mysql.BinaryFlag
-		x.Charset = charset.CharsetBin
-		x.Collate = charset.CollationBin
-		$$ = x
+		opt := &ast.SelectStmtOpts{}
+		opt.SQLCache = $1.(bool)
+		$$ = opt
 	}
-|	"REAL"
+|	"SQL_CALC_FOUND_ROWS"
 	{
-		var x *types.FieldType
-		if parser.lexer.GetSQLMode().HasRealAsFloatMode() {
-			x = types.NewFieldType(mysql.TypeFloat)
-		} else {
-			x = types.NewFieldType(mysql.TypeDouble)
-		}
-		x.Flen, x.Decimal = mysql.GetDefaultFieldLengthAndDecimalForCast(x.Tp)
-		x.Flag |= mysql.BinaryFlag
-		x.Charset = charset.CharsetBin
-		x.Collate = charset.CollationBin
-		$$ = x
+		opt := &ast.SelectStmtOpts{}
+		opt.SQLCache = true
+		opt.CalcFoundRows = true
+		$$ = opt
 	}
-
-Priority:
-	"LOW_PRIORITY"
+|	"STRAIGHT_JOIN"
 	{
-		$$ = mysql.LowPriority
+		opt := &ast.SelectStmtOpts{}
+		opt.SQLCache = true
+		opt.StraightJoin = true
+		$$ = opt
 	}
-|	"HIGH_PRIORITY"
+
+SelectStmtOpts:
+	%prec empty
 	{
-		$$ = mysql.HighPriority
+		opt := &ast.SelectStmtOpts{}
+		opt.SQLCache = true
+		$$ = opt
 	}
-|	"DELAYED"
+|	SelectStmtOptsList %prec lowerThanSelectOpt
+
+SelectStmtOptsList:
+	SelectStmtOptsList SelectStmtOpt
 	{
-		$$ = mysql.DelayedPriority
+		opts := $1.(*ast.SelectStmtOpts)
+		opt := $2.(*ast.SelectStmtOpts)
+
+		// Merge options.
+		// Always use the first hint.
+		if opt.TableHints != nil && opts.TableHints == nil {
+			opts.TableHints = opt.TableHints
+		}
+		if opt.Distinct {
+			opts.Distinct = true
+		}
+		if opt.Priority != mysql.NoPriority {
+			opts.Priority = opt.Priority
+		}
+		if opt.SQLSmallResult {
+			opts.SQLSmallResult = true
+		}
+		if opt.SQLBigResult {
+			opts.SQLBigResult = true
+		}
+		if opt.SQLBufferResult {
+			opts.SQLBufferResult = true
+		}
+		if !opt.SQLCache {
+			opts.SQLCache = false
+		}
+		if opt.CalcFoundRows {
+			opts.CalcFoundRows = true
+		}
+		if opt.StraightJoin {
+			opts.StraightJoin = true
+		}
+		if opt.ExplicitAll {
+			opts.ExplicitAll = true
+		}
+
+		if opts.Distinct && opts.ExplicitAll {
+			yylex.AppendError(ErrWrongUsage.GenWithStackByArgs("ALL", "DISTINCT"))
+			return 1
+		}
+
+		$$ = opts
 	}
+|	SelectStmtOpt
 
-PriorityOpt:
+TableOptimizerHints:
+	hintComment
 	{
-		$$ = mysql.NoPriority
+		hints, warns := parser.parseHint($1)
+		for _, w := range warns {
+			yylex.AppendError(w)
+			parser.lastErrorAsWarn()
+		}
+		$$ = hints
 	}
-|	Priority
 
-TableName:
-	Identifier
+TableOptimizerHintsOpt:
+	/* empty */
 	{
-		$$ = &ast.TableName{Name: model.NewCIStr($1)}
+		$$ = nil
 	}
-|	Identifier '.' Identifier
+|	TableOptimizerHints
+
+SelectStmtSQLCache:
+	"SQL_CACHE"
 	{
-		$$ = &ast.TableName{Schema: model.NewCIStr($1), Name: model.NewCIStr($3)}
+		$$ = true
 	}
-
-TableNameList:
-	TableName
+|	"SQL_NO_CACHE"
 	{
-		tbl := []*ast.TableName{$1.(*ast.TableName)}
-		$$ = tbl
+		$$ = false
 	}
-|	TableNameList ',' TableName
+
+SelectStmtFieldList:
+	FieldList
 	{
-		$$ = append($1.([]*ast.TableName), $3.(*ast.TableName))
+		$$ = &ast.FieldList{Fields: $1.([]*ast.SelectField)}
 	}
 
-TableNameOptWild:
-	Identifier OptWild
+SelectStmtGroup:
+	/* EMPTY */
 	{
-		$$ = &ast.TableName{Name: model.NewCIStr($1)}
+		$$ = nil
 	}
-|	Identifier '.' Identifier OptWild
+|	GroupByClause
+
+SelectStmtIntoOption:
 	{
-		$$ = &ast.TableName{Schema: model.NewCIStr($1), Name: model.NewCIStr($3)}
+		$$ = nil
 	}
+|	SelectIntoClause
 
-TableAliasRefList:
-	TableNameOptWild
+SelectIntoClause:
+	"INTO" "OUTFILE" stringLit Fields Lines
 	{
-		tbl := []*ast.TableName{$1.(*ast.TableName)}
-		$$ = tbl
+		x := &ast.SelectIntoOption{
+			Tp:       ast.SelectIntoOutfile,
+			FileName: $3,
+		}
+		if $4 != nil {
+			x.FieldsInfo = $4.(*ast.FieldsClause)
+		}
+		if $5 != nil {
+			x.LinesInfo = $5.(*ast.LinesClause)
+		}
+
+		$$ = x
 	}
-|	TableAliasRefList ',' TableNameOptWild
+|	"INTO" SelectIntoVarList
 	{
-		$$ = append($1.([]*ast.TableName), $3.(*ast.TableName))
+		$$ = &ast.SelectIntoOption{
+			Tp:   ast.SelectIntoVars,
+			Vars: $2.([]*ast.SelectIntoVar),
+		}
 	}
 
-OptWild:
-	%prec empty
-	{}
-|	'.' '*'
-	{}
-
-QuickOptional:
-	%prec empty
+SelectIntoVarList:
+	SelectIntoVar
 	{
-		$$ = false
+		$$ = []*ast.SelectIntoVar{$1.(*ast.SelectIntoVar)}
 	}
-|	"QUICK"
+|	SelectIntoVarList ',' SelectIntoVar
 	{
-		$$ = true
+		$$ = append($1.([]*ast.SelectIntoVar), $3.(*ast.SelectIntoVar))
 	}
 
-/***************************Prepared Statement Start******************************
- * See https://dev.mysql.com/doc/refman/5.7/en/prepare.html
- * Example:
- * PREPARE stmt_name FROM 'SELECT SQRT(POW(?,2) + POW(?,2)) AS hypotenuse';
- * OR
- * SET @s = 'SELECT SQRT(POW(?,2) + POW(?,2)) AS hypotenuse';
- * PREPARE stmt_name FROM @s;
- */
-PreparedStmt:
-	"PREPARE" Identifier "FROM" PrepareSQL
+SelectIntoVar:
+	Identifier
 	{
-		var sqlText string
-		var sqlVar *ast.VariableExpr
-		switch x := $4.(type) {
-		case string:
-			sqlText = x
-		case *ast.VariableExpr:
-			sqlVar = x
-		}
-		$$ = &ast.PrepareStmt{
-			Name:    $2,
-			SQLText: sqlText,
-			SQLVar:  sqlVar,
-		}
+		$$ = &ast.SelectIntoVar{Name: model.NewCIStr($1)}
+	}
+|	singleAtIdentifier
+	{
+		$$ = &ast.SelectIntoVar{Name: model.NewCIStr(strings.TrimPrefix($1, "@")), IsUserVar: true}
 	}
 
-PrepareSQL:
-	stringLit
+// See https://dev.mysql.com/doc/refman/5.7/en/subqueries.html
+SubSelect:
+	'(' SelectStmt ')'
 	{
-		$$ = $1
+		rs := $2.(*ast.SelectStmt)
+		endOffset := parser.endOffset(&yyS[yypt])
+		parser.setLastSelectFieldText(rs, endOffset)
+		src := parser.src
+		// See the implementation of yyParse function
+		rs.SetText(parser.lexer.client, src[yyS[yypt-1].offset:yyS[yypt].offset])
+		$$ = &ast.SubqueryExpr{Query: rs}
 	}
-|	UserVariable
+|	'(' SetOprStmt ')'
 	{
-		$$ = $1
+		rs := $2.(*ast.SetOprStmt)
+		src := parser.src
+		rs.SetText(parser.lexer.client, src[yyS[yypt-1].offset:yyS[yypt].offset])
+		$$ = &ast.SubqueryExpr{Query: rs}
 	}
-
-/*
- * See https://dev.mysql.com/doc/refman/5.7/en/execute.html
- * Example:
- * EXECUTE stmt1 USING @a, @b;
- * OR
- * EXECUTE stmt1;
- */
-ExecuteStmt:
-	"EXECUTE" Identifier
+|	'(' SelectStmtWithClause ')'
 	{
-		$$ = &ast.ExecuteStmt{Name: $2}
+		rs := $2.(*ast.SelectStmt)
+		endOffset := parser.endOffset(&yyS[yypt])
+		parser.setLastSelectFieldText(rs, endOffset)
+		src := parser.src
+		// See the implementation of yyParse function
+		rs.SetText(parser.lexer.client, src[yyS[yypt-1].offset:yyS[yypt].offset])
+		$$ = &ast.SubqueryExpr{Query: rs}
 	}
-|	"EXECUTE" Identifier "USING" UserVariableList
+|	'(' SubSelect ')'
 	{
-		$$ = &ast.ExecuteStmt{
-			Name:      $2,
-			UsingVars: $4.([]ast.ExprNode),
+		subQuery := $2.(*ast.SubqueryExpr).Query
+		isRecursive := true
+		// remove redundant brackets like '((select 1))'
+		for isRecursive {
+			if _, isRecursive = subQuery.(*ast.SubqueryExpr); isRecursive {
+				subQuery = subQuery.(*ast.SubqueryExpr).Query
+			}
+		}
+		switch rs := subQuery.(type) {
+		case *ast.SelectStmt:
+			endOffset := parser.endOffset(&yyS[yypt])
+			parser.setLastSelectFieldText(rs, endOffset)
+			src := parser.src
+			rs.SetText(parser.lexer.client, src[yyS[yypt-1].offset:yyS[yypt].offset])
+			$$ = &ast.SubqueryExpr{Query: rs}
+		case *ast.SetOprStmt:
+			src := parser.src
+			rs.SetText(parser.lexer.client, src[yyS[yypt-1].offset:yyS[yypt].offset])
+			$$ = &ast.SubqueryExpr{Query: rs}
 		}
 	}
 
-UserVariableList:
-	UserVariable
+// See https://dev.mysql.com/doc/refman/8.0/en/innodb-locking-reads.html
+SelectLockOpt:
+	/* empty */
 	{
-		$$ = []ast.ExprNode{$1}
+		$$ = nil
 	}
-|	UserVariableList ',' UserVariable
+|	SelectLockClauseList
+|	"LOCK" "IN" "SHARE" "MODE"
 	{
-		$$ = append($1.([]ast.ExprNode), $3)
+		$$ = &ast.SelectLockInfo{
+			LockType:        ast.SelectLockForShare,
+			Tables:          []*ast.TableName{},
+			LockInShareMode: true,
+		}
 	}
 
-DeallocateStmt:
-	DeallocateSym "PREPARE" Identifier
+SelectLockClauseList:
+	SelectLockClause
+|	SelectLockClauseList SelectLockClause
 	{
-		$$ = &ast.DeallocateStmt{Name: $3}
+		info := $1.(*ast.SelectLockInfo)
+		clause := $2.(*ast.SelectLockInfo)
+		if msg := checkSelectLockClause(info, clause); msg != "" {
+			yylex.AppendError(yylex.Errorf("%s", msg))
+			return 1
+		}
+		info.Others = append(info.Others, clause)
+		$$ = info
 	}
 
-DeallocateSym:
-	"DEALLOCATE"
-|	"DROP"
-
-RollbackStmt:
-	"ROLLBACK"
+SelectLockClause:
+	"FOR" "UPDATE" OfTablesOpt
 	{
-		$$ = &ast.RollbackStmt{}
+		$$ = &ast.SelectLockInfo{
+			LockType: ast.SelectLockForUpdate,
+			Tables:   $3.([]*ast.TableName),
+		}
 	}
-|	"ROLLBACK" CompletionTypeWithinTransaction
+|	"FOR" "SHARE" OfTablesOpt
 	{
-		$$ = &ast.RollbackStmt{CompletionType: $2.(ast.CompletionType)}
+		$$ = &ast.SelectLockInfo{
+			LockType: ast.SelectLockForShare,
+			Tables:   $3.([]*ast.TableName),
+		}
 	}
-
-CompletionTypeWithinTransaction:
-	"AND" "CHAIN" "NO" "RELEASE"
+|	"FOR" "UPDATE" OfTablesOpt "NOWAIT"
 	{
-		$$ = ast.CompletionTypeChain
+		$$ = &ast.SelectLockInfo{
+			LockType: ast.SelectLockForUpdateNoWait,
+			Tables:   $3.([]*ast.TableName),
+		}
 	}
-|	"AND" "NO" "CHAIN" "RELEASE"
+|	"FOR" "UPDATE" OfTablesOpt "WAIT" NUM
 	{
-		$$ = ast.CompletionTypeRelease
+		$$ = &ast.SelectLockInfo{
+			LockType: ast.SelectLockForUpdateWaitN,
+			WaitSec:  getUint64FromNUM($5),
+			Tables:   $3.([]*ast.TableName),
+		}
 	}
-|	"AND" "NO" "CHAIN" "NO" "RELEASE"
+|	"FOR" "SHARE" OfTablesOpt "NOWAIT"
 	{
-		$$ = ast.CompletionTypeDefault
+		$$ = &ast.SelectLockInfo{
+			LockType: ast.SelectLockForShareNoWait,
+			Tables:   $3.([]*ast.TableName),
+		}
 	}
-|	"AND" "CHAIN"
+|	"FOR" "UPDATE" OfTablesOpt "SKIP" "LOCKED"
 	{
-		$$ = ast.CompletionTypeChain
+		$$ = &ast.SelectLockInfo{
+			LockType: ast.SelectLockForUpdateSkipLocked,
+			Tables:   $3.([]*ast.TableName),
+		}
 	}
-|	"AND" "NO" "CHAIN"
+|	"FOR" "SHARE" OfTablesOpt "SKIP" "LOCKED"
 	{
-		$$ = ast.CompletionTypeDefault
+		$$ = &ast.SelectLockInfo{
+			LockType: ast.SelectLockForShareSkipLocked,
+			Tables:   $3.([]*ast.TableName),
+		}
 	}
-|	"RELEASE"
+
+OfTablesOpt:
+	/* empty */
 	{
-		$$ = ast.CompletionTypeRelease
+		$$ = []*ast.TableName{}
 	}
-|	"NO" "RELEASE"
+|	"OF" TableNameList
 	{
-		$$ = ast.CompletionTypeDefault
+		$$ = $2.([]*ast.TableName)
 	}
 
-ShutdownStmt:
-	"SHUTDOWN"
+SetOprStmt:
+	SetOprStmtWoutLimitOrderBy
+|	SetOprStmtWithLimitOrderBy
+|	WithClause SetOprStmtWithLimitOrderBy
 	{
-		$$ = &ast.ShutdownStmt{}
+		setOpr := $2.(*ast.SetOprStmt)
+		setOpr.With = $1.(*ast.WithClause)
+		$$ = setOpr
 	}
-
-RestartStmt:
-	"RESTART"
+|	WithClause SetOprStmtWoutLimitOrderBy
 	{
-		$$ = &ast.RestartStmt{}
+		setOpr := $2.(*ast.SetOprStmt)
+		setOpr.With = $1.(*ast.WithClause)
+		$$ = setOpr
 	}
 
-HelpStmt:
-	"HELP" stringLit
+// See https://dev.mysql.com/doc/refman/5.7/en/union.html
+// See https://mariadb.com/kb/en/intersect/
+// See https://mariadb.com/kb/en/except/
+SetOprStmtWoutLimitOrderBy:
+	SetOprClauseList SetOpr SelectStmt
 	{
-		$$ = &ast.HelpStmt{Topic: $2}
+		setOprList1 := $1.([]ast.Node)
+		if sel, isSelect := setOprList1[len(setOprList1)-1].(*ast.SelectStmt); isSelect && !sel.IsInBraces {
+			endOffset := parser.endOffset(&yyS[yypt-1])
+			parser.setLastSelectFieldText(sel, endOffset)
+		}
+		setOpr := &ast.SetOprStmt{SelectList: &ast.SetOprSelectList{Selects: $1.([]ast.Node)}}
+		st := $3.(*ast.SelectStmt)
+		setOpr.Limit = st.Limit
+		setOpr.OrderBy = st.OrderBy
+		st.Limit = nil
+		st.OrderBy = nil
+		st.AfterSetOperator = $2.(*ast.SetOprType)
+		setOpr.SelectList.Selects = append(setOpr.SelectList.Selects, st)
+		$$ = setOpr
 	}
-
-SelectStmtBasic:
-	"SELECT" SelectStmtOpts SelectStmtFieldList
+|	SetOprClauseList SetOpr SubSelect
 	{
-		st := &ast.SelectStmt{
-			SelectStmtOpts: $2.(*ast.SelectStmtOpts),
-			Distinct:       $2.(*ast.SelectStmtOpts).Distinct,
-			Fields:         $3.(*ast.FieldList),
-			Kind:           ast.SelectStmtKindSelect,
+		setOprList1 := $1.([]ast.Node)
+		if sel, isSelect := setOprList1[len(setOprList1)-1].(*ast.SelectStmt); isSelect && !sel.IsInBraces {
+			endOffset := parser.endOffset(&yyS[yypt-1])
+			parser.setLastSelectFieldText(sel, endOffset)
 		}
-		if st.SelectStmtOpts.TableHints != nil {
-			st.TableHints = st.SelectStmtOpts.TableHints
+		var setOprList2 []ast.Node
+		var with2 *ast.WithClause
+		switch x := $3.(*ast.SubqueryExpr).Query.(type) {
+		case *ast.SelectStmt:
+			setOprList2 = []ast.Node{x}
+			with2 = x.With
+		case *ast.SetOprStmt:
+			setOprList2 = x.SelectList.Selects
+			with2 = x.With
 		}
-		$$ = st
+		nextSetOprList := &ast.SetOprSelectList{Selects: setOprList2, With: with2}
+		nextSetOprList.AfterSetOperator = $2.(*ast.SetOprType)
+		setOprList := append(setOprList1, nextSetOprList)
+		setOpr := &ast.SetOprStmt{SelectList: &ast.SetOprSelectList{Selects: setOprList}}
+		$$ = setOpr
 	}
 
-SelectStmtFromDualTable:
-	SelectStmtBasic FromDual WhereClauseOptional
+SetOprStmtWithLimitOrderBy:
+	SetOprClauseList SetOpr SubSelect OrderBy
 	{
-		st := $1.(*ast.SelectStmt)
-		lastField := st.Fields.Fields[len(st.Fields.Fields)-1]
-		if lastField.Expr != nil && lastField.AsName.O == "" {
-			lastEnd := yyS[yypt-1].offset - 1
-			lastField.SetText(parser.lexer.client, parser.src[lastField.Offset:lastEnd])
+		setOprList1 := $1.([]ast.Node)
+		if sel, isSelect := setOprList1[len(setOprList1)-1].(*ast.SelectStmt); isSelect && !sel.IsInBraces {
+			endOffset := parser.endOffset(&yyS[yypt-2])
+			parser.setLastSelectFieldText(sel, endOffset)
 		}
-		if $3 != nil {
-			st.Where = $3.(ast.ExprNode)
+		var setOprList2 []ast.Node
+		var with2 *ast.WithClause
+		switch x := $3.(*ast.SubqueryExpr).Query.(type) {
+		case *ast.SelectStmt:
+			setOprList2 = []ast.Node{x}
+			with2 = x.With
+		case *ast.SetOprStmt:
+			setOprList2 = x.SelectList.Selects
+			with2 = x.With
 		}
+		nextSetOprList := &ast.SetOprSelectList{Selects: setOprList2, With: with2}
+		nextSetOprList.AfterSetOperator = $2.(*ast.SetOprType)
+		setOprList := append(setOprList1, nextSetOprList)
+		setOpr := &ast.SetOprStmt{SelectList: &ast.SetOprSelectList{Selects: setOprList}}
+		setOpr.OrderBy = $4.(*ast.OrderByClause)
+		$$ = setOpr
 	}
-
-SelectStmtFromTable:
-	SelectStmtBasic "FROM" TableRefsClause WhereClauseOptional SelectStmtGroup HavingClause WindowClauseOptional
+|	SetOprClauseList SetOpr SubSelect SelectStmtLimit
 	{
-		st := $1.(*ast.SelectStmt)
-		st.From = $3.(*ast.TableRefsClause)
-		lastField := st.Fields.Fields[len(st.Fields.Fields)-1]
-		if lastField.Expr != nil && lastField.AsName.O == "" {
-			lastEnd := parser.endOffset(&yyS[yypt-5])
-			lastField.SetText(parser.lexer.client, parser.src[lastField.Offset:lastEnd])
-		}
-		if $4 != nil {
-			st.Where = $4.(ast.ExprNode)
+		setOprList1 := $1.([]ast.Node)
+		if sel, isSelect := setOprList1[len(setOprList1)-1].(*ast.SelectStmt); isSelect && !sel.IsInBraces {
+			endOffset := parser.endOffset(&yyS[yypt-2])
+			parser.setLastSelectFieldText(sel, endOffset)
 		}
-		if $5 != nil {
-			st.GroupBy = $5.(*ast.GroupByClause)
+		var setOprList2 []ast.Node
+		var with2 *ast.WithClause
+		switch x := $3.(*ast.SubqueryExpr).Query.(type) {
+		case *ast.SelectStmt:
+			setOprList2 = []ast.Node{x}
+			with2 = x.With
+		case *ast.SetOprStmt:
+			setOprList2 = x.SelectList.Selects
+			with2 = x.With
 		}
-		if $6 != nil {
-			st.Having = $6.(*ast.HavingClause)
+		nextSetOprList := &ast.SetOprSelectList{Selects: setOprList2, With: with2}
+		nextSetOprList.AfterSetOperator = $2.(*ast.SetOprType)
+		setOprList := append(setOprList1, nextSetOprList)
+		setOpr := &ast.SetOprStmt{SelectList: &ast.SetOprSelectList{Selects: setOprList}}
+		setOpr.Limit = $4.(*ast.Limit)
+		$$ = setOpr
+	}
+|	SetOprClauseList SetOpr SubSelect OrderBy SelectStmtLimit
+	{
+		setOprList1 := $1.([]ast.Node)
+		if sel, isSelect := setOprList1[len(setOprList1)-1].(*ast.SelectStmt); isSelect && !sel.IsInBraces {
+			endOffset := parser.endOffset(&yyS[yypt-3])
+			parser.setLastSelectFieldText(sel, endOffset)
 		}
-		if $7 != nil {
-			st.WindowSpecs = ($7.([]ast.WindowSpec))
+		var setOprList2 []ast.Node
+		var with2 *ast.WithClause
+		switch x := $3.(*ast.SubqueryExpr).Query.(type) {
+		case *ast.SelectStmt:
+			setOprList2 = []ast.Node{x}
+			with2 = x.With
+		case *ast.SetOprStmt:
+			setOprList2 = x.SelectList.Selects
+			with2 = x.With
 		}
-		$$ = st
+		nextSetOprList := &ast.SetOprSelectList{Selects: setOprList2, With: with2}
+		nextSetOprList.AfterSetOperator = $2.(*ast.SetOprType)
+		setOprList := append(setOprList1, nextSetOprList)
+		setOpr := &ast.SetOprStmt{SelectList: &ast.SetOprSelectList{Selects: setOprList}}
+		setOpr.OrderBy = $4.(*ast.OrderByClause)
+		setOpr.Limit = $5.(*ast.Limit)
+		$$ = setOpr
 	}
-
-TableSampleOpt:
-	%prec empty
+|	SubSelect OrderBy
 	{
-		$$ = nil
+		var setOprList []ast.Node
+		var with *ast.WithClause
+		switch x := $1.(*ast.SubqueryExpr).Query.(type) {
+		case *ast.SelectStmt:
+			setOprList = []ast.Node{x}
+			with = x.With
+		case *ast.SetOprStmt:
+			setOprList = x.SelectList.Selects
+			with = x.With
+		}
+		setOpr := &ast.SetOprStmt{SelectList: &ast.SetOprSelectList{Selects: setOprList}, With: with}
+		setOpr.OrderBy = $2.(*ast.OrderByClause)
+		$$ = setOpr
 	}
-|	"TABLESAMPLE" TableSampleMethodOpt '(' Expression TableSampleUnitOpt ')' RepeatableOpt
+|	SubSelect SelectStmtLimit
 	{
-		var repSeed ast.ExprNode
-		if $7 != nil {
-			repSeed = ast.NewValueExpr($7, parser.charset, parser.collation)
+		var setOprList []ast.Node
+		var with *ast.WithClause
+		switch x := $1.(*ast.SubqueryExpr).Query.(type) {
+		case *ast.SelectStmt:
+			setOprList = []ast.Node{x}
+			with = x.With
+		case *ast.SetOprStmt:
+			setOprList = x.SelectList.Selects
+			with = x.With
 		}
-		$$ = &ast.TableSample{
-			SampleMethod:     $2.(ast.SampleMethodType),
-			Expr:             ast.NewValueExpr($4, parser.charset, parser.collation),
-			SampleClauseUnit: $5.(ast.SampleClauseUnitType),
-			RepeatableSeed:   repSeed,
+		setOpr := &ast.SetOprStmt{SelectList: &ast.SetOprSelectList{Selects: setOprList}, With: with}
+		setOpr.Limit = $2.(*ast.Limit)
+		$$ = setOpr
+	}
+|	SubSelect OrderBy SelectStmtLimit
+	{
+		var setOprList []ast.Node
+		var with *ast.WithClause
+		switch x := $1.(*ast.SubqueryExpr).Query.(type) {
+		case *ast.SelectStmt:
+			setOprList = []ast.Node{x}
+			with = x.With
+		case *ast.SetOprStmt:
+			setOprList = x.SelectList.Selects
+			with = x.With
 		}
+		setOpr := &ast.SetOprStmt{SelectList: &ast.SetOprSelectList{Selects: setOprList}, With: with}
+		setOpr.OrderBy = $2.(*ast.OrderByClause)
+		setOpr.Limit = $3.(*ast.Limit)
+		$$ = setOpr
 	}
-|	"TABLESAMPLE" TableSampleMethodOpt '(' ')' RepeatableOpt
+
+SetOprClauseList:
+	SetOprClause
+|	SetOprClauseList SetOpr SetOprClause
 	{
-		var repSeed ast.ExprNode
-		if $5 != nil {
-			repSeed = ast.NewValueExpr($5, parser.charset, parser.collation)
+		setOprList1 := $1.([]ast.Node)
+		setOprList2 := $3.([]ast.Node)
+		if sel, isSelect := setOprList1[len(setOprList1)-1].(*ast.SelectStmt); isSelect && !sel.IsInBraces {
+			endOffset := parser.endOffset(&yyS[yypt-1])
+			parser.setLastSelectFieldText(sel, endOffset)
 		}
-		$$ = &ast.TableSample{
-			SampleMethod:   $2.(ast.SampleMethodType),
-			RepeatableSeed: repSeed,
+		switch x := setOprList2[0].(type) {
+		case *ast.SelectStmt:
+			x.AfterSetOperator = $2.(*ast.SetOprType)
+		case *ast.SetOprSelectList:
+			x.AfterSetOperator = $2.(*ast.SetOprType)
 		}
+		$$ = append(setOprList1, setOprList2...)
 	}
 
-TableSampleMethodOpt:
-	%prec empty
+SetOprClause:
+	SelectStmt
 	{
-		$$ = ast.SampleMethodTypeNone
+		$$ = []ast.Node{$1.(*ast.SelectStmt)}
 	}
-|	"SYSTEM"
+|	SubSelect
 	{
-		$$ = ast.SampleMethodTypeSystem
+		var setOprList []ast.Node
+		switch x := $1.(*ast.SubqueryExpr).Query.(type) {
+		case *ast.SelectStmt:
+			setOprList = []ast.Node{&ast.SetOprSelectList{Selects: []ast.Node{x}}}
+		case *ast.SetOprStmt:
+			setOprList = []ast.Node{&ast.SetOprSelectList{Selects: x.SelectList.Selects, With: x.With}}
+		}
+		$$ = setOprList
 	}
-|	"BERNOULLI"
+
+SetOpr:
+	"UNION" SetOprOpt
 	{
-		$$ = ast.SampleMethodTypeBernoulli
+		var tp ast.SetOprType
+		tp = ast.Union
+		if $2 == false {
+			tp = ast.UnionAll
+		}
+		$$ = &tp
 	}
-|	"REGIONS"
+|	"EXCEPT" SetOprOpt
 	{
-		$$ = ast.SampleMethodTypeTiDBRegion
+		var tp ast.SetOprType
+		tp = ast.Except
+		if $2 == false {
+			tp = ast.ExceptAll
+		}
+		$$ = &tp
+	}
+|	"INTERSECT" SetOprOpt
+	{
+		var tp ast.SetOprType
+		tp = ast.Intersect
+		if $2 == false {
+			tp = ast.IntersectAll
+		}
+		$$ = &tp
 	}
 
-TableSampleUnitOpt:
-	%prec empty
+SetOprOpt:
+	DefaultTrueDistinctOpt
+
+/********************Change Statement*******************************/
+ChangeStmt:
+	"CHANGE" "PUMP" "TO" "NODE_STATE" eq stringLit forKwd "NODE_ID" stringLit
 	{
-		$$ = ast.SampleClauseUnitTypeDefault
+		$$ = &ast.ChangeStmt{
+			NodeType: ast.PumpType,
+			State:    $6,
+			NodeID:   $9,
+		}
+	}
+|	"CHANGE" "DRAINER" "TO" "NODE_STATE" eq stringLit forKwd "NODE_ID" stringLit
+	{
+		$$ = &ast.ChangeStmt{
+			NodeType: ast.DrainerType,
+			State:    $6,
+			NodeID:   $9,
+		}
 	}
-|	"ROWS"
+
+/*******************************************************************
+ * Replication control statements
+ *
+ *	CHANGE REPLICATION SOURCE TO option [, option] ... [FOR CHANNEL channel]
+ *	CHANGE MASTER TO option [, option] ... [FOR CHANNEL channel]
+ *	START {REPLICA | SLAVE} [thread_types] [UNTIL until_option] [connection_options] [FOR CHANNEL channel]
+ *	STOP {REPLICA | SLAVE} [thread_types] [FOR CHANNEL channel]
+ *	RESET {REPLICA | SLAVE} [ALL] [FOR CHANNEL channel]
+ *	RESET MASTER [TO binary_log_file_index_number]
+ *	PURGE {BINARY | MASTER} LOGS {TO 'log_name' | BEFORE datetime_expr}
+ *******************************************************************/
+ChangeReplicationSourceStmt:
+	"CHANGE" "REPLICATION" "SOURCE" "TO" ReplicationSourceOptionList ReplicationChannelOpt
 	{
-		$$ = ast.SampleClauseUnitTypeRow
+		$$ = &ast.ChangeReplicationSourceStmt{
+			Options: $5.([]*ast.ReplicationSourceOption),
+			Channel: $6,
+		}
 	}
-|	"PERCENT"
+|	"CHANGE" "MASTER" "TO" ReplicationSourceOptionList ReplicationChannelOpt
 	{
-		$$ = ast.SampleClauseUnitTypePercent
+		$$ = &ast.ChangeReplicationSourceStmt{
+			Legacy:  true,
+			Options: $4.([]*ast.ReplicationSourceOption),
+			Channel: $5,
+		}
 	}
 
-RepeatableOpt:
-	%prec empty
+ReplicationSourceOptionList:
+	ReplicationSourceOption
 	{
-		$$ = nil
+		$$ = []*ast.ReplicationSourceOption{$1.(*ast.ReplicationSourceOption)}
 	}
-|	"REPEATABLE" '(' Expression ')'
+|	ReplicationSourceOptionList ',' ReplicationSourceOption
 	{
-		$$ = $3
+		$$ = append($1.([]*ast.ReplicationSourceOption), $3.(*ast.ReplicationSourceOption))
 	}
 
-SelectStmt:
-	SelectStmtBasic WhereClauseOptional SelectStmtGroup OrderByOptional SelectStmtLimitOpt SelectLockOpt SelectStmtIntoOption
+ReplicationSourceOption:
+	ReplicationSourceStringOptionName eq stringLit
 	{
-		st := $1.(*ast.SelectStmt)
-		if $6 != nil {
-			st.LockInfo = $6.(*ast.SelectLockInfo)
-		}
-		lastField := st.Fields.Fields[len(st.Fields.Fields)-1]
-		if lastField.Expr != nil && lastField.AsName.O == "" {
-			src := parser.src
-			var lastEnd int
-			if $2 != nil {
-				lastEnd = yyS[yypt-5].offset - 1
-			} else if $3 != nil {
-				lastEnd = yyS[yypt-4].offset - 1
-			} else if $4 != nil {
-				lastEnd = yyS[yypt-3].offset - 1
-			} else if $5 != nil {
-				lastEnd = yyS[yypt-2].offset - 1
-			} else if st.LockInfo != nil && st.LockInfo.LockType != ast.SelectLockNone {
-				lastEnd = yyS[yypt-1].offset - 1
-			} else if $7 != nil {
-				lastEnd = yyS[yypt].offset - 1
-			} else {
-				lastEnd = len(src)
-				if src[lastEnd-1] == ';' {
-					lastEnd--
-				}
-			}
-			lastField.SetText(parser.lexer.client, src[lastField.Offset:lastEnd])
-		}
-		if $2 != nil {
-			st.Where = $2.(ast.ExprNode)
-		}
-		if $3 != nil {
-			st.GroupBy = $3.(*ast.GroupByClause)
-		}
-		if $4 != nil {
-			st.OrderBy = $4.(*ast.OrderByClause)
-		}
-		if $5 != nil {
-			st.Limit = $5.(*ast.Limit)
-		}
-		if $7 != nil {
-			st.SelectIntoOpt = $7.(*ast.SelectIntoOption)
+		$$ = &ast.ReplicationSourceOption{
+			Tp:       $1.(ast.ReplicationSourceOptionType),
+			StrValue: $3,
 		}
-		$$ = st
 	}
-|	SelectStmtFromDualTable SelectStmtGroup OrderByOptional SelectStmtLimitOpt SelectLockOpt SelectStmtIntoOption
+|	ReplicationSourceStringOptionName eq "NULL"
 	{
-		st := $1.(*ast.SelectStmt)
-		if $2 != nil {
-			st.GroupBy = $2.(*ast.GroupByClause)
-		}
-		if $3 != nil {
-			st.OrderBy = $3.(*ast.OrderByClause)
-		}
-		if $4 != nil {
-			st.Limit = $4.(*ast.Limit)
-		}
-		if $5 != nil {
-			st.LockInfo = $5.(*ast.SelectLockInfo)
-		}
-		if $6 != nil {
-			st.SelectIntoOpt = $6.(*ast.SelectIntoOption)
+		tp := $1.(ast.ReplicationSourceOptionType)
+		if tp != ast.ReplicationSourceOptionTLSCiphersuites {
+			yylex.AppendError(yylex.Errorf("The value of %s can't be NULL", tp))
+			return 1
 		}
-		$$ = st
+		$$ = &ast.ReplicationSourceOption{Tp: tp, IsNull: true}
 	}
-|	SelectStmtFromTable OrderByOptional SelectStmtLimitOpt SelectLockOpt SelectStmtIntoOption
+|	ReplicationSourceIntegerOptionName eq LengthNum
 	{
-		st := $1.(*ast.SelectStmt)
-		if $4 != nil {
-			st.LockInfo = $4.(*ast.SelectLockInfo)
+		$$ = &ast.ReplicationSourceOption{
+			Tp:        $1.(ast.ReplicationSourceOptionType),
+			UintValue: $3.(uint64),
 		}
-		if $2 != nil {
-			st.OrderBy = $2.(*ast.OrderByClause)
+	}
+|	ReplicationSourceBooleanOptionName eq LengthNum
+	{
+		tp := $1.(ast.ReplicationSourceOptionType)
+		if $3.(uint64) > 1 {
+			yylex.AppendError(yylex.Errorf("The value of %s must be 0 or 1", tp))
+			return 1
 		}
-		if $3 != nil {
-			st.Limit = $3.(*ast.Limit)
+		$$ = &ast.ReplicationSourceOption{
+			Tp:        tp,
+			UintValue: $3.(uint64),
 		}
-		if $5 != nil {
-			st.SelectIntoOpt = $5.(*ast.SelectIntoOption)
+	}
+|	ReplicationSourceHeartbeatPeriod eq NumLiteral
+	{
+		$$ = &ast.ReplicationSourceOption{
+			Tp:    ast.ReplicationSourceOptionHeartbeatPeriod,
+			Value: ast.NewValueExpr($3, "", ""),
 		}
-		$$ = st
 	}
-|	"TABLE" TableName OrderByOptional SelectStmtLimitOpt SelectLockOpt SelectStmtIntoOption
+|	"PRIVILEGE_CHECKS_USER" eq "NULL"
 	{
-		st := &ast.SelectStmt{
-			Kind:   ast.SelectStmtKindTable,
-			Fields: &ast.FieldList{Fields: []*ast.SelectField{{WildCard: &ast.WildCardField{}}}},
+		$$ = &ast.ReplicationSourceOption{Tp: ast.ReplicationSourceOptionPrivilegeChecksUser}
+	}
+|	"PRIVILEGE_CHECKS_USER" eq Username
+	{
+		user := $3.(*auth.UserIdentity)
+		if user.CurrentUser {
+			yylex.AppendError(yylex.Errorf("PRIVILEGE_CHECKS_USER requires an account name"))
+			return 1
 		}
-		ts := &ast.TableSource{Source: $2.(*ast.TableName)}
-		st.From = &ast.TableRefsClause{TableRefs: &ast.Join{Left: ts}}
-		if $3 != nil {
-			st.OrderBy = $3.(*ast.OrderByClause)
+		$$ = &ast.ReplicationSourceOption{
+			Tp:   ast.ReplicationSourceOptionPrivilegeChecksUser,
+			User: user,
 		}
-		if $4 != nil {
-			st.Limit = $4.(*ast.Limit)
+	}
+|	"REQUIRE_TABLE_PRIMARY_KEY_CHECK" eq "ON"
+	{
+		$$ = &ast.ReplicationSourceOption{
+			Tp:       ast.ReplicationSourceOptionRequireTablePrimaryKeyCheck,
+			StrValue: "ON",
 		}
-		if $5 != nil {
-			st.LockInfo = $5.(*ast.SelectLockInfo)
+	}
+|	"REQUIRE_TABLE_PRIMARY_KEY_CHECK" eq Identifier
+	{
+		value := strings.ToUpper($3)
+		if value != "STREAM" && value != "OFF" && value != "GENERATE" {
+			yylex.AppendError(yylex.Errorf("The value of REQUIRE_TABLE_PRIMARY_KEY_CHECK must be STREAM, ON, OFF or GENERATE"))
+			return 1
 		}
-		if $6 != nil {
-			st.SelectIntoOpt = $6.(*ast.SelectIntoOption)
+		$$ = &ast.ReplicationSourceOption{
+			Tp:       ast.ReplicationSourceOptionRequireTablePrimaryKeyCheck,
+			StrValue: value,
 		}
-		$$ = st
 	}
-|	"VALUES" ValuesStmtList OrderByOptional SelectStmtLimitOpt SelectLockOpt SelectStmtIntoOption
+|	"ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS" eq Identifier
 	{
-		st := &ast.SelectStmt{
-			Kind:   ast.SelectStmtKindValues,
-			Fields: &ast.FieldList{Fields: []*ast.SelectField{{WildCard: &ast.WildCardField{}}}},
-			Lists:  $2.([]*ast.RowExpr),
-		}
-		if $3 != nil {
-			st.OrderBy = $3.(*ast.OrderByClause)
+		value := strings.ToUpper($3)
+		if value != "OFF" && value != "LOCAL" {
+			yylex.AppendError(yylex.Errorf("The value of ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS must be OFF, LOCAL or a UUID"))
+			return 1
 		}
-		if $4 != nil {
-			st.Limit = $4.(*ast.Limit)
+		$$ = &ast.ReplicationSourceOption{
+			Tp:       ast.ReplicationSourceOptionAssignGtidsToAnonymousTransactions,
+			StrValue: value,
 		}
-		if $5 != nil {
-			st.LockInfo = $5.(*ast.SelectLockInfo)
+	}
+|	"ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS" eq stringLit
+	{
+		$$ = &ast.ReplicationSourceOption{
+			Tp:       ast.ReplicationSourceOptionAssignGtidsToAnonymousTransactions,
+			StrValue: $3,
 		}
-		if $6 != nil {
-			st.SelectIntoOpt = $6.(*ast.SelectIntoOption)
+	}
+|	"IGNORE_SERVER_IDS" eq '(' ReplicationServerIDListOpt ')'
+	{
+		$$ = &ast.ReplicationSourceOption{
+			Tp:        ast.ReplicationSourceOptionIgnoreServerIDs,
+			ServerIDs: $4.([]uint64),
 		}
-		$$ = st
 	}
 
-SelectStmtWithClause:
-	WithClause SelectStmt
+ReplicationSourceStringOptionName:
+	"SOURCE_BIND"
 	{
-		sel := $2.(*ast.SelectStmt)
-		sel.With = $1.(*ast.WithClause)
-		$$ = sel
+		$$ = ast.ReplicationSourceOptionBind
 	}
-|	WithClause SubSelect
+|	"MASTER_BIND"
 	{
-		var sel ast.StmtNode
-		switch x := $2.(*ast.SubqueryExpr).Query.(type) {
-		case *ast.SelectStmt:
-			x.IsInBraces = true
-			x.WithBeforeBraces = true
-			x.With = $1.(*ast.WithClause)
-			sel = x
-		case *ast.SetOprStmt:
-			x.IsInBraces = true
-			x.With = $1.(*ast.WithClause)
-			sel = x
-		}
-		$$ = sel
+		$$ = ast.ReplicationSourceOptionBind
 	}
-
-WithClause:
-	"WITH" WithList
+|	"SOURCE_HOST"
 	{
-		$$ = $2
+		$$ = ast.ReplicationSourceOptionHost
 	}
-|	"WITH" recursive WithList
+|	"MASTER_HOST"
 	{
-		ws := $3.(*ast.WithClause)
-		ws.IsRecursive = true
-		$$ = ws
+		$$ = ast.ReplicationSourceOptionHost
 	}
-
-WithList:
-	WithList ',' CommonTableExpr
+|	"SOURCE_USER"
 	{
-		ws := $1.(*ast.WithClause)
-		ws.CTEs = append(ws.CTEs, $3.(*ast.CommonTableExpression))
-		$$ = ws
+		$$ = ast.ReplicationSourceOptionUser
 	}
-|	CommonTableExpr
+|	"MASTER_USER"
 	{
-		ws := &ast.WithClause{}
-		ws.CTEs = make([]*ast.CommonTableExpression, 0, 4)
-		ws.CTEs = append(ws.CTEs, $1.(*ast.CommonTableExpression))
-		$$ = ws
+		$$ = ast.ReplicationSourceOptionUser
 	}
-
-CommonTableExpr:
-	Identifier IdentListWithParenOpt "AS" SubSelect
+|	"SOURCE_PASSWORD"
 	{
-		cte := &ast.CommonTableExpression{}
-		cte.Name = model.NewCIStr($1)
-		cte.ColNameList = $2.([]model.CIStr)
-		cte.Query = $4.(*ast.SubqueryExpr)
-		$$ = cte
+		$$ = ast.ReplicationSourceOptionPassword
 	}
-
-FromDual:
-	"FROM" "DUAL"
-
-WindowClauseOptional:
+|	"MASTER_PASSWORD"
 	{
-		$$ = nil
+		$$ = ast.ReplicationSourceOptionPassword
 	}
-|	"WINDOW" WindowDefinitionList
+|	"SOURCE_LOG_FILE"
 	{
-		$$ = $2.([]ast.WindowSpec)
+		$$ = ast.ReplicationSourceOptionLogFile
 	}
-
-WindowDefinitionList:
-	WindowDefinition
+|	"MASTER_LOG_FILE"
 	{
-		$$ = []ast.WindowSpec{$1.(ast.WindowSpec)}
+		$$ = ast.ReplicationSourceOptionLogFile
 	}
-|	WindowDefinitionList ',' WindowDefinition
+|	"RELAY_LOG_FILE"
 	{
-		$$ = append($1.([]ast.WindowSpec), $3.(ast.WindowSpec))
+		$$ = ast.ReplicationSourceOptionRelayLogFile
 	}
-
-WindowDefinition:
-	WindowName "AS" WindowSpec
+|	"SOURCE_COMPRESSION_ALGORITHMS"
 	{
-		var spec = $3.(ast.WindowSpec)
-		spec.Name = $1.(model.CIStr)
-		$$ = spec
+		$$ = ast.ReplicationSourceOptionCompressionAlgorithms
 	}
-
-WindowName:
-	Identifier
+|	"MASTER_COMPRESSION_ALGORITHMS"
 	{
-		$$ = model.NewCIStr($1)
+		$$ = ast.ReplicationSourceOptionCompressionAlgorithms
 	}
-
-WindowSpec:
-	'(' WindowSpecDetails ')'
+|	"SOURCE_SSL_CA"
 	{
-		$$ = $2.(ast.WindowSpec)
+		$$ = ast.ReplicationSourceOptionSSLCA
 	}
-
-WindowSpecDetails:
-	OptExistingWindowName OptPartitionClause OptWindowOrderByClause OptWindowFrameClause
+|	"MASTER_SSL_CA"
 	{
-		spec := ast.WindowSpec{Ref: $1.(model.CIStr)}
-		if $2 != nil {
-			spec.PartitionBy = $2.(*ast.PartitionByClause)
-		}
-		if $3 != nil {
-			spec.OrderBy = $3.(*ast.OrderByClause)
-		}
-		if $4 != nil {
-			spec.Frame = $4.(*ast.FrameClause)
-		}
-		$$ = spec
+		$$ = ast.ReplicationSourceOptionSSLCA
 	}
-
-OptExistingWindowName:
+|	"SOURCE_SSL_CAPATH"
 	{
-		$$ = model.CIStr{}
+		$$ = ast.ReplicationSourceOptionSSLCAPath
 	}
-|	WindowName
-
-OptPartitionClause:
+|	"MASTER_SSL_CAPATH"
 	{
-		$$ = nil
+		$$ = ast.ReplicationSourceOptionSSLCAPath
 	}
-|	"PARTITION" "BY" ByList
+|	"SOURCE_SSL_CERT"
 	{
-		$$ = &ast.PartitionByClause{Items: $3.([]*ast.ByItem)}
+		$$ = ast.ReplicationSourceOptionSSLCert
 	}
-
-OptWindowOrderByClause:
+|	"MASTER_SSL_CERT"
 	{
-		$$ = nil
+		$$ = ast.ReplicationSourceOptionSSLCert
 	}
-|	"ORDER" "BY" ByList
+|	"SOURCE_SSL_CRL"
 	{
-		$$ = &ast.OrderByClause{Items: $3.([]*ast.ByItem)}
+		$$ = ast.ReplicationSourceOptionSSLCRL
 	}
-
-OptWindowFrameClause:
+|	"MASTER_SSL_CRL"
 	{
-		$$ = nil
+		$$ = ast.ReplicationSourceOptionSSLCRL
 	}
-|	WindowFrameUnits WindowFrameExtent
+|	"SOURCE_SSL_CRLPATH"
 	{
-		$$ = &ast.FrameClause{
-			Type:   $1.(ast.FrameType),
-			Extent: $2.(ast.FrameExtent),
-		}
+		$$ = ast.ReplicationSourceOptionSSLCRLPath
 	}
-
-WindowFrameUnits:
-	"ROWS"
+|	"MASTER_SSL_CRLPATH"
+	{
+		$$ = ast.ReplicationSourceOptionSSLCRLPath
+	}
+|	"SOURCE_SSL_KEY"
+	{
+		$$ = ast.ReplicationSourceOptionSSLKey
+	}
+|	"MASTER_SSL_KEY"
 	{
-		$$ = ast.FrameType(ast.Rows)
+		$$ = ast.ReplicationSourceOptionSSLKey
 	}
-|	"RANGE"
+|	"SOURCE_SSL_CIPHER"
 	{
-		$$ = ast.FrameType(ast.Ranges)
+		$$ = ast.ReplicationSourceOptionSSLCipher
 	}
-|	"GROUPS"
+|	"MASTER_SSL_CIPHER"
 	{
-		$$ = ast.FrameType(ast.Groups)
+		$$ = ast.ReplicationSourceOptionSSLCipher
 	}
-
-WindowFrameExtent:
-	WindowFrameStart
+|	"SOURCE_TLS_VERSION"
 	{
-		$$ = ast.FrameExtent{
-			Start: $1.(ast.FrameBound),
-			End:   ast.FrameBound{Type: ast.CurrentRow},
-		}
+		$$ = ast.ReplicationSourceOptionTLSVersion
 	}
-|	WindowFrameBetween
-
-WindowFrameStart:
-	"UNBOUNDED" "PRECEDING"
+|	"MASTER_TLS_VERSION"
 	{
-		$$ = ast.FrameBound{Type: ast.Preceding, UnBounded: true}
+		$$ = ast.ReplicationSourceOptionTLSVersion
 	}
-|	NumLiteral "PRECEDING"
+|	"SOURCE_TLS_CIPHERSUITES"
 	{
-		$$ = ast.FrameBound{Type: ast.Preceding, Expr: ast.NewValueExpr($1, parser.charset, parser.collation)}
+		$$ = ast.ReplicationSourceOptionTLSCiphersuites
 	}
-|	paramMarker "PRECEDING"
+|	"MASTER_TLS_CIPHERSUITES"
 	{
-		$$ = ast.FrameBound{Type: ast.Preceding, Expr: ast.NewParamMarkerExpr(yyS[yypt].offset)}
+		$$ = ast.ReplicationSourceOptionTLSCiphersuites
 	}
-|	"INTERVAL" Expression TimeUnit "PRECEDING"
+|	"SOURCE_PUBLIC_KEY_PATH"
 	{
-		$$ = ast.FrameBound{Type: ast.Preceding, Expr: $2, Unit: $3.(ast.TimeUnitType)}
+		$$ = ast.ReplicationSourceOptionPublicKeyPath
 	}
-|	"CURRENT" "ROW"
+|	"MASTER_PUBLIC_KEY_PATH"
 	{
-		$$ = ast.FrameBound{Type: ast.CurrentRow}
+		$$ = ast.ReplicationSourceOptionPublicKeyPath
 	}
-
-WindowFrameBetween:
-	"BETWEEN" WindowFrameBound "AND" WindowFrameBound
+|	"NETWORK_NAMESPACE"
 	{
-		$$ = ast.FrameExtent{Start: $2.(ast.FrameBound), End: $4.(ast.FrameBound)}
+		$$ = ast.ReplicationSourceOptionNetworkNamespace
 	}
 
-WindowFrameBound:
-	WindowFrameStart
-|	"UNBOUNDED" "FOLLOWING"
+ReplicationSourceIntegerOptionName:
+	"SOURCE_PORT"
 	{
-		$$ = ast.FrameBound{Type: ast.Following, UnBounded: true}
+		$$ = ast.ReplicationSourceOptionPort
 	}
-|	NumLiteral "FOLLOWING"
+|	"MASTER_PORT"
 	{
-		$$ = ast.FrameBound{Type: ast.Following, Expr: ast.NewValueExpr($1, parser.charset, parser.collation)}
+		$$ = ast.ReplicationSourceOptionPort
 	}
-|	paramMarker "FOLLOWING"
+|	"SOURCE_LOG_POS"
 	{
-		$$ = ast.FrameBound{Type: ast.Following, Expr: ast.NewParamMarkerExpr(yyS[yypt].offset)}
+		$$ = ast.ReplicationSourceOptionLogPos
 	}
-|	"INTERVAL" Expression TimeUnit "FOLLOWING"
+|	"MASTER_LOG_POS"
 	{
-		$$ = ast.FrameBound{Type: ast.Following, Expr: $2, Unit: $3.(ast.TimeUnitType)}
+		$$ = ast.ReplicationSourceOptionLogPos
 	}
-
-OptWindowingClause:
+|	"RELAY_LOG_POS"
 	{
-		$$ = nil
+		$$ = ast.ReplicationSourceOptionRelayLogPos
 	}
-|	WindowingClause
+|	"SOURCE_CONNECT_RETRY"
 	{
-		spec := $1.(ast.WindowSpec)
-		$$ = &spec
+		$$ = ast.ReplicationSourceOptionConnectRetry
 	}
-
-WindowingClause:
-	"OVER" WindowNameOrSpec
+|	"MASTER_CONNECT_RETRY"
 	{
-		$$ = $2.(ast.WindowSpec)
+		$$ = ast.ReplicationSourceOptionConnectRetry
 	}
-
-WindowNameOrSpec:
-	WindowName
+|	"SOURCE_RETRY_COUNT"
 	{
-		$$ = ast.WindowSpec{Name: $1.(model.CIStr), OnlyAlias: true}
+		$$ = ast.ReplicationSourceOptionRetryCount
 	}
-|	WindowSpec
-
-WindowFuncCall:
-	"ROW_NUMBER" '(' ')' WindowingClause
+|	"MASTER_RETRY_COUNT"
 	{
-		$$ = &ast.WindowFuncExpr{F: $1, Spec: $4.(ast.WindowSpec)}
+		$$ = ast.ReplicationSourceOptionRetryCount
 	}
-|	"RANK" '(' ')' WindowingClause
+|	"SOURCE_DELAY"
 	{
-		$$ = &ast.WindowFuncExpr{F: $1, Spec: $4.(ast.WindowSpec)}
+		$$ = ast.ReplicationSourceOptionDelay
 	}
-|	"DENSE_RANK" '(' ')' WindowingClause
+|	"MASTER_DELAY"
 	{
-		$$ = &ast.WindowFuncExpr{F: $1, Spec: $4.(ast.WindowSpec)}
+		$$ = ast.ReplicationSourceOptionDelay
 	}
-|	"CUME_DIST" '(' ')' WindowingClause
+|	"SOURCE_ZSTD_COMPRESSION_LEVEL"
 	{
-		$$ = &ast.WindowFuncExpr{F: $1, Spec: $4.(ast.WindowSpec)}
+		$$ = ast.ReplicationSourceOptionZstdCompressionLevel
 	}
-|	"PERCENT_RANK" '(' ')' WindowingClause
+|	"MASTER_ZSTD_COMPRESSION_LEVEL"
 	{
-		$$ = &ast.WindowFuncExpr{F: $1, Spec: $4.(ast.WindowSpec)}
+		$$ = ast.ReplicationSourceOptionZstdCompressionLevel
 	}
-|	"NTILE" '(' SimpleExpr ')' WindowingClause
+
+ReplicationSourceBooleanOptionName:
+	"SOURCE_AUTO_POSITION"
 	{
-		$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$3}, Spec: $5.(ast.WindowSpec)}
+		$$ = ast.ReplicationSourceOptionAutoPosition
 	}
-|	"LEAD" '(' Expression OptLeadLagInfo ')' OptNullTreatment WindowingClause
+|	"MASTER_AUTO_POSITION"
 	{
-		args := []ast.ExprNode{$3}
-		if $4 != nil {
-			args = append(args, $4.([]ast.ExprNode)...)
-		}
-		$$ = &ast.WindowFuncExpr{F: $1, Args: args, IgnoreNull: $6.(bool), Spec: $7.(ast.WindowSpec)}
+		$$ = ast.ReplicationSourceOptionAutoPosition
 	}
-|	"LAG" '(' Expression OptLeadLagInfo ')' OptNullTreatment WindowingClause
+|	"SOURCE_CONNECTION_AUTO_FAILOVER"
 	{
-		args := []ast.ExprNode{$3}
-		if $4 != nil {
-			args = append(args, $4.([]ast.ExprNode)...)
-		}
-		$$ = &ast.WindowFuncExpr{F: $1, Args: args, IgnoreNull: $6.(bool), Spec: $7.(ast.WindowSpec)}
+		$$ = ast.ReplicationSourceOptionConnectionAutoFailover
 	}
-|	"FIRST_VALUE" '(' Expression ')' OptNullTreatment WindowingClause
+|	"SOURCE_SSL"
 	{
-		$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$3}, IgnoreNull: $5.(bool), Spec: $6.(ast.WindowSpec)}
+		$$ = ast.ReplicationSourceOptionSSL
 	}
-|	"LAST_VALUE" '(' Expression ')' OptNullTreatment WindowingClause
+|	"MASTER_SSL"
 	{
-		$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$3}, IgnoreNull: $5.(bool), Spec: $6.(ast.WindowSpec)}
+		$$ = ast.ReplicationSourceOptionSSL
 	}
-|	"NTH_VALUE" '(' Expression ',' SimpleExpr ')' OptFromFirstLast OptNullTreatment WindowingClause
+|	"SOURCE_SSL_VERIFY_SERVER_CERT"
 	{
-		$$ = &ast.WindowFuncExpr{F: $1, Args: []ast.ExprNode{$3, $5}, FromLast: $7.(bool), IgnoreNull: $8.(bool), Spec: $9.(ast.WindowSpec)}
+		$$ = ast.ReplicationSourceOptionSSLVerifyServerCert
 	}
-
-OptLeadLagInfo:
+|	"MASTER_SSL_VERIFY_SERVER_CERT"
 	{
-		$$ = nil
+		$$ = ast.ReplicationSourceOptionSSLVerifyServerCert
 	}
-|	',' NumLiteral OptLLDefault
+|	"GET_SOURCE_PUBLIC_KEY"
 	{
-		args := []ast.ExprNode{ast.NewValueExpr($2, parser.charset, parser.collation)}
-		if $3 != nil {
-			args = append(args, $3.(ast.ExprNode))
-		}
-		$$ = args
+		$$ = ast.ReplicationSourceOptionGetPublicKey
 	}
-|	',' paramMarker OptLLDefault
+|	"GET_MASTER_PUBLIC_KEY"
 	{
-		args := []ast.ExprNode{ast.NewValueExpr($2, parser.charset, parser.collation)}
-		if $3 != nil {
-			args = append(args, $3.(ast.ExprNode))
-		}
-		$$ = args
+		$$ = ast.ReplicationSourceOptionGetPublicKey
 	}
-
-OptLLDefault:
+|	"REQUIRE_ROW_FORMAT"
 	{
-		$$ = nil
+		$$ = ast.ReplicationSourceOptionRequireRowFormat
 	}
-|	',' Expression
+|	"GTID_ONLY"
 	{
-		$$ = $2
+		$$ = ast.ReplicationSourceOptionGtidOnly
 	}
 
-OptNullTreatment:
+ReplicationSourceHeartbeatPeriod:
+	"SOURCE_HEARTBEAT_PERIOD"
+|	"MASTER_HEARTBEAT_PERIOD"
+
+ReplicationServerIDListOpt:
 	{
-		$$ = false
+		$$ = []uint64{}
 	}
-|	"RESPECT" "NULLS"
+|	ReplicationServerIDList
+
+ReplicationServerIDList:
+	LengthNum
 	{
-		$$ = false
+		$$ = []uint64{$1.(uint64)}
 	}
-|	"IGNORE" "NULLS"
+|	ReplicationServerIDList ',' LengthNum
 	{
-		$$ = true
+		$$ = append($1.([]uint64), $3.(uint64))
 	}
 
-OptFromFirstLast:
+ReplicationChannelOpt:
 	{
-		$$ = false
+		$$ = ""
 	}
-|	"FROM" "FIRST"
+|	"FOR" "CHANNEL" StringName
+	{
+		$$ = $3
+	}
+
+ReplicaOrSlave:
+	"REPLICA"
 	{
 		$$ = false
 	}
-|	"FROM" "LAST"
+|	"SLAVE"
 	{
 		$$ = true
 	}
 
-TableRefsClause:
-	TableRefs
+StartReplicaStmt:
+	"START" ReplicaOrSlave ReplicaThreadTypeListOpt ReplicaUntilOpt ReplicaUserOpt ReplicaPasswordOpt ReplicaDefaultAuthOpt ReplicaPluginDirOpt ReplicationChannelOpt
 	{
-		$$ = &ast.TableRefsClause{TableRefs: $1.(*ast.Join)}
+		stmt := &ast.StartReplicaStmt{
+			Legacy:      $2.(bool),
+			Threads:     $3.(ast.ReplicaThreadType),
+			User:        $5,
+			Password:    $6,
+			DefaultAuth: $7,
+			PluginDir:   $8,
+			Channel:     $9,
+		}
+		if $4 != nil {
+			stmt.Until = $4.(*ast.ReplicaUntil)
+		}
+		$$ = stmt
 	}
 
-TableRefs:
-	EscapedTableRef
+StopReplicaStmt:
+	"STOP" ReplicaOrSlave ReplicaThreadTypeListOpt ReplicationChannelOpt
 	{
-		if j, ok := $1.(*ast.Join); ok {
-			// if $1 is Join, use it directly
-			$$ = j
-		} else {
-			$$ = &ast.Join{Left: $1.(ast.ResultSetNode), Right: nil}
+		$$ = &ast.StopReplicaStmt{
+			Legacy:  $2.(bool),
+			Threads: $3.(ast.ReplicaThreadType),
+			Channel: $4,
 		}
 	}
-|	TableRefs ',' EscapedTableRef
+
+ReplicaThreadTypeListOpt:
 	{
-		/* from a, b is default cross join */
-		$$ = &ast.Join{Left: $1.(ast.ResultSetNode), Right: $3.(ast.ResultSetNode), Tp: ast.CrossJoin}
+		$$ = ast.ReplicaThreadType(0)
 	}
+|	ReplicaThreadTypeList
 
-EscapedTableRef:
-	TableRef %prec lowerThanSetKeyword
-|	'{' Identifier TableRef '}'
+ReplicaThreadTypeList:
+	ReplicaThreadType
+|	ReplicaThreadTypeList ',' ReplicaThreadType
 	{
-		/*
-		 * ODBC escape syntax for outer join is { OJ join_table }
-		 * Use an Identifier for OJ
-		 */
-		$$ = $3
+		$$ = $1.(ast.ReplicaThreadType) | $3.(ast.ReplicaThreadType)
 	}
 
-TableRef:
-	TableFactor
-|	JoinTable
-
-TableFactor:
-	TableName PartitionNameListOpt TableAsNameOpt AsOfClauseOpt IndexHintListOpt TableSampleOpt
+ReplicaThreadType:
+	"IO_THREAD"
 	{
-		tn := $1.(*ast.TableName)
-		tn.PartitionNames = $2.([]model.CIStr)
-		tn.IndexHints = $5.([]*ast.IndexHint)
-		if $6 != nil {
-			tn.TableSample = $6.(*ast.TableSample)
-		}
-		if $4 != nil {
-			tn.AsOf = $4.(*ast.AsOfClause)
-		}
-		$$ = &ast.TableSource{Source: tn, AsName: $3.(model.CIStr)}
+		$$ = ast.ReplicaThreadIO
 	}
-|	SubSelect TableAsNameOpt
+|	"RELAY_THREAD"
 	{
-		resultNode := $1.(*ast.SubqueryExpr).Query
-		$$ = &ast.TableSource{Source: resultNode, AsName: $2.(model.CIStr)}
+		$$ = ast.ReplicaThreadIO
 	}
-|	'(' TableRefs ')'
+|	"SQL_THREAD"
 	{
-		j := $2.(*ast.Join)
-		j.ExplicitParens = true
-		$$ = $2
+		$$ = ast.ReplicaThreadSQL
 	}
 
-PartitionNameListOpt:
-	/* empty */
+ReplicaUntilOpt:
 	{
-		$$ = []model.CIStr{}
+		$$ = nil
 	}
-|	"PARTITION" '(' PartitionNameList ')'
+|	"UNTIL" "SQL_BEFORE_GTIDS" eq stringLit
 	{
-		$$ = $3
+		$$ = &ast.ReplicaUntil{Tp: ast.ReplicaUntilSQLBeforeGTIDs, GTIDSet: $4}
 	}
-
-TableAsNameOpt:
-	%prec empty
+|	"UNTIL" "SQL_AFTER_GTIDS" eq stringLit
 	{
-		$$ = model.CIStr{}
+		$$ = &ast.ReplicaUntil{Tp: ast.ReplicaUntilSQLAfterGTIDs, GTIDSet: $4}
 	}
-|	TableAsName
-
-TableAsName:
-	Identifier
+|	"UNTIL" ReplicaUntilSourceLogFile eq stringLit ',' ReplicaUntilSourceLogPos eq LengthNum
 	{
-		$$ = model.NewCIStr($1)
+		$$ = &ast.ReplicaUntil{Tp: ast.ReplicaUntilSourceLogPos, LogFile: $4, LogPos: $8.(uint64)}
 	}
-|	"AS" Identifier
+|	"UNTIL" "RELAY_LOG_FILE" eq stringLit ',' "RELAY_LOG_POS" eq LengthNum
 	{
-		$$ = model.NewCIStr($2)
+		$$ = &ast.ReplicaUntil{Tp: ast.ReplicaUntilRelayLogPos, LogFile: $4, LogPos: $8.(uint64)}
+	}
+|	"UNTIL" "SQL_AFTER_MTS_GAPS"
+	{
+		$$ = &ast.ReplicaUntil{Tp: ast.ReplicaUntilSQLAfterMTSGaps}
 	}
 
-IndexHintType:
-	"USE" KeyOrIndex
+ReplicaUntilSourceLogFile:
+	"SOURCE_LOG_FILE"
+|	"MASTER_LOG_FILE"
+
+ReplicaUntilSourceLogPos:
+	"SOURCE_LOG_POS"
+|	"MASTER_LOG_POS"
+
+ReplicaUserOpt:
 	{
-		$$ = ast.HintUse
+		$$ = ""
 	}
-|	"IGNORE" KeyOrIndex
+|	"USER" eq stringLit
 	{
-		$$ = ast.HintIgnore
+		$$ = $3
 	}
-|	"FORCE" KeyOrIndex
+
+ReplicaPasswordOpt:
 	{
-		$$ = ast.HintForce
+		$$ = ""
+	}
+|	"PASSWORD" eq stringLit
+	{
+		$$ = $3
 	}
 
-IndexHintScope:
+ReplicaDefaultAuthOpt:
 	{
-		$$ = ast.HintForScan
+		$$ = ""
 	}
-|	"FOR" "JOIN"
+|	"DEFAULT_AUTH" eq stringLit
 	{
-		$$ = ast.HintForJoin
+		$$ = $3
 	}
-|	"FOR" "ORDER" "BY"
+
+ReplicaPluginDirOpt:
 	{
-		$$ = ast.HintForOrderBy
+		$$ = ""
 	}
-|	"FOR" "GROUP" "BY"
+|	"PLUGIN_DIR" eq stringLit
 	{
-		$$ = ast.HintForGroupBy
+		$$ = $3
 	}
 
-IndexHint:
-	IndexHintType IndexHintScope '(' IndexNameList ')'
+ResetReplicaStmt:
+	"RESET" ReplicaOrSlave ReplicationChannelOpt
 	{
-		$$ = &ast.IndexHint{
-			IndexNames: $4.([]model.CIStr),
-			HintType:   $1.(ast.IndexHintType),
-			HintScope:  $2.(ast.IndexHintScope),
+		$$ = &ast.ResetReplicaStmt{
+			Legacy:  $2.(bool),
+			Channel: $3,
+		}
+	}
+|	"RESET" ReplicaOrSlave "ALL" ReplicationChannelOpt
+	{
+		$$ = &ast.ResetReplicaStmt{
+			Legacy:  $2.(bool),
+			All:     true,
+			Channel: $4,
 		}
 	}
 
-IndexNameList:
+ResetPersistStmt:
+	"RESET" "PERSIST"
 	{
-		var nameList []model.CIStr
-		$$ = nameList
+		$$ = &ast.ResetPersistStmt{}
 	}
-|	Identifier
+|	"RESET" "PERSIST" IfExists VariableName
 	{
-		$$ = []model.CIStr{model.NewCIStr($1)}
+		$$ = &ast.ResetPersistStmt{IfExists: $3.(bool), Name: $4}
 	}
-|	IndexNameList ',' Identifier
+
+ResetMasterStmt:
+	"RESET" "MASTER"
 	{
-		$$ = append($1.([]model.CIStr), model.NewCIStr($3))
+		$$ = &ast.ResetMasterStmt{}
 	}
-|	"PRIMARY"
+|	"RESET" "MASTER" "TO" LengthNum
+	{
+		if $4.(uint64) == 0 {
+			yylex.AppendError(yylex.Errorf("The binary log file index number of RESET MASTER must be positive"))
+			return 1
+		}
+		$$ = &ast.ResetMasterStmt{To: $4.(uint64)}
+	}
+
+PurgeBinaryLogsStmt:
+	"PURGE" BinaryOrMaster "LOGS" "TO" stringLit
+	{
+		$$ = &ast.PurgeBinaryLogsStmt{Legacy: $2.(bool), To: $5}
+	}
+|	"PURGE" BinaryOrMaster "LOGS" "BEFORE" Expression
+	{
+		$$ = &ast.PurgeBinaryLogsStmt{Legacy: $2.(bool), Before: $5}
+	}
+
+/*******************************************************************
+ *
+ *  Sharding Table Rule Statements
+ *
+ *  Example:
+ *	CREATE SHARDING TABLE RULE IF NOT EXISTS t_order (
+ *		TOPOLOGY = 'db_${0000..0007}.t_order_${0000..0127}',
+ *		SHARDING_COLUMNS = (uid),
+ *		DATABASE_ALGORITHM = (TYPE = 'mod', PROPERTIES = ('divisor' = '8')),
+ *		TABLE_ALGORITHM = (TYPE = 'mod', PROPERTIES = ('divisor' = '128')),
+ *		KEY_GENERATOR = (COLUMN = id, TYPE = 'snowflake')
+ *	)
+ *	ALTER SHARDING TABLE RULE t_order (TABLE_ALGORITHM = (TYPE = 'hash'))
+ *	DROP SHARDING TABLE RULE IF EXISTS t_order, t_user
+ *
+ *******************************************************************/
+CreateShardingTableRuleStmt:
+	"CREATE" "SHARDING" "TABLE" "RULE" IfNotExists ShardingTableRuleList
 	{
-		$$ = []model.CIStr{model.NewCIStr($1)}
+		$$ = &ast.CreateShardingTableRuleStmt{
+			IfNotExists: $5.(bool),
+			Rules:       $6.([]*ast.ShardingTableRule),
+		}
 	}
-|	IndexNameList ',' "PRIMARY"
+
+AlterShardingTableRuleStmt:
+	"ALTER" "SHARDING" "TABLE" "RULE" ShardingTableRuleList
 	{
-		$$ = append($1.([]model.CIStr), model.NewCIStr($3))
+		$$ = &ast.AlterShardingTableRuleStmt{Rules: $5.([]*ast.ShardingTableRule)}
 	}
 
-IndexHintList:
-	IndexHint
+DropShardingTableRuleStmt:
+	"DROP" "SHARDING" "TABLE" "RULE" IfExists TableNameList
 	{
-		$$ = []*ast.IndexHint{$1.(*ast.IndexHint)}
+		$$ = &ast.DropShardingTableRuleStmt{
+			IfExists: $5.(bool),
+			Tables:   $6.([]*ast.TableName),
+		}
 	}
-|	IndexHintList IndexHint
+
+ShardingTableRuleList:
+	ShardingTableRule
 	{
-		$$ = append($1.([]*ast.IndexHint), $2.(*ast.IndexHint))
+		$$ = []*ast.ShardingTableRule{$1.(*ast.ShardingTableRule)}
 	}
-
-IndexHintListOpt:
+|	ShardingTableRuleList ',' ShardingTableRule
 	{
-		$$ = []*ast.IndexHint{}
+		$$ = append($1.([]*ast.ShardingTableRule), $3.(*ast.ShardingTableRule))
 	}
-|	IndexHintList
 
-JoinTable:
-	/* Use %prec to evaluate production TableRef before cross join */
-	TableRef CrossOpt TableRef %prec tableRefPriority
+ShardingTableRule:
+	TableName '(' ShardingTableRuleOptionList ')'
 	{
-		$$ = ast.NewCrossJoin($1.(ast.ResultSetNode), $3.(ast.ResultSetNode))
+		$$ = &ast.ShardingTableRule{
+			Table:   $1.(*ast.TableName),
+			Options: $3.([]*ast.ShardingTableRuleOption),
+		}
 	}
-|	TableRef CrossOpt TableRef "ON" Expression
+
+ShardingTableRuleOptionList:
+	ShardingTableRuleOption
 	{
-		on := &ast.OnCondition{Expr: $5}
-		$$ = &ast.Join{Left: $1.(ast.ResultSetNode), Right: $3.(ast.ResultSetNode), Tp: ast.CrossJoin, On: on}
+		$$ = []*ast.ShardingTableRuleOption{$1.(*ast.ShardingTableRuleOption)}
 	}
-|	TableRef CrossOpt TableRef "USING" '(' ColumnNameList ')'
+|	ShardingTableRuleOptionList ',' ShardingTableRuleOption
 	{
-		$$ = &ast.Join{Left: $1.(ast.ResultSetNode), Right: $3.(ast.ResultSetNode), Tp: ast.CrossJoin, Using: $6.([]*ast.ColumnName)}
+		$$ = append($1.([]*ast.ShardingTableRuleOption), $3.(*ast.ShardingTableRuleOption))
 	}
-|	TableRef JoinType OuterOpt "JOIN" TableRef "ON" Expression
+
+ShardingTableRuleOption:
+	"TOPOLOGY" EqOpt stringLit
 	{
-		on := &ast.OnCondition{Expr: $7}
-		$$ = &ast.Join{Left: $1.(ast.ResultSetNode), Right: $5.(ast.ResultSetNode), Tp: $2.(ast.JoinType), On: on}
+		topology, err := ast.ParseShardingTopology($3)
+		if err != nil {
+			yylex.AppendError(err)
+			return 1
+		}
+		$$ = &ast.ShardingTableRuleOption{Tp: ast.ShardingTableRuleOptionTopology, Topology: topology}
 	}
-|	TableRef JoinType OuterOpt "JOIN" TableRef "USING" '(' ColumnNameList ')'
+|	"SHARDING_COLUMNS" EqOpt '(' IdentList ')'
 	{
-		$$ = &ast.Join{Left: $1.(ast.ResultSetNode), Right: $5.(ast.ResultSetNode), Tp: $2.(ast.JoinType), Using: $8.([]*ast.ColumnName)}
+		$$ = &ast.ShardingTableRuleOption{Tp: ast.ShardingTableRuleOptionColumns, Columns: $4.([]model.CIStr)}
 	}
-|	TableRef "NATURAL" "JOIN" TableRef
+|	"DATABASE_ALGORITHM" EqOpt '(' ShardingAlgorithm ')'
 	{
-		$$ = &ast.Join{Left: $1.(ast.ResultSetNode), Right: $4.(ast.ResultSetNode), NaturalJoin: true}
+		$$ = &ast.ShardingTableRuleOption{Tp: ast.ShardingTableRuleOptionDatabaseAlgorithm, Algorithm: $4.(*ast.ShardingAlgorithm)}
 	}
-|	TableRef "NATURAL" JoinType OuterOpt "JOIN" TableRef
+|	"TABLE_ALGORITHM" EqOpt '(' ShardingAlgorithm ')'
 	{
-		$$ = &ast.Join{Left: $1.(ast.ResultSetNode), Right: $6.(ast.ResultSetNode), Tp: $3.(ast.JoinType), NaturalJoin: true}
+		$$ = &ast.ShardingTableRuleOption{Tp: ast.ShardingTableRuleOptionTableAlgorithm, Algorithm: $4.(*ast.ShardingAlgorithm)}
 	}
-|	TableRef "STRAIGHT_JOIN" TableRef
+|	"KEY_GENERATOR" EqOpt '(' "COLUMN" EqOpt Identifier ',' ShardingAlgorithm ')'
 	{
-		$$ = &ast.Join{Left: $1.(ast.ResultSetNode), Right: $3.(ast.ResultSetNode), StraightJoin: true}
+		$$ = &ast.ShardingTableRuleOption{
+			Tp: ast.ShardingTableRuleOptionKeyGenerator,
+			KeyGenerator: &ast.ShardingKeyGenerator{
+				Column:    model.NewCIStr($6),
+				Algorithm: $8.(*ast.ShardingAlgorithm),
+			},
+		}
 	}
-|	TableRef "STRAIGHT_JOIN" TableRef "ON" Expression
+
+ShardingAlgorithm:
+	"TYPE" EqOpt stringLit ShardingPropertiesOpt
 	{
-		on := &ast.OnCondition{Expr: $5}
-		$$ = &ast.Join{Left: $1.(ast.ResultSetNode), Right: $3.(ast.ResultSetNode), StraightJoin: true, On: on}
+		$$ = &ast.ShardingAlgorithm{Type: $3, Properties: $4.([]*ast.ShardingProperty)}
 	}
 
-JoinType:
-	"LEFT"
+ShardingPropertiesOpt:
 	{
-		$$ = ast.LeftJoin
+		$$ = []*ast.ShardingProperty(nil)
 	}
-|	"RIGHT"
+|	',' "PROPERTIES" EqOpt '(' ShardingPropertyList ')'
 	{
-		$$ = ast.RightJoin
+		$$ = $5
 	}
 
-OuterOpt:
-	{}
-|	"OUTER"
-
-CrossOpt:
-	"JOIN"
-|	"CROSS" "JOIN"
-|	"INNER" "JOIN"
-
-LimitClause:
+ShardingPropertyList:
+	ShardingProperty
 	{
-		$$ = nil
+		$$ = []*ast.ShardingProperty{$1.(*ast.ShardingProperty)}
 	}
-|	"LIMIT" LimitOption
+|	ShardingPropertyList ',' ShardingProperty
 	{
-		$$ = &ast.Limit{Count: $2.(ast.ValueExpr)}
+		$$ = append($1.([]*ast.ShardingProperty), $3.(*ast.ShardingProperty))
 	}
 
-LimitOption:
-	LengthNum
+ShardingProperty:
+	stringLit eq stringLit
 	{
-		$$ = ast.NewValueExpr($1, parser.charset, parser.collation)
+		$$ = &ast.ShardingProperty{Key: $1, Value: $3}
 	}
-|	paramMarker
+
+/*******************************************************************
+ *
+ *  Tenant and Node Statements
+ *
+ *  Example:
+ *	CREATE TENANT IF NOT EXISTS t1
+ *	ALTER TENANT t1 ADD USER 'arana' IDENTIFIED BY '123456', DROP USER IF EXISTS 'guest'
+ *	CREATE NODE node0 (HOST = '127.0.0.1', PORT = 3306, USERNAME = 'root', PASSWORD = '123456', WEIGHT = 10, LABELS = ('zone' = 'a')) FROM t1
+ *	DROP NODE IF EXISTS node0 FROM t1
+ *
+ *******************************************************************/
+CreateTenantStmt:
+	"CREATE" "TENANT" IfNotExists Tenant
 	{
-		paramExpr := ast.NewParamMarkerExpr(yyS[yypt].offset)
-		paramExpr.SetOrder(parser.paramMarkerCursor)
-		parser.paramMarkerCursor++
-		$$ = paramExpr
+		$$ = &ast.CreateTenantStmt{IfNotExists: $3.(bool), Name: $4}
 	}
 
-RowOrRows:
-	"ROW"
-|	"ROWS"
-
-FirstOrNext:
-	"FIRST"
-|	"NEXT"
+AlterTenantStmt:
+	"ALTER" "TENANT" Tenant AlterTenantSpecList
+	{
+		$$ = &ast.AlterTenantStmt{Name: $3, Specs: $4.([]*ast.AlterTenantSpec)}
+	}
 
-FetchFirstOpt:
+AlterTenantSpecList:
+	AlterTenantSpec
 	{
-		$$ = ast.NewValueExpr(uint64(1), parser.charset, parser.collation)
+		$$ = []*ast.AlterTenantSpec{$1.(*ast.AlterTenantSpec)}
+	}
+|	AlterTenantSpecList ',' AlterTenantSpec
+	{
+		$$ = append($1.([]*ast.AlterTenantSpec), $3.(*ast.AlterTenantSpec))
 	}
-|	LimitOption
 
-SelectStmtLimit:
-	"LIMIT" LimitOption
+AlterTenantSpec:
+	"ADD" "USER" IfNotExists StringName "IDENTIFIED" "BY" stringLit
 	{
-		$$ = &ast.Limit{Count: $2.(ast.ExprNode)}
+		$$ = &ast.AlterTenantSpec{Tp: ast.AlterTenantAddUser, IfNotExists: $3.(bool), User: $4, Password: $7}
 	}
-|	"LIMIT" LimitOption ',' LimitOption
+|	"ALTER" "USER" StringName "IDENTIFIED" "BY" stringLit
 	{
-		$$ = &ast.Limit{Offset: $2.(ast.ExprNode), Count: $4.(ast.ExprNode)}
+		$$ = &ast.AlterTenantSpec{Tp: ast.AlterTenantAlterUser, User: $3, Password: $6}
 	}
-|	"LIMIT" LimitOption "OFFSET" LimitOption
+|	"DROP" "USER" IfExists StringName
 	{
-		$$ = &ast.Limit{Offset: $4.(ast.ExprNode), Count: $2.(ast.ExprNode)}
+		$$ = &ast.AlterTenantSpec{Tp: ast.AlterTenantDropUser, IfExists: $3.(bool), User: $4}
 	}
-|	"FETCH" FirstOrNext FetchFirstOpt RowOrRows "ONLY"
+|	"RENAME" "TO" Tenant
 	{
-		$$ = &ast.Limit{Count: $3.(ast.ExprNode)}
+		$$ = &ast.AlterTenantSpec{Tp: ast.AlterTenantRename, NewName: $3}
 	}
 
-SelectStmtLimitOpt:
+DropTenantStmt:
+	"DROP" "TENANT" IfExists Tenant
 	{
-		$$ = nil
+		$$ = &ast.DropTenantStmt{IfExists: $3.(bool), Name: $4}
 	}
-|	SelectStmtLimit
 
-SelectStmtOpt:
-	TableOptimizerHints
+CreateNodeStmt:
+	"CREATE" "NODE" IfNotExists Identifier '(' NodeOptionList ')' "FROM" Tenant
 	{
-		opt := &ast.SelectStmtOpts{}
-		opt.SQLCache = true
-		opt.TableHints = $1.([]*ast.TableOptimizerHint)
-		$$ = opt
+		$$ = &ast.CreateNodeStmt{
+			IfNotExists: $3.(bool),
+			Name:        $4,
+			Options:     $6.([]*ast.NodeOption),
+			Tenant:      $9,
+		}
 	}
-|	DistinctOpt
+
+AlterNodeStmt:
+	"ALTER" "NODE" Identifier '(' NodeOptionList ')' "FROM" Tenant
 	{
-		opt := &ast.SelectStmtOpts{}
-		opt.SQLCache = true
-		if $1.(bool) {
-			opt.Distinct = true
-		} else {
-			opt.Distinct = false
-			opt.ExplicitAll = true
+		$$ = &ast.AlterNodeStmt{
+			Name:    $3,
+			Options: $5.([]*ast.NodeOption),
+			Tenant:  $8,
 		}
-		$$ = opt
 	}
-|	Priority
+
+DropNodeStmt:
+	"DROP" "NODE" IfExists Identifier "FROM" Tenant
 	{
-		opt := &ast.SelectStmtOpts{}
-		opt.SQLCache = true
-		opt.Priority = $1.(mysql.PriorityEnum)
-		$$ = opt
+		$$ = &ast.DropNodeStmt{
+			IfExists: $3.(bool),
+			Name:     $4,
+			Tenant:   $6,
+		}
 	}
-|	"SQL_SMALL_RESULT"
+
+NodeOptionList:
+	NodeOption
 	{
-		opt := &ast.SelectStmtOpts{}
-		opt.SQLCache = true
-		opt.SQLSmallResult = true
-		$$ = opt
+		$$ = []*ast.NodeOption{$1.(*ast.NodeOption)}
 	}
-|	"SQL_BIG_RESULT"
+|	NodeOptionList ',' NodeOption
 	{
-		opt := &ast.SelectStmtOpts{}
-		opt.SQLCache = true
-		opt.SQLBigResult = true
-		$$ = opt
+		$$ = append($1.([]*ast.NodeOption), $3.(*ast.NodeOption))
 	}
-|	"SQL_BUFFER_RESULT"
+
+NodeOption:
+	"HOST" EqOpt stringLit
 	{
-		opt := &ast.SelectStmtOpts{}
-		opt.SQLCache = true
-		opt.SQLBufferResult = true
-		$$ = opt
+		$$ = &ast.NodeOption{Tp: ast.NodeOptionHost, StrValue: $3}
 	}
-|	SelectStmtSQLCache
+|	"PORT" EqOpt LengthNum
 	{
-		opt := &ast.SelectStmtOpts{}
-		opt.SQLCache = $1.(bool)
-		$$ = opt
+		if $3.(uint64) > math.MaxUint16 {
+			yylex.AppendError(yylex.Errorf("The port of a node must be less than 65536"))
+			return 1
+		}
+		$$ = &ast.NodeOption{Tp: ast.NodeOptionPort, UintValue: $3.(uint64)}
 	}
-|	"SQL_CALC_FOUND_ROWS"
+|	"USERNAME" EqOpt stringLit
 	{
-		opt := &ast.SelectStmtOpts{}
-		opt.SQLCache = true
-		opt.CalcFoundRows = true
-		$$ = opt
+		$$ = &ast.NodeOption{Tp: ast.NodeOptionUsername, StrValue: $3}
 	}
-|	"STRAIGHT_JOIN"
+|	"PASSWORD" EqOpt stringLit
 	{
-		opt := &ast.SelectStmtOpts{}
-		opt.SQLCache = true
-		opt.StraightJoin = true
-		$$ = opt
+		$$ = &ast.NodeOption{Tp: ast.NodeOptionPassword, StrValue: $3}
 	}
-
-SelectStmtOpts:
-	%prec empty
+|	"WEIGHT" EqOpt LengthNum
 	{
-		opt := &ast.SelectStmtOpts{}
-		opt.SQLCache = true
-		$$ = opt
+		$$ = &ast.NodeOption{Tp: ast.NodeOptionWeight, UintValue: $3.(uint64)}
 	}
-|	SelectStmtOptsList %prec lowerThanSelectOpt
-
-SelectStmtOptsList:
-	SelectStmtOptsList SelectStmtOpt
+|	"LABELS" EqOpt '(' NodeLabelList ')'
 	{
-		opts := $1.(*ast.SelectStmtOpts)
-		opt := $2.(*ast.SelectStmtOpts)
-
-		// Merge options.
-		// Always use the first hint.
-		if opt.TableHints != nil && opts.TableHints == nil {
-			opts.TableHints = opt.TableHints
-		}
-		if opt.Distinct {
-			opts.Distinct = true
-		}
-		if opt.Priority != mysql.NoPriority {
-			opts.Priority = opt.Priority
-		}
-		if opt.SQLSmallResult {
-			opts.SQLSmallResult = true
-		}
-		if opt.SQLBigResult {
-			opts.SQLBigResult = true
-		}
-		if opt.SQLBufferResult {
-			opts.SQLBufferResult = true
-		}
-		if !opt.SQLCache {
-			opts.SQLCache = false
-		}
-		if opt.CalcFoundRows {
-			opts.CalcFoundRows = true
-		}
-		if opt.StraightJoin {
-			opts.StraightJoin = true
-		}
-		if opt.ExplicitAll {
-			opts.ExplicitAll = true
-		}
-
-		if opts.Distinct && opts.ExplicitAll {
-			yylex.AppendError(ErrWrongUsage.GenWithStackByArgs("ALL", "DISTINCT"))
-			return 1
-		}
-
-		$$ = opts
+		$$ = &ast.NodeOption{Tp: ast.NodeOptionLabels, Labels: $4.([]*ast.NodeLabel)}
 	}
-|	SelectStmtOpt
 
-TableOptimizerHints:
-	hintComment
+NodeLabelList:
+	NodeLabel
 	{
-		hints, warns := parser.parseHint($1)
-		for _, w := range warns {
-			yylex.AppendError(w)
-			parser.lastErrorAsWarn()
-		}
-		$$ = hints
+		$$ = []*ast.NodeLabel{$1.(*ast.NodeLabel)}
 	}
-
-TableOptimizerHintsOpt:
-	/* empty */
+|	NodeLabelList ',' NodeLabel
 	{
-		$$ = nil
+		$$ = append($1.([]*ast.NodeLabel), $3.(*ast.NodeLabel))
 	}
-|	TableOptimizerHints
 
-SelectStmtSQLCache:
-	"SQL_CACHE"
+NodeLabel:
+	stringLit eq stringLit
 	{
-		$$ = true
+		$$ = &ast.NodeLabel{Key: $1, Value: $3}
 	}
-|	"SQL_NO_CACHE"
+
+/*******************************************************************
+ *
+ *  DB Group Statements
+ *
+ *  Example:
+ *	CREATE DB GROUP employees_0000 (
+ *		PRIMARY = node0,
+ *		REPLICAS = (node1 WEIGHT 10, node2 WEIGHT 5),
+ *		LOAD_BALANCE = WEIGHT,
+ *		TRANSACTION_ROUTING = PRIMARY
+ *	) FROM t1
+ *	ALTER DB GROUP employees_0000 (REPLICAS = (node1 WEIGHT 5, node2 WEIGHT 10)) FROM t1
+ *	DROP DB GROUP IF EXISTS employees_0000 FROM t1
+ *
+ *******************************************************************/
+CreateDBGroupStmt:
+	"CREATE" "DB" "GROUP" IfNotExists Identifier '(' DBGroupOptionList ')' "FROM" Tenant
 	{
-		$$ = false
+		$$ = &ast.CreateDBGroupStmt{
+			IfNotExists: $4.(bool),
+			Name:        $5,
+			Options:     $7.([]*ast.DBGroupOption),
+			Tenant:      $10,
+		}
 	}
 
-SelectStmtFieldList:
-	FieldList
+AlterDBGroupStmt:
+	"ALTER" "DB" "GROUP" Identifier '(' DBGroupOptionList ')' "FROM" Tenant
 	{
-		$$ = &ast.FieldList{Fields: $1.([]*ast.SelectField)}
+		$$ = &ast.AlterDBGroupStmt{
+			Name:    $4,
+			Options: $6.([]*ast.DBGroupOption),
+			Tenant:  $9,
+		}
 	}
 
-SelectStmtGroup:
-	/* EMPTY */
+DropDBGroupStmt:
+	"DROP" "DB" "GROUP" IfExists Identifier "FROM" Tenant
 	{
-		$$ = nil
+		$$ = &ast.DropDBGroupStmt{
+			IfExists: $4.(bool),
+			Name:     $5,
+			Tenant:   $7,
+		}
 	}
-|	GroupByClause
 
-SelectStmtIntoOption:
+DBGroupOptionList:
+	DBGroupOption
 	{
-		$$ = nil
+		$$ = []*ast.DBGroupOption{$1.(*ast.DBGroupOption)}
 	}
-|	"INTO" "OUTFILE" stringLit Fields Lines
+|	DBGroupOptionList ',' DBGroupOption
 	{
-		x := &ast.SelectIntoOption{
-			Tp:       ast.SelectIntoOutfile,
-			FileName: $3,
-		}
-		if $4 != nil {
-			x.FieldsInfo = $4.(*ast.FieldsClause)
-		}
-		if $5 != nil {
-			x.LinesInfo = $5.(*ast.LinesClause)
-		}
-
-		$$ = x
+		$$ = append($1.([]*ast.DBGroupOption), $3.(*ast.DBGroupOption))
 	}
 
-// See https://dev.mysql.com/doc/refman/5.7/en/subqueries.html
-SubSelect:
-	'(' SelectStmt ')'
+DBGroupOption:
+	"PRIMARY" EqOpt Identifier
 	{
-		rs := $2.(*ast.SelectStmt)
-		endOffset := parser.endOffset(&yyS[yypt])
-		parser.setLastSelectFieldText(rs, endOffset)
-		src := parser.src
-		// See the implementation of yyParse function
-		rs.SetText(parser.lexer.client, src[yyS[yypt-1].offset:yyS[yypt].offset])
-		$$ = &ast.SubqueryExpr{Query: rs}
+		$$ = &ast.DBGroupOption{Tp: ast.DBGroupOptionPrimary, Primary: $3}
 	}
-|	'(' SetOprStmt ')'
+|	"REPLICAS" EqOpt '(' DBGroupReplicaListOpt ')'
 	{
-		rs := $2.(*ast.SetOprStmt)
-		src := parser.src
-		rs.SetText(parser.lexer.client, src[yyS[yypt-1].offset:yyS[yypt].offset])
-		$$ = &ast.SubqueryExpr{Query: rs}
+		$$ = &ast.DBGroupOption{Tp: ast.DBGroupOptionReplicas, Replicas: $4.([]*ast.DBGroupReplica)}
 	}
-|	'(' SelectStmtWithClause ')'
+|	"LOAD_BALANCE" EqOpt Identifier
 	{
-		rs := $2.(*ast.SelectStmt)
-		endOffset := parser.endOffset(&yyS[yypt])
-		parser.setLastSelectFieldText(rs, endOffset)
-		src := parser.src
-		// See the implementation of yyParse function
-		rs.SetText(parser.lexer.client, src[yyS[yypt-1].offset:yyS[yypt].offset])
-		$$ = &ast.SubqueryExpr{Query: rs}
+		var lb ast.DBGroupLoadBalance
+		switch strings.ToLower($3) {
+		case "random":
+			lb = ast.DBGroupLoadBalanceRandom
+		case "round_robin":
+			lb = ast.DBGroupLoadBalanceRoundRobin
+		case "weight":
+			lb = ast.DBGroupLoadBalanceWeight
+		default:
+			yylex.AppendError(yylex.Errorf("Unknown load balance strategy '%s'", $3))
+			return 1
+		}
+		$$ = &ast.DBGroupOption{Tp: ast.DBGroupOptionLoadBalance, LoadBalance: lb}
 	}
-|	'(' SubSelect ')'
+|	"TRANSACTION_ROUTING" EqOpt "PRIMARY"
 	{
-		subQuery := $2.(*ast.SubqueryExpr).Query
-		isRecursive := true
-		// remove redundant brackets like '((select 1))'
-		for isRecursive {
-			if _, isRecursive = subQuery.(*ast.SubqueryExpr); isRecursive {
-				subQuery = subQuery.(*ast.SubqueryExpr).Query
-			}
-		}
-		switch rs := subQuery.(type) {
-		case *ast.SelectStmt:
-			endOffset := parser.endOffset(&yyS[yypt])
-			parser.setLastSelectFieldText(rs, endOffset)
-			src := parser.src
-			rs.SetText(parser.lexer.client, src[yyS[yypt-1].offset:yyS[yypt].offset])
-			$$ = &ast.SubqueryExpr{Query: rs}
-		case *ast.SetOprStmt:
-			src := parser.src
-			rs.SetText(parser.lexer.client, src[yyS[yypt-1].offset:yyS[yypt].offset])
-			$$ = &ast.SubqueryExpr{Query: rs}
+		$$ = &ast.DBGroupOption{Tp: ast.DBGroupOptionTxnRouting, TxnRouting: ast.DBGroupTxnRoutingPrimary}
+	}
+|	"TRANSACTION_ROUTING" EqOpt Identifier
+	{
+		var routing ast.DBGroupTxnRouting
+		switch strings.ToLower($3) {
+		case "sticky":
+			routing = ast.DBGroupTxnRoutingSticky
+		case "dynamic":
+			routing = ast.DBGroupTxnRoutingDynamic
+		default:
+			yylex.AppendError(yylex.Errorf("Unknown transaction routing policy '%s'", $3))
+			return 1
 		}
+		$$ = &ast.DBGroupOption{Tp: ast.DBGroupOptionTxnRouting, TxnRouting: routing}
 	}
 
-// See https://dev.mysql.com/doc/refman/8.0/en/innodb-locking-reads.html
-SelectLockOpt:
-	/* empty */
+DBGroupReplicaListOpt:
 	{
-		$$ = nil
+		$$ = []*ast.DBGroupReplica{}
 	}
-|	"FOR" "UPDATE" OfTablesOpt
+|	DBGroupReplicaList
+
+DBGroupReplicaList:
+	DBGroupReplica
 	{
-		$$ = &ast.SelectLockInfo{
-			LockType: ast.SelectLockForUpdate,
-			Tables:   $3.([]*ast.TableName),
-		}
+		$$ = []*ast.DBGroupReplica{$1.(*ast.DBGroupReplica)}
 	}
-|	"FOR" "SHARE" OfTablesOpt
+|	DBGroupReplicaList ',' DBGroupReplica
 	{
-		$$ = &ast.SelectLockInfo{
-			LockType: ast.SelectLockForShare,
-			Tables:   $3.([]*ast.TableName),
-		}
+		$$ = append($1.([]*ast.DBGroupReplica), $3.(*ast.DBGroupReplica))
 	}
-|	"FOR" "UPDATE" OfTablesOpt "NOWAIT"
+
+DBGroupReplica:
+	Identifier
 	{
-		$$ = &ast.SelectLockInfo{
-			LockType: ast.SelectLockForUpdateNoWait,
-			Tables:   $3.([]*ast.TableName),
-		}
+		$$ = &ast.DBGroupReplica{Node: $1, Weight: ast.DefaultDBGroupReplicaWeight}
 	}
-|	"FOR" "UPDATE" OfTablesOpt "WAIT" NUM
+|	Identifier "WEIGHT" LengthNum
 	{
-		$$ = &ast.SelectLockInfo{
-			LockType: ast.SelectLockForUpdateWaitN,
-			WaitSec:  getUint64FromNUM($5),
-			Tables:   $3.([]*ast.TableName),
-		}
+		$$ = &ast.DBGroupReplica{Node: $1, Weight: $3.(uint64)}
 	}
-|	"FOR" "SHARE" OfTablesOpt "NOWAIT"
+
+/*******************************************************************
+ *
+ *  Shadow Rule Statements
+ *
+ *  Example:
+ *	CREATE SHADOW RULE student (
+ *		SHADOW_GROUP = employees_shadow,
+ *		MATCH uid = 10000 ON (INSERT, UPDATE),
+ *		MATCH name REGEXP '^hanmeimei$' ON (DELETE),
+ *		MATCH HINT ON (SELECT)
+ *	)
+ *	DROP SHADOW RULE IF EXISTS student
+ *
+ *******************************************************************/
+CreateShadowRuleStmt:
+	"CREATE" "SHADOW" "RULE" IfNotExists ShadowRuleList
 	{
-		$$ = &ast.SelectLockInfo{
-			LockType: ast.SelectLockForShareNoWait,
-			Tables:   $3.([]*ast.TableName),
+		$$ = &ast.CreateShadowRuleStmt{
+			IfNotExists: $4.(bool),
+			Rules:       $5.([]*ast.ShadowRule),
 		}
 	}
-|	"FOR" "UPDATE" OfTablesOpt "SKIP" "LOCKED"
+
+AlterShadowRuleStmt:
+	"ALTER" "SHADOW" "RULE" ShadowRuleList
 	{
-		$$ = &ast.SelectLockInfo{
-			LockType: ast.SelectLockForUpdateSkipLocked,
-			Tables:   $3.([]*ast.TableName),
-		}
+		$$ = &ast.AlterShadowRuleStmt{Rules: $4.([]*ast.ShadowRule)}
 	}
-|	"FOR" "SHARE" OfTablesOpt "SKIP" "LOCKED"
+
+DropShadowRuleStmt:
+	"DROP" "SHADOW" "RULE" IfExists TableNameList
 	{
-		$$ = &ast.SelectLockInfo{
-			LockType: ast.SelectLockForShareSkipLocked,
-			Tables:   $3.([]*ast.TableName),
+		$$ = &ast.DropShadowRuleStmt{
+			IfExists: $4.(bool),
+			Tables:   $5.([]*ast.TableName),
 		}
 	}
-|	"LOCK" "IN" "SHARE" "MODE"
+
+ShadowRuleList:
+	ShadowRule
 	{
-		$$ = &ast.SelectLockInfo{
-			LockType: ast.SelectLockForShare,
-			Tables:   []*ast.TableName{},
-		}
+		$$ = []*ast.ShadowRule{$1.(*ast.ShadowRule)}
 	}
-
-OfTablesOpt:
-	/* empty */
+|	ShadowRuleList ',' ShadowRule
 	{
-		$$ = []*ast.TableName{}
+		$$ = append($1.([]*ast.ShadowRule), $3.(*ast.ShadowRule))
 	}
-|	"OF" TableNameList
+
+ShadowRule:
+	TableName '(' "SHADOW_GROUP" EqOpt Identifier ShadowMatcherListOpt ')'
 	{
-		$$ = $2.([]*ast.TableName)
+		$$ = &ast.ShadowRule{
+			Table:    $1.(*ast.TableName),
+			Group:    $5,
+			Matchers: $6.([]*ast.ShadowMatcher),
+		}
 	}
 
-SetOprStmt:
-	SetOprStmtWoutLimitOrderBy
-|	SetOprStmtWithLimitOrderBy
-|	WithClause SetOprStmtWithLimitOrderBy
+ShadowMatcherListOpt:
 	{
-		setOpr := $2.(*ast.SetOprStmt)
-		setOpr.With = $1.(*ast.WithClause)
-		$$ = setOpr
+		$$ = []*ast.ShadowMatcher(nil)
 	}
-|	WithClause SetOprStmtWoutLimitOrderBy
+|	ShadowMatcherListOpt ',' ShadowMatcher
 	{
-		setOpr := $2.(*ast.SetOprStmt)
-		setOpr.With = $1.(*ast.WithClause)
-		$$ = setOpr
+		$$ = append($1.([]*ast.ShadowMatcher), $3.(*ast.ShadowMatcher))
 	}
 
-// See https://dev.mysql.com/doc/refman/5.7/en/union.html
-// See https://mariadb.com/kb/en/intersect/
-// See https://mariadb.com/kb/en/except/
-SetOprStmtWoutLimitOrderBy:
-	SetOprClauseList SetOpr SelectStmt
+ShadowMatcher:
+	"MATCH" Identifier eq SignedLiteral ShadowOperationsOpt
 	{
-		setOprList1 := $1.([]ast.Node)
-		if sel, isSelect := setOprList1[len(setOprList1)-1].(*ast.SelectStmt); isSelect && !sel.IsInBraces {
-			endOffset := parser.endOffset(&yyS[yypt-1])
-			parser.setLastSelectFieldText(sel, endOffset)
+		$$ = &ast.ShadowMatcher{
+			Tp:         ast.ShadowMatcherValue,
+			Column:     model.NewCIStr($2),
+			Value:      $4,
+			Operations: $5.(ast.ShadowOperation),
 		}
-		setOpr := &ast.SetOprStmt{SelectList: &ast.SetOprSelectList{Selects: $1.([]ast.Node)}}
-		st := $3.(*ast.SelectStmt)
-		setOpr.Limit = st.Limit
-		setOpr.OrderBy = st.OrderBy
-		st.Limit = nil
-		st.OrderBy = nil
-		st.AfterSetOperator = $2.(*ast.SetOprType)
-		setOpr.SelectList.Selects = append(setOpr.SelectList.Selects, st)
-		$$ = setOpr
 	}
-|	SetOprClauseList SetOpr SubSelect
+|	"MATCH" Identifier "REGEXP" stringLit ShadowOperationsOpt
 	{
-		setOprList1 := $1.([]ast.Node)
-		if sel, isSelect := setOprList1[len(setOprList1)-1].(*ast.SelectStmt); isSelect && !sel.IsInBraces {
-			endOffset := parser.endOffset(&yyS[yypt-1])
-			parser.setLastSelectFieldText(sel, endOffset)
+		if _, err := regexp.Compile($4); err != nil {
+			yylex.AppendError(yylex.Errorf("Invalid regular expression '%s' of shadow matcher", $4))
+			return 1
 		}
-		var setOprList2 []ast.Node
-		var with2 *ast.WithClause
-		switch x := $3.(*ast.SubqueryExpr).Query.(type) {
-		case *ast.SelectStmt:
-			setOprList2 = []ast.Node{x}
-			with2 = x.With
-		case *ast.SetOprStmt:
-			setOprList2 = x.SelectList.Selects
-			with2 = x.With
+		$$ = &ast.ShadowMatcher{
+			Tp:         ast.ShadowMatcherRegexp,
+			Column:     model.NewCIStr($2),
+			Regexp:     $4,
+			Operations: $5.(ast.ShadowOperation),
 		}
-		nextSetOprList := &ast.SetOprSelectList{Selects: setOprList2, With: with2}
-		nextSetOprList.AfterSetOperator = $2.(*ast.SetOprType)
-		setOprList := append(setOprList1, nextSetOprList)
-		setOpr := &ast.SetOprStmt{SelectList: &ast.SetOprSelectList{Selects: setOprList}}
-		$$ = setOpr
 	}
-
-SetOprStmtWithLimitOrderBy:
-	SetOprClauseList SetOpr SubSelect OrderBy
+|	"MATCH" "HINT" ShadowOperationsOpt
 	{
-		setOprList1 := $1.([]ast.Node)
-		if sel, isSelect := setOprList1[len(setOprList1)-1].(*ast.SelectStmt); isSelect && !sel.IsInBraces {
-			endOffset := parser.endOffset(&yyS[yypt-2])
-			parser.setLastSelectFieldText(sel, endOffset)
-		}
-		var setOprList2 []ast.Node
-		var with2 *ast.WithClause
-		switch x := $3.(*ast.SubqueryExpr).Query.(type) {
-		case *ast.SelectStmt:
-			setOprList2 = []ast.Node{x}
-			with2 = x.With
-		case *ast.SetOprStmt:
-			setOprList2 = x.SelectList.Selects
-			with2 = x.With
-		}
-		nextSetOprList := &ast.SetOprSelectList{Selects: setOprList2, With: with2}
-		nextSetOprList.AfterSetOperator = $2.(*ast.SetOprType)
-		setOprList := append(setOprList1, nextSetOprList)
-		setOpr := &ast.SetOprStmt{SelectList: &ast.SetOprSelectList{Selects: setOprList}}
-		setOpr.OrderBy = $4.(*ast.OrderByClause)
-		$$ = setOpr
+		$$ = &ast.ShadowMatcher{Tp: ast.ShadowMatcherHint, Operations: $3.(ast.ShadowOperation)}
 	}
-|	SetOprClauseList SetOpr SubSelect SelectStmtLimit
+
+ShadowOperationsOpt:
 	{
-		setOprList1 := $1.([]ast.Node)
-		if sel, isSelect := setOprList1[len(setOprList1)-1].(*ast.SelectStmt); isSelect && !sel.IsInBraces {
-			endOffset := parser.endOffset(&yyS[yypt-2])
-			parser.setLastSelectFieldText(sel, endOffset)
-		}
-		var setOprList2 []ast.Node
-		var with2 *ast.WithClause
-		switch x := $3.(*ast.SubqueryExpr).Query.(type) {
-		case *ast.SelectStmt:
-			setOprList2 = []ast.Node{x}
-			with2 = x.With
-		case *ast.SetOprStmt:
-			setOprList2 = x.SelectList.Selects
-			with2 = x.With
-		}
-		nextSetOprList := &ast.SetOprSelectList{Selects: setOprList2, With: with2}
-		nextSetOprList.AfterSetOperator = $2.(*ast.SetOprType)
-		setOprList := append(setOprList1, nextSetOprList)
-		setOpr := &ast.SetOprStmt{SelectList: &ast.SetOprSelectList{Selects: setOprList}}
-		setOpr.Limit = $4.(*ast.Limit)
-		$$ = setOpr
+		$$ = ast.ShadowOperation(0)
 	}
-|	SetOprClauseList SetOpr SubSelect OrderBy SelectStmtLimit
+|	"ON" '(' ShadowOperationList ')'
 	{
-		setOprList1 := $1.([]ast.Node)
-		if sel, isSelect := setOprList1[len(setOprList1)-1].(*ast.SelectStmt); isSelect && !sel.IsInBraces {
-			endOffset := parser.endOffset(&yyS[yypt-3])
-			parser.setLastSelectFieldText(sel, endOffset)
-		}
-		var setOprList2 []ast.Node
-		var with2 *ast.WithClause
-		switch x := $3.(*ast.SubqueryExpr).Query.(type) {
-		case *ast.SelectStmt:
-			setOprList2 = []ast.Node{x}
-			with2 = x.With
-		case *ast.SetOprStmt:
-			setOprList2 = x.SelectList.Selects
-			with2 = x.With
-		}
-		nextSetOprList := &ast.SetOprSelectList{Selects: setOprList2, With: with2}
-		nextSetOprList.AfterSetOperator = $2.(*ast.SetOprType)
-		setOprList := append(setOprList1, nextSetOprList)
-		setOpr := &ast.SetOprStmt{SelectList: &ast.SetOprSelectList{Selects: setOprList}}
-		setOpr.OrderBy = $4.(*ast.OrderByClause)
-		setOpr.Limit = $5.(*ast.Limit)
-		$$ = setOpr
+		$$ = $3
+	}
+
+ShadowOperationList:
+	ShadowOperation
+|	ShadowOperationList ',' ShadowOperation
+	{
+		$$ = $1.(ast.ShadowOperation) | $3.(ast.ShadowOperation)
 	}
-|	SubSelect OrderBy
+
+ShadowOperation:
+	"SELECT"
 	{
-		var setOprList []ast.Node
-		var with *ast.WithClause
-		switch x := $1.(*ast.SubqueryExpr).Query.(type) {
-		case *ast.SelectStmt:
-			setOprList = []ast.Node{x}
-			with = x.With
-		case *ast.SetOprStmt:
-			setOprList = x.SelectList.Selects
-			with = x.With
-		}
-		setOpr := &ast.SetOprStmt{SelectList: &ast.SetOprSelectList{Selects: setOprList}, With: with}
-		setOpr.OrderBy = $2.(*ast.OrderByClause)
-		$$ = setOpr
+		$$ = ast.ShadowOperationSelect
 	}
-|	SubSelect SelectStmtLimit
+|	"INSERT"
 	{
-		var setOprList []ast.Node
-		var with *ast.WithClause
-		switch x := $1.(*ast.SubqueryExpr).Query.(type) {
-		case *ast.SelectStmt:
-			setOprList = []ast.Node{x}
-			with = x.With
-		case *ast.SetOprStmt:
-			setOprList = x.SelectList.Selects
-			with = x.With
-		}
-		setOpr := &ast.SetOprStmt{SelectList: &ast.SetOprSelectList{Selects: setOprList}, With: with}
-		setOpr.Limit = $2.(*ast.Limit)
-		$$ = setOpr
+		$$ = ast.ShadowOperationInsert
 	}
-|	SubSelect OrderBy SelectStmtLimit
+|	"UPDATE"
 	{
-		var setOprList []ast.Node
-		var with *ast.WithClause
-		switch x := $1.(*ast.SubqueryExpr).Query.(type) {
-		case *ast.SelectStmt:
-			setOprList = []ast.Node{x}
-			with = x.With
-		case *ast.SetOprStmt:
-			setOprList = x.SelectList.Selects
-			with = x.With
-		}
-		setOpr := &ast.SetOprStmt{SelectList: &ast.SetOprSelectList{Selects: setOprList}, With: with}
-		setOpr.OrderBy = $2.(*ast.OrderByClause)
-		setOpr.Limit = $3.(*ast.Limit)
-		$$ = setOpr
+		$$ = ast.ShadowOperationUpdate
+	}
+|	"DELETE"
+	{
+		$$ = ast.ShadowOperationDelete
 	}
 
-SetOprClauseList:
-	SetOprClause
-|	SetOprClauseList SetOpr SetOprClause
+CheckShardingTableItemsOpt:
 	{
-		setOprList1 := $1.([]ast.Node)
-		setOprList2 := $3.([]ast.Node)
-		if sel, isSelect := setOprList1[len(setOprList1)-1].(*ast.SelectStmt); isSelect && !sel.IsInBraces {
-			endOffset := parser.endOffset(&yyS[yypt-1])
-			parser.setLastSelectFieldText(sel, endOffset)
-		}
-		switch x := setOprList2[0].(type) {
-		case *ast.SelectStmt:
-			x.AfterSetOperator = $2.(*ast.SetOprType)
-		case *ast.SetOprSelectList:
-			x.AfterSetOperator = $2.(*ast.SetOprType)
-		}
-		$$ = append(setOprList1, setOprList2...)
+		$$ = ast.CheckShardingTableItem(0)
+	}
+|	"WITH" '(' CheckShardingTableItemList ')'
+	{
+		$$ = $3
 	}
 
-SetOprClause:
-	SelectStmt
+CheckShardingTableItemList:
+	CheckShardingTableItem
+|	CheckShardingTableItemList ',' CheckShardingTableItem
 	{
-		$$ = []ast.Node{$1.(*ast.SelectStmt)}
+		$$ = $1.(ast.CheckShardingTableItem) | $3.(ast.CheckShardingTableItem)
 	}
-|	SubSelect
+
+CheckShardingTableItem:
+	"COLUMNS"
 	{
-		var setOprList []ast.Node
-		switch x := $1.(*ast.SubqueryExpr).Query.(type) {
-		case *ast.SelectStmt:
-			setOprList = []ast.Node{&ast.SetOprSelectList{Selects: []ast.Node{x}}}
-		case *ast.SetOprStmt:
-			setOprList = []ast.Node{&ast.SetOprSelectList{Selects: x.SelectList.Selects, With: x.With}}
-		}
-		$$ = setOprList
+		$$ = ast.CheckShardingTableColumns
+	}
+|	"INDEXES"
+	{
+		$$ = ast.CheckShardingTableIndexes
+	}
+|	"ROW_COUNT"
+	{
+		$$ = ast.CheckShardingTableRowCount
 	}
 
-SetOpr:
-	"UNION" SetOprOpt
+GroupsKwd:
+	"GROUPS"
+	{}
+|	identifier
 	{
-		var tp ast.SetOprType
-		tp = ast.Union
-		if $2 == false {
-			tp = ast.UnionAll
+		// GROUPS is only a keyword when window functions are enabled.
+		if !strings.EqualFold($1, "GROUPS") {
+			yylex.AppendError(ErrSyntax)
+			return 1
 		}
-		$$ = &tp
 	}
-|	"EXCEPT" SetOprOpt
+
+ShowBinlogInOpt:
 	{
-		var tp ast.SetOprType
-		tp = ast.Except
-		if $2 == false {
-			tp = ast.ExceptAll
-		}
-		$$ = &tp
+		$$ = ""
 	}
-|	"INTERSECT" SetOprOpt
+|	"IN" stringLit
 	{
-		var tp ast.SetOprType
-		tp = ast.Intersect
-		if $2 == false {
-			tp = ast.IntersectAll
-		}
-		$$ = &tp
+		$$ = $2
 	}
 
-SetOprOpt:
-	DefaultTrueDistinctOpt
+ShowBinlogFromOpt:
+	{
+		$$ = uint64(0)
+	}
+|	"FROM" LengthNum
+	{
+		$$ = $2
+	}
 
-/********************Change Statement*******************************/
-ChangeStmt:
-	"CHANGE" "PUMP" "TO" "NODE_STATE" eq stringLit forKwd "NODE_ID" stringLit
+BinaryOrMaster:
+	"BINARY"
 	{
-		$$ = &ast.ChangeStmt{
-			NodeType: ast.PumpType,
-			State:    $6,
-			NodeID:   $9,
-		}
+		$$ = false
 	}
-|	"CHANGE" "DRAINER" "TO" "NODE_STATE" eq stringLit forKwd "NODE_ID" stringLit
+|	"MASTER"
 	{
-		$$ = &ast.ChangeStmt{
-			NodeType: ast.DrainerType,
-			State:    $6,
-			NodeID:   $9,
-		}
+		$$ = true
 	}
 
 /********************Set Statement*******************************/
@@ -9851,20 +13107,28 @@ VariableAssignment:
 	}
 |	"GLOBAL" VariableName EqOrAssignmentEq SetExpr
 	{
-		$$ = &ast.VariableAssignment{Name: $2, Value: $4, IsGlobal: true, IsSystem: true}
+		$$ = &ast.VariableAssignment{Name: $2, Value: $4, IsGlobal: true, IsSystem: true, IsScoped: true}
 	}
 |	"SESSION" VariableName EqOrAssignmentEq SetExpr
 	{
-		$$ = &ast.VariableAssignment{Name: $2, Value: $4, IsSystem: true}
+		$$ = &ast.VariableAssignment{Name: $2, Value: $4, IsSystem: true, IsScoped: true}
 	}
 |	"LOCAL" VariableName EqOrAssignmentEq SetExpr
 	{
-		$$ = &ast.VariableAssignment{Name: $2, Value: $4, IsSystem: true}
+		$$ = &ast.VariableAssignment{Name: $2, Value: $4, IsSystem: true, IsScoped: true}
+	}
+|	"PERSIST" VariableName EqOrAssignmentEq SetExpr
+	{
+		$$ = &ast.VariableAssignment{Name: $2, Value: $4, IsGlobal: true, IsSystem: true, IsScoped: true, IsPersist: true}
+	}
+|	"PERSIST_ONLY" VariableName EqOrAssignmentEq SetExpr
+	{
+		$$ = &ast.VariableAssignment{Name: $2, Value: $4, IsSystem: true, IsScoped: true, IsPersistOnly: true}
 	}
 |	doubleAtIdentifier EqOrAssignmentEq SetExpr
 	{
 		v := strings.ToLower($1)
-		var isGlobal bool
+		var isGlobal, isPersist, isPersistOnly bool
 		if strings.HasPrefix(v, "@@global.") {
 			isGlobal = true
 			v = strings.TrimPrefix(v, "@@global.")
@@ -9872,10 +13136,16 @@ VariableAssignment:
 			v = strings.TrimPrefix(v, "@@session.")
 		} else if strings.HasPrefix(v, "@@local.") {
 			v = strings.TrimPrefix(v, "@@local.")
+		} else if strings.HasPrefix(v, "@@persist.") {
+			isGlobal, isPersist = true, true
+			v = strings.TrimPrefix(v, "@@persist.")
+		} else if strings.HasPrefix(v, "@@persist_only.") {
+			isPersistOnly = true
+			v = strings.TrimPrefix(v, "@@persist_only.")
 		} else if strings.HasPrefix(v, "@@") {
 			v = strings.TrimPrefix(v, "@@")
 		}
-		$$ = &ast.VariableAssignment{Name: v, Value: $3, IsGlobal: isGlobal, IsSystem: true}
+		$$ = &ast.VariableAssignment{Name: v, Value: $3, IsGlobal: isGlobal, IsSystem: true, IsScoped: true, IsPersist: isPersist, IsPersistOnly: isPersistOnly}
 	}
 |	singleAtIdentifier EqOrAssignmentEq Expression
 	{
@@ -10227,6 +13497,13 @@ AdminStmt:
 			Quick:  $4.(bool),
 		}
 	}
+|	"CHECK" "SHARDING" "TABLE" TableNameList CheckShardingTableItemsOpt
+	{
+		$$ = &ast.CheckShardingTableStmt{
+			Tables: $4.([]*ast.TableName),
+			Items:  $5.(ast.CheckShardingTableItem),
+		}
+	}
 |	"REPAIR" "TABLE" TableNameList QuickOptional
 	{
 		$$ = &ast.RepairTableStmt{
@@ -10403,6 +13680,41 @@ ShowStmt:
 			Tenant: $4,
 		}
 	}
+|	"SHOW" "SHADOW" "RULES" ShowDatabaseNameOpt
+	{
+		$$ = &ast.ShowStmt{
+			Tp:     ast.ShowShadowRules,
+			DBName: $4,
+		}
+	}
+|	"SHOW" "SHADOW" "TABLES" ShowDatabaseNameOpt
+	{
+		$$ = &ast.ShowStmt{
+			Tp:     ast.ShowShadowTables,
+			DBName: $4,
+		}
+	}
+|	"SHOW" "SEQUENCES" ShowDatabaseNameOpt
+	{
+		$$ = &ast.ShowStmt{
+			Tp:     ast.ShowSequences,
+			DBName: $3,
+		}
+	}
+|	"SHOW" "SEQUENCE" "STATUS" ShowDatabaseNameOpt
+	{
+		$$ = &ast.ShowStmt{
+			Tp:     ast.ShowSequenceStatus,
+			DBName: $4,
+		}
+	}
+|	"SHOW" "DB" GroupsKwd "FROM" Tenant
+	{
+		$$ = &ast.ShowStmt{
+			Tp:     ast.ShowDBGroups,
+			Tenant: $5,
+		}
+	}
 |	"SHOW" "CREATE" "TABLE" TableName
 	{
 		$$ = &ast.ShowStmt{
@@ -10534,6 +13846,37 @@ ShowStmt:
 			Tp: ast.ShowReplicaStatus,
 		}
 	}
+|	"SHOW" BinaryOrMaster "LOGS"
+	{
+		$$ = &ast.ShowStmt{
+			Tp: ast.ShowBinaryLogs,
+		}
+	}
+|	"SHOW" "BINLOG" "EVENTS" ShowBinlogInOpt ShowBinlogFromOpt SelectStmtLimitOpt
+	{
+		stmt := &ast.ShowStmt{
+			Tp:         ast.ShowBinlogEvents,
+			BinlogFile: $4,
+			BinlogPos:  $5.(uint64),
+		}
+		if $6 != nil {
+			stmt.Limit = $6.(*ast.Limit)
+		}
+		$$ = stmt
+	}
+|	"SHOW" "RELAYLOG" "EVENTS" ShowBinlogInOpt ShowBinlogFromOpt SelectStmtLimitOpt ReplicationChannelOpt
+	{
+		stmt := &ast.ShowStmt{
+			Tp:         ast.ShowRelaylogEvents,
+			BinlogFile: $4,
+			BinlogPos:  $5.(uint64),
+			Channel:    $7,
+		}
+		if $6 != nil {
+			stmt.Limit = $6.(*ast.Limit)
+		}
+		$$ = stmt
+	}
 |	"SHOW" OptFull "PROCESSLIST"
 	{
 		$$ = &ast.ShowStmt{
@@ -11135,7 +14478,30 @@ Statement:
 |	DeleteFromStmt
 |	ExecuteStmt
 |	ExplainStmt
+|	ExplainShardingStmt
 |	ChangeStmt
+|	ChangeReplicationSourceStmt
+|	StartReplicaStmt
+|	StopReplicaStmt
+|	ResetReplicaStmt
+|	ResetMasterStmt
+|	ResetPersistStmt
+|	PurgeBinaryLogsStmt
+|	CreateShardingTableRuleStmt
+|	AlterShardingTableRuleStmt
+|	DropShardingTableRuleStmt
+|	CreateTenantStmt
+|	AlterTenantStmt
+|	DropTenantStmt
+|	CreateNodeStmt
+|	AlterNodeStmt
+|	DropNodeStmt
+|	CreateDBGroupStmt
+|	AlterDBGroupStmt
+|	DropDBGroupStmt
+|	CreateShadowRuleStmt
+|	AlterShadowRuleStmt
+|	DropShadowRuleStmt
 |	CreateDatabaseStmt
 |	CreateImportStmt
 |	CreateIndexStmt
@@ -11147,9 +14513,17 @@ Statement:
 |	CreatePolicyStmt
 |	CreateSequenceStmt
 |	CreateStatisticsStmt
+|	CreateTriggerStmt
+|	CreateEventStmt
+|	CreateProcedureStmt
+|	CreateFunctionStmt
+|	AlterRoutineStmt
+|	AlterEventStmt
 |	DoStmt
 |	DropDatabaseStmt
 |	DropTriggerStmt
+|	DropEventStmt
+|	DropRoutineStmt
 |	DropImportStmt
 |	DropIndexStmt
 |	DropTableStmt
@@ -11163,6 +14537,7 @@ Statement:
 |	DropBindingStmt
 |	FlushStmt
 |	FlashbackTableStmt
+|	GetDiagnosticsStmt
 |	GrantStmt
 |	GrantProxyStmt
 |	GrantRoleStmt
@@ -11177,12 +14552,15 @@ Statement:
 |	PurgeImportStmt
 |	RollbackStmt
 |	RenameTableStmt
+|	ResignalStmt
 |	RenameUserStmt
+|	ReleaseSavepointStmt
 |	ReplaceIntoStmt
 |	RecoverTableStmt
 |	ResumeImportStmt
 |	RevokeStmt
 |	RevokeRoleStmt
+|	SavepointStmt
 |	SetOprStmt
 |	SelectStmt
 |	SelectStmtWithClause
@@ -11203,6 +14581,7 @@ Statement:
 |	SetBindingStmt
 |	SetRoleStmt
 |	SetDefaultRoleStmt
+|	SignalStmt
 |	SplitRegionStmt
 |	StopImportStmt
 |	ShowImportStmt
@@ -11216,6 +14595,7 @@ Statement:
 |	ShutdownStmt
 |	RestartStmt
 |	HelpStmt
+|	XAStmt
 
 TraceableStmt:
 	DeleteFromStmt
@@ -11267,6 +14647,28 @@ ExplainableStmt:
 	}
 |	AlterTableStmt
 
+ShardingPreviewableStmt:
+	DeleteFromStmt
+|	UpdateStmt
+|	InsertIntoStmt
+|	ReplaceIntoStmt
+|	SetOprStmt
+|	SelectStmt
+|	SelectStmtWithClause
+|	SubSelect
+	{
+		var sel ast.StmtNode
+		switch x := $1.(*ast.SubqueryExpr).Query.(type) {
+		case *ast.SelectStmt:
+			x.IsInBraces = true
+			sel = x
+		case *ast.SetOprStmt:
+			x.IsInBraces = true
+			sel = x
+		}
+		$$ = sel
+	}
+
 StatementList:
 	Statement
 	{
@@ -11275,6 +14677,9 @@ StatementList:
 			if lexer, ok := yylex.(stmtTexter); ok {
 				s.SetText(parser.lexer.client, lexer.stmtText())
 			}
+			if es, ok := s.(*ast.ExplainShardingStmt); ok {
+				parser.setShardingPreviewText(es, parser.lexer.stmtStartPos)
+			}
 
 			// fill arana hints
 			if hints := parser.lexer.aranaHints; len(hints) > 0 {
@@ -11294,6 +14699,9 @@ StatementList:
 			if lexer, ok := yylex.(stmtTexter); ok {
 				s.SetText(parser.lexer.client, lexer.stmtText())
 			}
+			if es, ok := s.(*ast.ExplainShardingStmt); ok {
+				parser.setShardingPreviewText(es, parser.lexer.stmtStartPos)
+			}
 
 			// fill arana hints
 			if hints := parser.lexer.aranaHints; len(hints) > 0 {
@@ -11659,6 +15067,7 @@ Type:
 	NumericType
 |	StringType
 |	DateAndTimeType
+|	SpatialType
 
 NumericType:
 	IntegerType OptFieldLen FieldOpts
@@ -12174,7 +15583,46 @@ FieldLen:
 		$$ = int($2.(uint64))
 	}
 
+SpatialType:
+	"GEOMETRY"
+	{
+		$$ = types.NewGeometryFieldType(types.GeometryTypeGeometry)
+	}
+|	"POINT"
+	{
+		$$ = types.NewGeometryFieldType(types.GeometryTypePoint)
+	}
+|	"LINESTRING"
+	{
+		$$ = types.NewGeometryFieldType(types.GeometryTypeLineString)
+	}
+|	"POLYGON"
+	{
+		$$ = types.NewGeometryFieldType(types.GeometryTypePolygon)
+	}
+|	"MULTIPOINT"
+	{
+		$$ = types.NewGeometryFieldType(types.GeometryTypeMultiPoint)
+	}
+|	"MULTILINESTRING"
+	{
+		$$ = types.NewGeometryFieldType(types.GeometryTypeMultiLineString)
+	}
+|	"MULTIPOLYGON"
+	{
+		$$ = types.NewGeometryFieldType(types.GeometryTypeMultiPolygon)
+	}
+|	"GEOMETRYCOLLECTION"
+	{
+		$$ = types.NewGeometryFieldType(types.GeometryTypeGeometryCollection)
+	}
+|	"GEOMCOLLECTION"
+	{
+		$$ = types.NewGeometryFieldType(types.GeometryTypeGeometryCollection)
+	}
+
 OptFieldLen:
+	/* empty */ %prec lowerThanParenthese
 	{
 		$$ = types.UnspecifiedLength
 	}
@@ -12204,6 +15652,7 @@ FieldOpts:
 	}
 
 FloatOpt:
+	/* empty */ %prec lowerThanParenthese
 	{
 		$$ = &ast.FloatOpt{Flen: types.UnspecifiedLength, Decimal: types.UnspecifiedLength}
 	}
@@ -12229,6 +15678,7 @@ OptBinMod:
 	}
 
 OptBinary:
+	/* empty */ %prec lowerThanParenthese
 	{
 		$$ = &ast.OptBinary{
 			IsBinary: false,
@@ -13672,11 +17122,18 @@ AlterPolicyStmt:
  *	[ START [ WITH | = ] start ]
  *	[ CACHE [=] cache | NOCACHE | NO CACHE]
  *	[ CYCLE | NOCYCLE | NO CYCLE]
+ *	[ TYPE [=] { GROUP | SNOWFLAKE | SEGMENT } ]
+ *	[ WORKER_ID [=] worker_id ]
+ *	[ STEP [=] step ]
  *	[table_options]
  ********************************************************************************************/
 CreateSequenceStmt:
 	"CREATE" "SEQUENCE" IfNotExists TableName CreateSequenceOptionListOpt CreateTableOptionListOpt
 	{
+		if err := ast.CheckSequenceOptions($5.([]*ast.SequenceOption), false); err != nil {
+			yylex.AppendError(err)
+			return 1
+		}
 		$$ = &ast.CreateSequenceStmt{
 			IfNotExists: $3.(bool),
 			Name:        $4.(*ast.TableName),
@@ -13766,6 +17223,32 @@ SequenceOption:
 	{
 		$$ = &ast.SequenceOption{Tp: ast.SequenceNoCycle}
 	}
+|	"TYPE" EqOpt SequenceType
+	{
+		$$ = &ast.SequenceOption{Tp: ast.SequenceTypeOption, SeqType: $3.(ast.SequenceType)}
+	}
+|	"WORKER_ID" EqOpt SignedNum
+	{
+		$$ = &ast.SequenceOption{Tp: ast.SequenceWorkerID, IntValue: $3.(int64)}
+	}
+|	"STEP" EqOpt SignedNum
+	{
+		$$ = &ast.SequenceOption{Tp: ast.SequenceStep, IntValue: $3.(int64)}
+	}
+
+SequenceType:
+	"GROUP"
+	{
+		$$ = ast.SequenceTypeGroup
+	}
+|	"SNOWFLAKE"
+	{
+		$$ = ast.SequenceTypeSnowflake
+	}
+|	"SEGMENT"
+	{
+		$$ = ast.SequenceTypeSegment
+	}
 
 SignedNum:
 	Int64Num
@@ -13809,10 +17292,18 @@ DropSequenceStmt:
  *	[ CACHE [=] cache | NOCACHE | NO CACHE]
  *	[ CYCLE | NOCYCLE | NO CYCLE]
  *	[ RESTART [WITH | = ] restart ]
+ *	[ TYPE [=] { GROUP | SNOWFLAKE | SEGMENT } ]
+ *	[ WORKER_ID [=] worker_id ]
+ *	[ STEP [=] step ]
+ *	[ SET VALUE [=] value ]
  ********************************************************************************************/
 AlterSequenceStmt:
 	"ALTER" "SEQUENCE" IfExists TableName AlterSequenceOptionList
 	{
+		if err := ast.CheckSequenceOptions($5.([]*ast.SequenceOption), true); err != nil {
+			yylex.AppendError(err)
+			return 1
+		}
 		$$ = &ast.AlterSequenceStmt{
 			IfExists:   $3.(bool),
 			Name:       $4.(*ast.TableName),
@@ -13844,6 +17335,10 @@ AlterSequenceOption:
 	{
 		$$ = &ast.SequenceOption{Tp: ast.SequenceRestartWith, IntValue: $3.(int64)}
 	}
+|	"SET" "VALUE" EqOpt SignedNum
+	{
+		$$ = &ast.SequenceOption{Tp: ast.SequenceSetValue, IntValue: $4.(int64)}
+	}
 
 /********************************************************************
  * Index Advisor Statement
@@ -62,7 +62,10 @@ func TestSimple(t *testing.T) {
 		"delayed", "high_priority", "low_priority",
 		"cumeDist", "denseRank", "firstValue", "lag", "lastValue", "lead", "nthValue", "ntile",
 		"over", "percentRank", "rank", "row", "rows", "rowNumber", "window", "linear",
-		"match", "until", "placement", "tablesample", "attributes",
+		"match", "until", "placement", "tablesample", "attributes", "before", "each",
+		"condition", "continue", "cursor", "declare", "deterministic", "elseif", "exit", "inout", "iterate",
+		"leave", "loop", "modifies", "out", "reads", "return", "sqlexception", "sqlstate", "sqlwarning", "undo", "while",
+		"get", "signal", "resignal", "json_table", "lateral",
 		// TODO: support the following keywords
 		// "with",
 	}
@@ -101,6 +104,30 @@ func TestSimple(t *testing.T) {
 		"following", "preceding", "unbounded", "respect", "nulls", "current", "last", "against", "expansion",
 		"chain", "error", "general", "nvarchar", "pack_keys", "p", "shard_row_id_bits", "pre_split_regions",
 		"constraints", "role", "replicas", "policy", "s3", "strict", "running", "stop", "preserve", "placement",
+		"follows", "precedes", "close", "contains", "found", "handler", "returns",
+		"diagnostics", "stacked", "number", "class_origin", "subclass_origin", "returned_sqlstate", "message_text",
+		"mysql_errno", "constraint_catalog", "constraint_schema", "constraint_name", "catalog_name", "schema_name",
+		"table_name", "column_name", "cursor_name", "savepoint", "xa", "suspend", "migrate", "one", "phase", "xid",
+		"ordinality", "nested", "path", "empty", "at", "every", "starts", "ends", "completion",
+		"geometry", "point", "linestring", "polygon", "multipoint", "multilinestring", "multipolygon", "geometrycollection", "geomcollection", "srid", "member", "array",
+		"source_bind", "master_bind", "source_host", "master_host", "source_user", "master_user",
+		"source_password", "master_password", "source_port", "master_port", "source_log_file", "master_log_file",
+		"source_log_pos", "master_log_pos", "source_auto_position", "master_auto_position", "source_heartbeat_period", "master_heartbeat_period",
+		"source_connect_retry", "master_connect_retry", "source_retry_count", "master_retry_count", "source_delay", "master_delay",
+		"source_compression_algorithms", "master_compression_algorithms", "source_zstd_compression_level", "master_zstd_compression_level", "source_ssl", "master_ssl",
+		"source_ssl_ca", "master_ssl_ca", "source_ssl_capath", "master_ssl_capath", "source_ssl_cert", "master_ssl_cert",
+		"source_ssl_crl", "master_ssl_crl", "source_ssl_crlpath", "master_ssl_crlpath", "source_ssl_key", "master_ssl_key",
+		"source_ssl_cipher", "master_ssl_cipher", "source_ssl_verify_server_cert", "master_ssl_verify_server_cert", "source_tls_version", "master_tls_version",
+		"source_tls_ciphersuites", "master_tls_ciphersuites", "source_public_key_path", "master_public_key_path", "get_source_public_key", "get_master_public_key",
+		"source_connection_auto_failover", "channel", "io_thread", "sql_thread", "relay_thread", "sql_before_gtids",
+		"sql_after_gtids", "sql_after_mts_gaps", "default_auth", "plugin_dir", "relay_log_file", "relay_log_pos",
+		"privilege_checks_user", "require_row_format", "require_table_primary_key_check", "assign_gtids_to_anonymous_transactions", "network_namespace", "ignore_server_ids",
+		"gtid_only", "persist", "persist_only", "rule", "sharding_columns", "database_algorithm",
+		"table_algorithm", "key_generator", "properties", "tenant", "node", "host",
+		"port", "username", "weight", "db", "load_balance", "transaction_routing",
+		"shadow", "shadow_group", "hint", "preview",
+		"sequences", "snowflake", "segment", "step", "worker_id",
+		"aggregate", "soname", "string", "work", "relaylog",
 	}
 	for _, kw := range unreservedKws {
 		src := fmt.Sprintf("SELECT %s FROM tbl;", kw)
@@ -538,6 +565,63 @@ func TestDMLStmt(t *testing.T) {
 		{"ROLLBACK AND NO CHAIN RELEASE", true, "ROLLBACK RELEASE"},
 		{"ROLLBACK AND CHAIN NO RELEASE", true, "ROLLBACK AND CHAIN"},
 		{"ROLLBACK AND CHAIN RELEASE", false, ""},
+		{"SAVEPOINT sp1", true, "SAVEPOINT `sp1`"},
+		{"savepoint `s p`", true, "SAVEPOINT `s p`"},
+		{"SAVEPOINT", false, ""},
+		{"ROLLBACK TO sp1", true, "ROLLBACK TO SAVEPOINT `sp1`"},
+		{"ROLLBACK TO SAVEPOINT sp1", true, "ROLLBACK TO SAVEPOINT `sp1`"},
+		{"ROLLBACK TO savepoint", true, "ROLLBACK TO SAVEPOINT `savepoint`"},
+		{"ROLLBACK TO SAVEPOINT savepoint", true, "ROLLBACK TO SAVEPOINT `savepoint`"},
+		{"ROLLBACK TO SAVEPOINT sp1 AND CHAIN", false, ""},
+		{"ROLLBACK WORK TO sp1", true, "ROLLBACK TO SAVEPOINT `sp1`"},
+		{"ROLLBACK WORK TO SAVEPOINT sp1", true, "ROLLBACK TO SAVEPOINT `sp1`"},
+		{"ROLLBACK WORK TO work", true, "ROLLBACK TO SAVEPOINT `work`"},
+		{"ROLLBACK WORK", true, "ROLLBACK"},
+		{"ROLLBACK WORK AND CHAIN", true, "ROLLBACK AND CHAIN"},
+		{"COMMIT WORK", true, "COMMIT"},
+		{"COMMIT WORK RELEASE", true, "COMMIT RELEASE"},
+		{"ROLLBACK TO WORK SAVEPOINT sp1", false, ""},
+		{"RELEASE SAVEPOINT sp1", true, "RELEASE SAVEPOINT `sp1`"},
+		{"RELEASE sp1", false, ""},
+		{`BEGIN;
+			SAVEPOINT sp1;
+			INSERT INTO foo VALUES (1);
+			ROLLBACK TO SAVEPOINT sp1;
+			RELEASE SAVEPOINT sp1;
+		COMMIT;`, true, "START TRANSACTION; SAVEPOINT `sp1`; INSERT INTO `foo` VALUES (1); ROLLBACK TO SAVEPOINT `sp1`; RELEASE SAVEPOINT `sp1`; COMMIT"},
+
+		// xa statements
+		{"XA START 'xid1'", true, "XA START 'xid1'"},
+		{"xa begin 'xid1'", true, "XA START 'xid1'"},
+		{"XA START 'xid1', 'b1'", true, "XA START 'xid1','b1'"},
+		{"XA START 'xid1', 'b1', 1", true, "XA START 'xid1','b1'"},
+		{"XA START 'xid1', '', 1", true, "XA START 'xid1',''"},
+		{"XA START 'xid1', 'b1', 7", true, "XA START 'xid1','b1',7"},
+		{"XA START X'6162', 0x63", true, "XA START 0x6162,0x63"},
+		{"XA START 'xid1', b'01100001', 3", true, "XA START 'xid1',0x61,3"},
+		{"XA START 'xid1' JOIN", true, "XA START 'xid1' JOIN"},
+		{"xa begin 'xid1', 'b1', 7 resume", true, "XA START 'xid1','b1',7 RESUME"},
+		{"XA START 'xid1' JOIN RESUME", false, ""},
+		{"XA END 'xid1' JOIN", false, ""},
+		{"XA START X'0102', X'03', 0x10", true, "XA START 0x0102,0x03,16"},
+		{"XA START 'xid1', 'b1', b'101'", true, "XA START 'xid1','b1',5"},
+		{"XA START 'xid1', 'b1', 0x0000000000000000ff", true, "XA START 'xid1','b1',255"},
+		{"XA START 'xid1', 'b1', 0x010000000000000000", false, ""},
+		{"XA START 'xid1', 'b1', 'f'", false, ""},
+		{"XA START 'xid1', 3", false, ""},
+		{"XA START", false, ""},
+		{"XA END 'xid1'", true, "XA END 'xid1'"},
+		{"XA END 'xid1' SUSPEND", true, "XA END 'xid1' SUSPEND"},
+		{"XA END 'xid1', 'b1', 2 SUSPEND FOR MIGRATE", true, "XA END 'xid1','b1',2 SUSPEND FOR MIGRATE"},
+		{"XA END 'xid1' FOR MIGRATE", false, ""},
+		{"XA PREPARE 'xid1'", true, "XA PREPARE 'xid1'"},
+		{"XA COMMIT 'xid1'", true, "XA COMMIT 'xid1'"},
+		{"XA COMMIT 'xid1' ONE PHASE", true, "XA COMMIT 'xid1' ONE PHASE"},
+		{"XA ROLLBACK 'xid1'", true, "XA ROLLBACK 'xid1'"},
+		{"XA ROLLBACK 'xid1' ONE PHASE", false, ""},
+		{"XA RECOVER", true, "XA RECOVER"},
+		{"XA RECOVER CONVERT XID", true, "XA RECOVER CONVERT XID"},
+		{"XA RECOVER 'xid1'", false, ""},
 		{`BEGIN;
 			INSERT INTO foo VALUES (42, 3.14);
 			INSERT INTO foo VALUES (-1, 2.78);
@@ -568,6 +652,10 @@ func TestDMLStmt(t *testing.T) {
 		{"CREATE VIEW v AS TABLE t", true, "CREATE ALGORITHM = UNDEFINED DEFINER = CURRENT_USER SQL SECURITY DEFINER VIEW `v` AS TABLE `t`"},
 		{"CREATE VIEW v AS (TABLE t)", true, "CREATE ALGORITHM = UNDEFINED DEFINER = CURRENT_USER SQL SECURITY DEFINER VIEW `v` AS (TABLE `t`)"},
 		{"SELECT * FROM t1 WHERE a IN (TABLE t2)", true, "SELECT * FROM `t1` WHERE `a` IN (TABLE `t2`)"},
+		{"SELECT * FROM (TABLE t) AS d", true, "SELECT * FROM (TABLE `t`) AS `d`"},
+		{"SELECT a FROM t1 UNION TABLE t2 ORDER BY a", true, "SELECT `a` FROM `t1` UNION TABLE `t2` ORDER BY `a`"},
+		{"(TABLE t1 LIMIT 1) UNION ALL (TABLE t2 ORDER BY a LIMIT 2)", true, "(TABLE `t1` LIMIT 1) UNION ALL (TABLE `t2` ORDER BY `a` LIMIT 2)"},
+		{"WITH c AS (SELECT 1) TABLE c", true, "WITH `c` AS (SELECT 1) TABLE `c`"},
 
 		// values statement
 		{"VALUES ROW(1)", true, "VALUES ROW(1)"},
@@ -585,6 +673,14 @@ func TestDMLStmt(t *testing.T) {
 		{"CREATE TABLE ta VALUES ROW(1)", true, "CREATE TABLE `ta` AS VALUES ROW(1)"},
 		{"CREATE TABLE ta AS VALUES ROW(1)", true, "CREATE TABLE `ta` AS VALUES ROW(1)"},
 		{"CREATE VIEW a AS VALUES ROW(1)", true, "CREATE ALGORITHM = UNDEFINED DEFINER = CURRENT_USER SQL SECURITY DEFINER VIEW `a` AS VALUES ROW(1)"},
+		{"SELECT 1, 2 UNION VALUES ROW(3, 4)", true, "SELECT 1,2 UNION VALUES ROW(3,4)"},
+		{"VALUES ROW(1, 2) UNION ALL TABLE t EXCEPT VALUES ROW(3, 4)", true, "VALUES ROW(1,2) UNION ALL TABLE `t` EXCEPT VALUES ROW(3,4)"},
+		{"(VALUES ROW(1)) UNION (VALUES ROW(2) LIMIT 1)", true, "(VALUES ROW(1)) UNION (VALUES ROW(2) LIMIT 1)"},
+		{"SELECT * FROM (VALUES ROW(1, 2), ROW(3, 4)) AS d", true, "SELECT * FROM (VALUES ROW(1,2), ROW(3,4)) AS `d`"},
+		{"SELECT * FROM t WHERE a IN (VALUES ROW(1), ROW(2))", true, "SELECT * FROM `t` WHERE `a` IN (VALUES ROW(1), ROW(2))"},
+		{"WITH c AS (VALUES ROW(1)) SELECT * FROM c", true, "WITH `c` AS (VALUES ROW(1)) SELECT * FROM `c`"},
+		{"INSERT INTO t VALUES ROW(1, 2), ROW(3, 4)", true, "INSERT INTO `t` VALUES ROW(1,2), ROW(3,4)"},
+		{"INSERT INTO t (a) VALUES ROW(1) UNION TABLE s", true, "INSERT INTO `t` (`a`) VALUES ROW(1) UNION TABLE `s`"},
 
 		// qualified select
 		{"SELECT a.b.c FROM t", true, "SELECT `a`.`b`.`c` FROM `t`"},
@@ -668,7 +764,7 @@ func TestDMLStmt(t *testing.T) {
 		{"select * from t for share nowait", true, "SELECT * FROM `t` FOR SHARE NOWAIT"},
 		{"select * from t for update skip locked", true, "SELECT * FROM `t` FOR UPDATE SKIP LOCKED"},
 		{"select * from t for share skip locked", true, "SELECT * FROM `t` FOR SHARE SKIP LOCKED"},
-		{"select * from t lock in share mode", true, "SELECT * FROM `t` FOR SHARE"},
+		{"select * from t lock in share mode", true, "SELECT * FROM `t` LOCK IN SHARE MODE"},
 		{"select * from t lock in share mode nowait", false, ""},
 		{"select * from t lock in share mode skip locked", false, ""},
 
@@ -680,6 +776,19 @@ func TestDMLStmt(t *testing.T) {
 		{"select * from t for share of t nowait", true, "SELECT * FROM `t` FOR SHARE OF `t` NOWAIT"},
 		{"select * from t for update of t skip locked", true, "SELECT * FROM `t` FOR UPDATE OF `t` SKIP LOCKED"},
 		{"select * from t for share of t skip locked", true, "SELECT * FROM `t` FOR SHARE OF `t` SKIP LOCKED"},
+		{"select * from t1, t2 for update of t1, t2 skip locked", true, "SELECT * FROM (`t1`) JOIN `t2` FOR UPDATE OF `t1`, `t2` SKIP LOCKED"},
+		{"select * from t1, t2 for update of t1 nowait for share of t2 skip locked", true, "SELECT * FROM (`t1`) JOIN `t2` FOR UPDATE OF `t1` NOWAIT FOR SHARE OF `t2` SKIP LOCKED"},
+		{"select * from t1, t2, t3 for share of t1 for update of t2 wait 3 for share of t3", true, "SELECT * FROM ((`t1`) JOIN `t2`) JOIN `t3` FOR SHARE OF `t1` FOR UPDATE OF `t2` WAIT 3 FOR SHARE OF `t3`"},
+		{"select * from t1 limit 1 for update of t1 for share of t2 into outfile '/tmp/1.csv'", true, "SELECT * FROM `t1` LIMIT 1 FOR UPDATE OF `t1` FOR SHARE OF `t2` INTO OUTFILE '/tmp/1.csv'"},
+		{"select * from t for update for update", false, ""},
+		{"select * from t for update for share nowait", false, ""},
+		{"select * from t1, t2 for update of t1 for share of t2, t1", false, ""},
+		{"select * from t1, t2 for update of t1 for update of T1", false, ""},
+		{"select * from db.t, t for update of db.t for share of t", true, "SELECT * FROM (`db`.`t`) JOIN `t` FOR UPDATE OF `db`.`t` FOR SHARE OF `t`"},
+		{"select * from t1, t2 for update for share of t2", true, "SELECT * FROM (`t1`) JOIN `t2` FOR UPDATE FOR SHARE OF `t2`"},
+		{"select * from t for update lock in share mode", false, ""},
+		{"select * from t lock in share mode for update", false, ""},
+		{"select * from t for update of t skip", false, ""},
 
 		// select into outfile
 		{"select a, b from t into outfile '/tmp/result.txt'", true, "SELECT `a`,`b` FROM `t` INTO OUTFILE '/tmp/result.txt'"},
@@ -695,6 +804,17 @@ func TestDMLStmt(t *testing.T) {
 		{"select a,b,a+b from t into outfile '/tmp/result.txt' fields terminated BY ',' optionally enclosed BY '\"' lines starting by 'xy' terminated BY '\r'", true, "SELECT `a`,`b`,`a`+`b` FROM `t` INTO OUTFILE '/tmp/result.txt' FIELDS TERMINATED BY ',' OPTIONALLY ENCLOSED BY '\"' LINES STARTING BY 'xy' TERMINATED BY '\r'"},
 		{"select a,b,a+b from t into outfile '/tmp/result.txt' fields terminated BY ',' enclosed BY '\"' lines starting by 'xy' terminated BY '\r'", true, "SELECT `a`,`b`,`a`+`b` FROM `t` INTO OUTFILE '/tmp/result.txt' FIELDS TERMINATED BY ',' ENCLOSED BY '\"' LINES STARTING BY 'xy' TERMINATED BY '\r'"},
 
+		// select into var_list
+		{"select 1 into @a", true, "SELECT 1 INTO @`a`"},
+		{"select a, b from t where c = 1 into @a, @b", true, "SELECT `a`,`b` FROM `t` WHERE `c`=1 INTO @`a`,@`b`"},
+		{"select a, b into @a, @b from t where c = 1 order by d limit 1", true, "SELECT `a`,`b` FROM `t` WHERE `c`=1 ORDER BY `d` LIMIT 1 INTO @`a`,@`b`"},
+		{"select a into @a from t for update", true, "SELECT `a` FROM `t` FOR UPDATE INTO @`a`"},
+		{"select 1 into @a from dual", true, "SELECT 1 INTO @`a`"},
+		{"select a into outfile '/tmp/1.csv' from t", true, "SELECT `a` FROM `t` INTO OUTFILE '/tmp/1.csv'"},
+		{"select a into @a from t into @b", false, ""},
+		{"select a into outfile '/tmp/1.csv' from t into @b", false, ""},
+		{"select a from t into", false, ""},
+
 		// from join
 		{"SELECT * from t1, t2, t3", true, "SELECT * FROM ((`t1`) JOIN `t2`) JOIN `t3`"},
 		{"select * from t1 join t2 left join t3 on t2.id = t3.id", true, "SELECT * FROM (`t1` JOIN `t2`) LEFT JOIN `t3` ON `t2`.`id`=`t3`.`id`"},
@@ -821,6 +941,18 @@ func TestDMLStmt(t *testing.T) {
 		// for on duplicate key update
 		{"INSERT INTO t (a,b,c) VALUES (1,2,3),(4,5,6) ON DUPLICATE KEY UPDATE c=VALUES(a)+VALUES(b);", true, "INSERT INTO `t` (`a`,`b`,`c`) VALUES (1,2,3),(4,5,6) ON DUPLICATE KEY UPDATE `c`=VALUES(`a`)+VALUES(`b`)"},
 		{"INSERT IGNORE INTO t (a,b,c) VALUES (1,2,3),(4,5,6) ON DUPLICATE KEY UPDATE c=VALUES(a)+VALUES(b);", true, "INSERT IGNORE INTO `t` (`a`,`b`,`c`) VALUES (1,2,3),(4,5,6) ON DUPLICATE KEY UPDATE `c`=VALUES(`a`)+VALUES(`b`)"},
+		{"INSERT INTO t VALUES (1,2,3) AS new ON DUPLICATE KEY UPDATE c=new.a+new.b", true, "INSERT INTO `t` VALUES (1,2,3) AS `new` ON DUPLICATE KEY UPDATE `c`=`new`.`a`+`new`.`b`"},
+		{"INSERT INTO t (a,b,c) VALUES (1,2,3),(4,5,6) AS new(m,n,p) ON DUPLICATE KEY UPDATE c=m+n", true, "INSERT INTO `t` (`a`,`b`,`c`) VALUES (1,2,3),(4,5,6) AS `new`(`m`,`n`,`p`) ON DUPLICATE KEY UPDATE `c`=`m`+`n`"},
+		{"INSERT INTO t SET a=1,b=2 AS new ON DUPLICATE KEY UPDATE b=new.a", true, "INSERT INTO `t` SET `a`=1,`b`=2 AS `new` ON DUPLICATE KEY UPDATE `b`=`new`.`a`"},
+		{"INSERT INTO t VALUE (1) AS new", true, "INSERT INTO `t` VALUES (1) AS `new`"},
+		{"INSERT INTO t VALUES (1) new ON DUPLICATE KEY UPDATE a=new.a", false, ""},
+		{"INSERT INTO t VALUES (1) AS new() ON DUPLICATE KEY UPDATE a=1", false, ""},
+		{"INSERT INTO t SELECT * FROM s AS new ON DUPLICATE KEY UPDATE a=new.a", true, "INSERT INTO `t` SELECT * FROM `s` AS `new` ON DUPLICATE KEY UPDATE `a`=`new`.`a`"},
+		{"INSERT INTO t VALUES ROW(1,2), ROW(3,4) AS new ON DUPLICATE KEY UPDATE a=new.b", true, "INSERT INTO `t` VALUES ROW(1,2), ROW(3,4) AS `new` ON DUPLICATE KEY UPDATE `a`=`new`.`b`"},
+		{"INSERT INTO t (a,b) VALUES ROW(1,2) AS new(m,n) ON DUPLICATE KEY UPDATE a=m+n", true, "INSERT INTO `t` (`a`,`b`) VALUES ROW(1,2) AS `new`(`m`,`n`) ON DUPLICATE KEY UPDATE `a`=`m`+`n`"},
+		{"INSERT INTO t VALUES ROW(1) LIMIT 1 AS new", false, ""},
+		{"REPLACE INTO t VALUES (1) AS new", false, ""},
+		{"REPLACE INTO t VALUES ROW(1) AS new", false, ""},
 
 		// for insert ... set
 		{"INSERT INTO t SET a=1,b=2", true, "INSERT INTO `t` SET `a`=1,`b`=2"},
@@ -1222,6 +1354,16 @@ func TestDBAStmt(t *testing.T) {
 		// set default value
 		{"SET @@global.autocommit = default", true, "SET @@GLOBAL.`autocommit`=DEFAULT"},
 		{"SET @@session.autocommit = default", true, "SET @@SESSION.`autocommit`=DEFAULT"},
+		// persist system variables
+		{"SET PERSIST max_connections = 100, PERSIST_ONLY back_log = 10", true, "SET @@PERSIST.`max_connections`=100, @@PERSIST_ONLY.`back_log`=10"},
+		{"SET @@persist.max_connections = 1000", true, "SET @@PERSIST.`max_connections`=1000"},
+		{"SET @@PERSIST_ONLY.innodb_log_file_size=1024, @@global.autocommit=1", true, "SET @@PERSIST_ONLY.`innodb_log_file_size`=1024, @@GLOBAL.`autocommit`=1"},
+		{"SET @@persist.`max_connections` = default", true, "SET @@PERSIST.`max_connections`=DEFAULT"},
+		{"SET persist = 1", true, "SET @@SESSION.`persist`=1"},
+		{"RESET PERSIST", true, "RESET PERSIST"},
+		{"RESET PERSIST max_connections", true, "RESET PERSIST `max_connections`"},
+		{"RESET PERSIST IF EXISTS component.var", true, "RESET PERSIST IF EXISTS `component`.`var`"},
+		{"RESET PERSIST IF EXISTS", false, ""},
 		// set binary value
 		{"SET @@character_set_results = binary", true, "SET @@SESSION.`character_set_results`=_UTF8MB4'BINARY'"},
 		// SET CHARACTER SET
@@ -1357,7 +1499,19 @@ func TestSetVariable(t *testing.T) {
 		require.Equal(t, tbl.IsSystem, v.IsSystem)
 	}
 
-	_, err := p.ParseOneStmt("set xx.xx.xx = 666", "", "")
+	stmt, err := p.ParseOneStmt("set persist a = 1, persist_only b = 1, @@persist.c = 1, @@persist_only.d = 1", "", "")
+	require.NoError(t, err)
+	vars := stmt.(*ast.SetStmt).Variables
+	require.Len(t, vars, 4)
+	for i, v := range vars {
+		persistOnly := i%2 == 1
+		require.True(t, v.IsSystem)
+		require.Equal(t, !persistOnly, v.IsPersist)
+		require.Equal(t, persistOnly, v.IsPersistOnly)
+		require.Equal(t, !persistOnly, v.IsGlobal)
+	}
+
+	_, err = p.ParseOneStmt("set xx.xx.xx = 666", "", "")
 	require.Error(t, err)
 }
 
@@ -1408,6 +1562,17 @@ func TestExpression(t *testing.T) {
 		{"select _utf8 B'1101000010110001';", true, "SELECT _UTF8 b'1101000010110001'"},
 		// for comparison
 		{"select 1 <=> 0, 1 <=> null, 1 = null", true, "SELECT 1<=>0,1<=>NULL,1=NULL"},
+		// for member of
+		{"select * from t where 17 member of (custinfo->'$.zipcode')", true, "SELECT * FROM `t` WHERE 17 MEMBER OF (JSON_EXTRACT(`custinfo`, _UTF8MB4'$.zipcode'))"},
+		{"select a member of ('[1, 2]') and b = c member of (d)", true, "SELECT `a` MEMBER OF (_UTF8MB4'[1, 2]') AND `b`=`c` MEMBER OF (`d`)"},
+		{"select not 1 member of (x), a + 1 member of (b) = 1", true, "SELECT NOT 1 MEMBER OF (`x`),`a`+1 MEMBER OF (`b`)=1"},
+		{"select 1 member of j", false, ""},
+		{"select 1 member (j)", false, ""},
+		{"select member(1, j)", false, ""},
+		{"select member of (j)", false, ""},
+		{"select 1 member of ('[1]', '[2]')", false, ""},
+		{"select 1 not member of (j)", false, ""},
+		{"select member, array from member", true, "SELECT `member`,`array` FROM `member`"},
 		// for date literal
 		{"select date'1989-09-10'", true, "SELECT DATE '1989-09-10'"},
 		{"select date 19890910", false, ""},
@@ -1604,6 +1769,22 @@ func TestBuiltin(t *testing.T) {
 		{"select cast('2000' as year);", true, "SELECT CAST(_UTF8MB4'2000' AS YEAR)"},
 		{"select cast(time '2000' as year);", true, "SELECT CAST(TIME '2000' AS YEAR)"},
 
+		// for cast as array
+		{"select cast(a as unsigned array), cast(b as char(10) array)", true, "SELECT CAST(`a` AS UNSIGNED ARRAY),CAST(`b` AS CHAR(10) ARRAY)"},
+		{"select cast(j->'$.a' as signed array)", true, "SELECT CAST(JSON_EXTRACT(`j`, _UTF8MB4'$.a') AS SIGNED ARRAY)"},
+		{"select convert(a, unsigned array), convert(j->'$.b', char(8) array)", true, "SELECT CONVERT(`a`, UNSIGNED ARRAY),CONVERT(JSON_EXTRACT(`j`, _UTF8MB4'$.b'), CHAR(8) ARRAY)"},
+		{"select convert(a using utf8mb4 array)", false, ""},
+		{"select convert(a, array)", false, ""},
+		{"select cast(a as unsigned array array)", false, ""},
+		{"select cast(a as array)", false, ""},
+		{"select cast(a as binary array), cast(a as date array), cast(a as datetime(6) array), cast(a as decimal(10, 2) array), cast(a as time array), cast(a as signed integer array)", true, "SELECT CAST(`a` AS BINARY ARRAY),CAST(`a` AS DATE ARRAY),CAST(`a` AS DATETIME(6) ARRAY),CAST(`a` AS DECIMAL(10, 2) ARRAY),CAST(`a` AS TIME ARRAY),CAST(`a` AS SIGNED ARRAY)"},
+		{"select cast(a as json array)", false, ""},
+		{"select cast(a as year array)", false, ""},
+		{"select cast(a as double array)", false, ""},
+		{"select cast(a as float array)", false, ""},
+		{"select convert(a, json array)", false, ""},
+		{"select cast(a as json)", true, "SELECT CAST(`a` AS JSON)"},
+
 		// for last_insert_id
 		{"SELECT last_insert_id();", true, "SELECT LAST_INSERT_ID()"},
 		{"SELECT last_insert_id(1);", true, "SELECT LAST_INSERT_ID(1)"},
@@ -2630,6 +2811,119 @@ func TestDDL(t *testing.T) {
 		{"drop trigger xxx", true, "DROP TRIGGER `xxx`"},
 		{"drop trigger if exists xxx", true, "DROP TRIGGER IF EXISTS `xxx`"},
 		{"drop trigger if exists xxx.yyy", true, "DROP TRIGGER IF EXISTS `xxx`.`yyy`"},
+
+		// for create trigger
+		{"create trigger trg before insert on t for each row set new.a = 1", true, "CREATE TRIGGER `trg` BEFORE INSERT ON `t` FOR EACH ROW SET `new`.`a`=1"},
+		{"create trigger if not exists db.trg after update on db.t for each row insert into log values (old.id, new.id)", true, "CREATE TRIGGER IF NOT EXISTS `db`.`trg` AFTER UPDATE ON `db`.`t` FOR EACH ROW INSERT INTO `log` VALUES (`old`.`id`,`new`.`id`)"},
+		{"create definer = 'root'@'localhost' trigger trg after delete on t for each row delete from t2 where id = old.id", true, "CREATE DEFINER = `root`@`localhost` TRIGGER `trg` AFTER DELETE ON `t` FOR EACH ROW DELETE FROM `t2` WHERE `id`=`old`.`id`"},
+		{"create definer = current_user trigger trg before update on t for each row follows trg0 set NEW.b = OLD.b + 1, @cnt = @cnt + 1", true, "CREATE TRIGGER `trg` BEFORE UPDATE ON `t` FOR EACH ROW FOLLOWS `trg0` SET `NEW`.`b`=`OLD`.`b`+1, @`cnt`=@`cnt`+1"},
+		{"CREATE DEFINER=`root`@`%` TRIGGER `ins_sum` BEFORE INSERT ON `account` FOR EACH ROW PRECEDES `ins_log` SET @sum = @sum + NEW.amount", true, "CREATE DEFINER = `root`@`%` TRIGGER `ins_sum` BEFORE INSERT ON `account` FOR EACH ROW PRECEDES `ins_log` SET @`sum`=@`sum`+`NEW`.`amount`"},
+		{"create trigger trg before insert on t for each row update t2 set c = c + 1", true, "CREATE TRIGGER `trg` BEFORE INSERT ON `t` FOR EACH ROW UPDATE `t2` SET `c`=`c`+1"},
+		{"create trigger trg before insert on t for each row call p(new.a)", true, "CREATE TRIGGER `trg` BEFORE INSERT ON `t` FOR EACH ROW CALL `p`(`new`.`a`)"},
+		{"create trigger trg before insert on t for each row set global x = 1", true, "CREATE TRIGGER `trg` BEFORE INSERT ON `t` FOR EACH ROW SET @@GLOBAL.`x`=1"},
+		{"create trigger trg before select on t for each row set new.a = 1", false, ""},
+		{"create trigger trg insert on t for each row set new.a = 1", false, ""},
+		{"create trigger trg before insert on t set new.a = 1", false, ""},
+		{"create trigger trg before insert on t for each row", false, ""},
+		{"create or replace trigger trg before insert on t for each row set new.a = 1", false, ""},
+		{"create trigger trg before insert on t for each row begin if new.a < 0 then set new.a = 0; end if; end", true, "CREATE TRIGGER `trg` BEFORE INSERT ON `t` FOR EACH ROW BEGIN IF `new`.`a`<0 THEN SET `new`.`a`=0; END IF; END"},
+
+		// for create procedure
+		{"create procedure p() select 1", true, "CREATE PROCEDURE `p`() SELECT 1"},
+		{"create procedure if not exists db.p(a int, in b varchar(10), out c int, inout d bigint) set c = a", true, "CREATE PROCEDURE IF NOT EXISTS `db`.`p`(IN `a` INT,IN `b` VARCHAR(10),OUT `c` INT,INOUT `d` BIGINT) SET `c`=`a`"},
+		{"create definer = 'root'@'%' procedure p() comment 'test' language sql not deterministic contains sql sql security invoker begin end", true, "CREATE DEFINER = `root`@`%` PROCEDURE `p`() COMMENT 'test' LANGUAGE SQL NOT DETERMINISTIC CONTAINS SQL SQL SECURITY INVOKER BEGIN END"},
+		{"create procedure p() deterministic no sql reads sql data modifies sql data sql security definer begin end", true, "CREATE PROCEDURE `p`() DETERMINISTIC NO SQL READS SQL DATA MODIFIES SQL DATA SQL SECURITY DEFINER BEGIN END"},
+		{"create procedure p(in a int) begin declare x, y int default 0; declare s varchar(20); set x = a + 1, @u = x; select x, y; end", true, "CREATE PROCEDURE `p`(IN `a` INT) BEGIN DECLARE `x`,`y` INT DEFAULT 0; DECLARE `s` VARCHAR(20); SET `x`=`a`+1, @`u`=`x`; SELECT `x`,`y`; END"},
+		{"create procedure p() begin declare no_table condition for sqlstate '42S02'; declare dup_key condition for 1062; declare c cursor for select id from t where id > 1; declare continue handler for not found, no_table set @done = 1; declare exit handler for sqlwarning, sqlexception, sqlstate value '23000', 1213 begin rollback; end; end", true, "CREATE PROCEDURE `p`() BEGIN DECLARE `no_table` CONDITION FOR SQLSTATE '42S02'; DECLARE `dup_key` CONDITION FOR 1062; DECLARE `c` CURSOR FOR SELECT `id` FROM `t` WHERE `id`>1; DECLARE CONTINUE HANDLER FOR NOT FOUND,`no_table` SET @`done`=1; DECLARE EXIT HANDLER FOR SQLWARNING,SQLEXCEPTION,SQLSTATE '23000',1213 BEGIN ROLLBACK; END; END"},
+		{"create procedure p() begin start transaction; create temporary table x (a int); drop temporary table if exists x; alter table t add column b int; show tables; lock tables t read; unlock tables; (select 1); commit; end", true, "CREATE PROCEDURE `p`() BEGIN START TRANSACTION; CREATE TEMPORARY TABLE `x` (`a` INT); DROP TEMPORARY TABLE IF EXISTS `x`; ALTER TABLE `t` ADD COLUMN `b` INT; SHOW TABLES; LOCK TABLES `t` READ; UNLOCK TABLES; (SELECT 1); COMMIT; END"},
+		{"create procedure p() begin create index i on t (a); drop index i on t; rename table t to t2; (select 1) union (select 2); explain select 1; end", true, "CREATE PROCEDURE `p`() BEGIN CREATE INDEX `i` ON `t` (`a`); DROP INDEX `i` ON `t`; RENAME TABLE `t` TO `t2`; (SELECT 1) UNION (SELECT 2); EXPLAIN FORMAT = 'row' SELECT 1; END"},
+		{"create procedure p() begin begin; end", false, ""},
+		{"create procedure p() begin begin pessimistic; end", false, ""},
+		{"create procedure p() begin create procedure q() begin end; end", false, ""},
+		{"create procedure p() begin begin declare sql_mode int; set sql_mode = 1; end; set sql_mode = ''; end", true, "CREATE PROCEDURE `p`() BEGIN BEGIN DECLARE `sql_mode` INT; SET `sql_mode`=1; END; SET @@SESSION.`sql_mode`=_UTF8MB4''; END"},
+		{"create procedure p() begin declare x int; set session x = 1; set @@x = 2; set local x = 3; set @@session.x = 4; set x = 5; end", true, "CREATE PROCEDURE `p`() BEGIN DECLARE `x` INT; SET @@SESSION.`x`=1; SET @@SESSION.`x`=2; SET @@SESSION.`x`=3; SET @@SESSION.`x`=4; SET `x`=5; END"},
+		{"create procedure p() begin declare a, b int; declare c cursor for select x, y from t; open c; fetch c into a, b; fetch from c into a, b; fetch next from c into a, b; close c; end", true, "CREATE PROCEDURE `p`() BEGIN DECLARE `a`,`b` INT; DECLARE `c` CURSOR FOR SELECT `x`,`y` FROM `t`; OPEN `c`; FETCH `c` INTO `a`,`b`; FETCH `c` INTO `a`,`b`; FETCH `c` INTO `a`,`b`; CLOSE `c`; END"},
+		{"create procedure p(x int) begin if x > 10 then select 1; elseif x > 5 then select 2; select 3; else select 4; end if; case x when 1 then select 1; when 2 then select 2; else begin end; end case; case when x > 1 then select 1; end case; end", true, "CREATE PROCEDURE `p`(IN `x` INT) BEGIN IF `x`>10 THEN SELECT 1; ELSEIF `x`>5 THEN SELECT 2; SELECT 3; ELSE SELECT 4; END IF; CASE `x` WHEN 1 THEN SELECT 1; WHEN 2 THEN SELECT 2; ELSE BEGIN END; END CASE; CASE WHEN `x`>1 THEN SELECT 1; END CASE; END"},
+		{"create procedure p(x int) lbl: begin l1: loop set x = x + 1; if x > 10 then leave l1; end if; iterate l1; end loop l1; while x > 0 do set x = x - 1; end while; l2: repeat set x = x + 1; until x > 5 end repeat; end lbl", true, "CREATE PROCEDURE `p`(IN `x` INT) `lbl`: BEGIN `l1`: LOOP SET `x`=`x`+1; IF `x`>10 THEN LEAVE `l1`; END IF; ITERATE `l1`; END LOOP `l1`; WHILE `x`>0 DO SET `x`=`x`-1; END WHILE; `l2`: REPEAT SET `x`=`x`+1; UNTIL `x`>5 END REPEAT `l2`; END `lbl`"},
+		{"create procedure p() begin insert into t values (1); update t set a = 1; delete from t; replace into t values (2); call p2(); do 1; commit; end", true, "CREATE PROCEDURE `p`() BEGIN INSERT INTO `t` VALUES (1); UPDATE `t` SET `a`=1; DELETE FROM `t`; REPLACE INTO `t` VALUES (2); CALL `p2`(); DO 1; COMMIT; END"},
+		{"create procedure p(out r int) begin declare x, y int; select a into x from t where id = 1; select a, b from t into x, y; select count(*) into r from t for update; select x, y into @x, r; end", true, "CREATE PROCEDURE `p`(OUT `r` INT) BEGIN DECLARE `x`,`y` INT; SELECT `a` FROM `t` WHERE `id`=1 INTO `x`; SELECT `a`,`b` FROM `t` INTO `x`,`y`; SELECT COUNT(1) FROM `t` FOR UPDATE INTO `r`; SELECT `x`,`y` INTO @`x`,`r`; END"},
+		{"create procedure p() l1: begin end l2", false, ""},
+		{"create procedure p() begin select 1; declare a int; end", false, ""},
+		{"create procedure p() begin declare c cursor for select 1; fetch c into @x; end", false, ""},
+		{"create procedure p() begin select 1 end", false, ""},
+		{"create procedure p", false, ""},
+
+		// for create function
+		{"create function f() returns int return 1", true, "CREATE FUNCTION `f`() RETURNS INT RETURN 1"},
+		{"create definer = current_user function if not exists db.f(a int, b varchar(10)) returns varchar(20) deterministic reads sql data begin declare r varchar(20); set r = concat(b, a); return r; end", true, "CREATE FUNCTION IF NOT EXISTS `db`.`f`(`a` INT,`b` VARCHAR(10)) RETURNS VARCHAR(20) DETERMINISTIC READS SQL DATA BEGIN DECLARE `r` VARCHAR(20); SET `r`=CONCAT(`b`, `a`); RETURN `r`; END"},
+		{"create function f(in a int) returns int return a", false, ""},
+		{"create function f returns string soname 'udf.so'", true, "CREATE FUNCTION `f` RETURNS STRING SONAME 'udf.so'"},
+		{"create function if not exists f returns integer soname 'udf.so'", true, "CREATE FUNCTION IF NOT EXISTS `f` RETURNS INTEGER SONAME 'udf.so'"},
+		{"create aggregate function f returns real soname 'udf.so'", true, "CREATE AGGREGATE FUNCTION `f` RETURNS REAL SONAME 'udf.so'"},
+		{"create aggregate function if not exists f returns decimal soname 'udf.so'", true, "CREATE AGGREGATE FUNCTION IF NOT EXISTS `f` RETURNS DECIMAL SONAME 'udf.so'"},
+		{"create function db.f returns string soname 'udf.so'", false, ""},
+		{"create definer = current_user function f returns string soname 'udf.so'", false, ""},
+		{"create definer = current_user aggregate function f returns string soname 'udf.so'", false, ""},
+		{"create aggregate function f() returns int return 1", false, ""},
+		{"create aggregate function f returns int soname 'udf.so'", false, ""},
+		{"create function f returns string", false, ""},
+		{"create function f() return 1", false, ""},
+
+		// for alter/drop procedure and function
+		{"alter procedure p", true, "ALTER PROCEDURE `p`"},
+		{"alter procedure db.p comment 'x' sql security invoker", true, "ALTER PROCEDURE `db`.`p` COMMENT 'x' SQL SECURITY INVOKER"},
+		{"alter function f language sql modifies sql data", true, "ALTER FUNCTION `f` LANGUAGE SQL MODIFIES SQL DATA"},
+		{"alter procedure p deterministic", false, ""},
+		{"drop procedure p", true, "DROP PROCEDURE `p`"},
+		{"drop procedure if exists db.p", true, "DROP PROCEDURE IF EXISTS `db`.`p`"},
+		{"drop function f", true, "DROP FUNCTION `f`"},
+		{"drop function if exists f", true, "DROP FUNCTION IF EXISTS `f`"},
+		// for multi-valued index
+		{"create table t (id int, tags json, index zips ((cast(tags->'$' as unsigned array))), key ((cast(custinfo->'$.zipcode' as char(10) array))))", true, "CREATE TABLE `t` (`id` INT,`tags` JSON,INDEX `zips`((CAST(JSON_EXTRACT(`tags`, _UTF8MB4'$') AS UNSIGNED ARRAY))),INDEX((CAST(JSON_EXTRACT(`custinfo`, _UTF8MB4'$.zipcode') AS CHAR(10) ARRAY))))"},
+		{"create index idx on t ((cast(j->'$.tags' as char(32) array)))", true, "CREATE INDEX `idx` ON `t` ((CAST(JSON_EXTRACT(`j`, _UTF8MB4'$.tags') AS CHAR(32) ARRAY)))"},
+		{"create index idx on t ((convert(j->'$.tags', unsigned array)))", true, "CREATE INDEX `idx` ON `t` ((CONVERT(JSON_EXTRACT(`j`, _UTF8MB4'$.tags'), UNSIGNED ARRAY)))"},
+
+		// for spatial types
+		{"create table t (g geometry, p point, l linestring, pg polygon, mp multipoint, ml multilinestring, mpg multipolygon, gc geometrycollection)", true, "CREATE TABLE `t` (`g` GEOMETRY,`p` POINT,`l` LINESTRING,`pg` POLYGON,`mp` MULTIPOINT,`ml` MULTILINESTRING,`mpg` MULTIPOLYGON,`gc` GEOMETRYCOLLECTION)"},
+		{"create table t (gc geomcollection)", true, "CREATE TABLE `t` (`gc` GEOMETRYCOLLECTION)"},
+		{"create table t (g geometry not null srid 4326, spatial index sp (g))", true, "CREATE TABLE `t` (`g` GEOMETRY NOT NULL SRID 4326,SPATIAL `sp`(`g`))"},
+		{"create table t (p point srid 0 not null, spatial key (p), spatial (p) comment 'geo')", true, "CREATE TABLE `t` (`p` POINT SRID 0 NOT NULL,SPATIAL(`p`),SPATIAL(`p`) COMMENT 'geo')"},
+		{"create table t (p point srid 4294967295)", true, "CREATE TABLE `t` (`p` POINT SRID 4294967295)"},
+		{"create table t (p point srid 4294967296)", false, ""},
+		{"create table t (p point srid)", false, ""},
+		{"create table t (p point(10))", false, ""},
+		{"create table t (point int, polygon int, srid int, geometry int)", true, "CREATE TABLE `t` (`point` INT,`polygon` INT,`srid` INT,`geometry` INT)"},
+		{"select point(1, 2), linestring(point(0, 0), point(1, 1)), polygon(linestring(point(0, 0), point(1, 1), point(0, 0)))", true, "SELECT POINT(1, 2),LINESTRING(POINT(0, 0), POINT(1, 1)),POLYGON(LINESTRING(POINT(0, 0), POINT(1, 1), POINT(0, 0)))"},
+		{"select multipoint(point(1, 1)), multilinestring(l), multipolygon(pg), geometrycollection(p, l)", true, "SELECT MULTIPOINT(POINT(1, 1)),MULTILINESTRING(`l`),MULTIPOLYGON(`pg`),GEOMETRYCOLLECTION(`p`, `l`)"},
+		{"SELECT GEOMCOLLECTION(POINT(1,1)), geomcollection()", true, "SELECT GEOMCOLLECTION(POINT(1, 1)),GEOMCOLLECTION()"},
+		{"select st_astext(st_geomfromtext('POINT(1 1)', 4326)), st_distance_sphere(a, b), st_srid(g) from t where st_contains(g, point(1, 1))", true, "SELECT ST_ASTEXT(ST_GEOMFROMTEXT(_UTF8MB4'POINT(1 1)', 4326)),ST_DISTANCE_SPHERE(`a`, `b`),ST_SRID(`g`) FROM `t` WHERE ST_CONTAINS(`g`, POINT(1, 1))"},
+
+		// for event
+		{"create event e on schedule at current_timestamp + interval 1 hour do update t set a = a + 1", true, "CREATE EVENT `e` ON SCHEDULE AT DATE_ADD(CURRENT_TIMESTAMP(), INTERVAL 1 HOUR) DO UPDATE `t` SET `a`=`a`+1"},
+		{"create definer = 'root'@'%' event if not exists db.e on schedule every 1 day starts '2022-01-01' ends '2023-01-01' + interval 1 month on completion not preserve disable on slave comment 'daily' do begin delete from t; end", true, "CREATE DEFINER = `root`@`%` EVENT IF NOT EXISTS `db`.`e` ON SCHEDULE EVERY 1 DAY STARTS _UTF8MB4'2022-01-01' ENDS DATE_ADD(_UTF8MB4'2023-01-01', INTERVAL 1 MONTH) ON COMPLETION NOT PRESERVE DISABLE ON SLAVE COMMENT 'daily' DO BEGIN DELETE FROM `t`; END"},
+		{"create event e on schedule every '1:30' hour_minute on completion preserve enable do call p()", true, "CREATE EVENT `e` ON SCHEDULE EVERY _UTF8MB4'1:30' HOUR_MINUTE ON COMPLETION PRESERVE ENABLE DO CALL `p`()"},
+		{"create event e on schedule every 1 day ends now() do select 1", true, "CREATE EVENT `e` ON SCHEDULE EVERY 1 DAY ENDS NOW() DO SELECT 1"},
+		{"create event e on schedule every 1 day do begin start transaction read only; show status like 'a'; end", true, "CREATE EVENT `e` ON SCHEDULE EVERY 1 DAY DO BEGIN START TRANSACTION READ ONLY; SHOW SESSION STATUS LIKE _UTF8MB4'a'; END"},
+		{"create event e on schedule every 1 day do create table if not exists t2 like t", true, "CREATE EVENT `e` ON SCHEDULE EVERY 1 DAY DO CREATE TABLE IF NOT EXISTS `t2` LIKE `t`"},
+		{"create event e on schedule every 1 day do begin; end", false, ""},
+		{"create event e on schedule every 1 do select 1", false, ""},
+		{"create event e on schedule at now() starts now() do select 1", false, ""},
+		{"create event e on schedule at now()", false, ""},
+		{"create event e do select 1", false, ""},
+		{"create event e on schedule at now() enable on completion preserve do select 1", false, ""},
+		{"alter event e on schedule every 2 hour", true, "ALTER EVENT `e` ON SCHEDULE EVERY 2 HOUR"},
+		{"alter event e on completion preserve", true, "ALTER EVENT `e` ON COMPLETION PRESERVE"},
+		{"alter event e on schedule at now() on completion not preserve rename to db2.e2 disable comment 'x' do select 1", true, "ALTER EVENT `e` ON SCHEDULE AT NOW() ON COMPLETION NOT PRESERVE RENAME TO `db2`.`e2` DISABLE COMMENT 'x' DO SELECT 1"},
+		{"alter definer = current_user event e enable", true, "ALTER EVENT `e` ENABLE"},
+		{"alter event e disable on slave", true, "ALTER EVENT `e` DISABLE ON SLAVE"},
+		{"alter event e disable on replica", true, "ALTER EVENT `e` DISABLE ON REPLICA"},
+		{"create event e on schedule at current_timestamp disable on replica do delete from t", true, "CREATE EVENT `e` ON SCHEDULE AT CURRENT_TIMESTAMP() DISABLE ON REPLICA DO DELETE FROM `t`"},
+		{"alter event e disable on source", false, ""},
+		{"alter event e do begin end", true, "ALTER EVENT `e` DO BEGIN END"},
+		{"alter event e", false, ""},
+		{"drop event e", true, "DROP EVENT `e`"},
+		{"drop event if exists db.e", true, "DROP EVENT IF EXISTS `db`.`e`"},
+		{"drop event e1, e2", false, ""},
 		{"drop schema xxx", true, "DROP DATABASE `xxx`"},
 		{"drop schema if exists xxx", true, "DROP DATABASE IF EXISTS `xxx`"},
 		{"drop schema if not exists xxx", false, ""},
@@ -2952,6 +3246,10 @@ func TestDDL(t *testing.T) {
 		{"ALTER TABLE t ADD FULLTEXT KEY `FullText` (`name` ASC)", true, "ALTER TABLE `t` ADD FULLTEXT `FullText`(`name`)"},
 		{"ALTER TABLE t ADD FULLTEXT `FullText` (`name` ASC)", true, "ALTER TABLE `t` ADD FULLTEXT `FullText`(`name`)"},
 		{"ALTER TABLE t ADD FULLTEXT INDEX `FullText` (`name` ASC)", true, "ALTER TABLE `t` ADD FULLTEXT `FullText`(`name`)"},
+		{"ALTER TABLE t ADD SPATIAL KEY `sp` (`g`)", true, "ALTER TABLE `t` ADD SPATIAL `sp`(`g`)"},
+		{"ALTER TABLE t ADD INDEX idx((CAST(j->'$.a' AS SIGNED ARRAY)), a)", true, "ALTER TABLE `t` ADD INDEX `idx`((CAST(JSON_EXTRACT(`j`, _UTF8MB4'$.a') AS SIGNED ARRAY)), `a`)"},
+		{"ALTER TABLE t ADD SPATIAL INDEX (`g`) COMMENT 'geo'", true, "ALTER TABLE `t` ADD SPATIAL(`g`) COMMENT 'geo'"},
+		{"ALTER TABLE t ADD COLUMN p POINT NOT NULL SRID 4326", true, "ALTER TABLE `t` ADD COLUMN `p` POINT NOT NULL SRID 4326"},
 		{"ALTER TABLE t ADD INDEX (a) USING BTREE COMMENT 'a'", true, "ALTER TABLE `t` ADD INDEX(`a`) USING BTREE COMMENT 'a'"},
 		{"ALTER TABLE t ADD INDEX IF NOT EXISTS (a) USING BTREE COMMENT 'a'", true, "ALTER TABLE `t` ADD INDEX IF NOT EXISTS(`a`) USING BTREE COMMENT 'a'"},
 		{"ALTER TABLE t ADD INDEX (a) USING RTREE COMMENT 'a'", true, "ALTER TABLE `t` ADD INDEX(`a`) USING RTREE COMMENT 'a'"},
@@ -3108,6 +3406,7 @@ func TestDDL(t *testing.T) {
 		{"CREATE UNIQUE INDEX ident USING BTREE ON d_n.t_n ( ident , ident ASC )", true, "CREATE UNIQUE INDEX `ident` ON `d_n`.`t_n` (`ident`, `ident`) USING BTREE"},
 		{"CREATE SPATIAL INDEX idx ON t (a)", true, "CREATE SPATIAL INDEX `idx` ON `t` (`a`)"},
 		{"CREATE SPATIAL INDEX IF NOT EXISTS idx ON t (a)", true, "CREATE SPATIAL INDEX IF NOT EXISTS `idx` ON `t` (`a`)"},
+		{"CREATE SPATIAL INDEX idx ON t (a) COMMENT 'geo'", true, "CREATE SPATIAL INDEX `idx` ON `t` (`a`) COMMENT 'geo'"},
 		{"CREATE FULLTEXT INDEX idx ON t (a)", true, "CREATE FULLTEXT INDEX `idx` ON `t` (`a`)"},
 		{"CREATE FULLTEXT INDEX IF NOT EXISTS idx ON t (a)", true, "CREATE FULLTEXT INDEX IF NOT EXISTS `idx` ON `t` (`a`)"},
 		{"CREATE FULLTEXT INDEX idx ON t (a) WITH PARSER ident", true, "CREATE FULLTEXT INDEX `idx` ON `t` (`a`) WITH PARSER `ident`"},
@@ -5152,6 +5451,9 @@ func TestView(t *testing.T) {
 		{"create or replace algorithm = merge definer = 'root' sql security invoker view v(a,b) as (select * from t union all select * from t) with local check option", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = `root`@`%` SQL SECURITY INVOKER VIEW `v` (`a`,`b`) AS (SELECT * FROM `t` UNION ALL SELECT * FROM `t`) WITH LOCAL CHECK OPTION"},
 		{"create or replace algorithm = merge definer = 'root' sql security invoker view v(a,b) as (select * from t union all select * from t) with cascaded check option", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = `root`@`%` SQL SECURITY INVOKER VIEW `v` (`a`,`b`) AS (SELECT * FROM `t` UNION ALL SELECT * FROM `t`)"},
 		{"create or replace algorithm = merge definer = current_user view v as select * from t union all select * from t", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = CURRENT_USER SQL SECURITY DEFINER VIEW `v` AS SELECT * FROM `t` UNION ALL SELECT * FROM `t`"},
+		{"create definer = 'root' view v as select * from t", true, "CREATE ALGORITHM = UNDEFINED DEFINER = `root`@`%` SQL SECURITY DEFINER VIEW `v` AS SELECT * FROM `t`"},
+		{"create definer = 'root' sql security invoker view v as select * from t", true, "CREATE ALGORITHM = UNDEFINED DEFINER = `root`@`%` SQL SECURITY INVOKER VIEW `v` AS SELECT * FROM `t`"},
+		{"create algorithm = merge view v as select * from t", true, "CREATE ALGORITHM = MERGE DEFINER = CURRENT_USER SQL SECURITY DEFINER VIEW `v` AS SELECT * FROM `t`"},
 	}
 	RunTest(t, table, false)
 
@@ -5188,6 +5490,170 @@ func TestView(t *testing.T) {
 	require.Equal(t, model.CheckOptionCascaded, v.CheckOption)
 }
 
+func TestCreateTrigger(t *testing.T) {
+	p := parser.New()
+	st, err := p.ParseOneStmt("CREATE DEFINER = root@localhost TRIGGER trg AFTER UPDATE ON t FOR EACH ROW FOLLOWS trg0 SET NEW.a = 1, b = 2", "", "")
+	require.NoError(t, err)
+	v, ok := st.(*ast.CreateTriggerStmt)
+	require.True(t, ok)
+	require.Equal(t, "root", v.Definer.Username)
+	require.Equal(t, "trg", v.Trigger.Name.O)
+	require.Equal(t, ast.TriggerAfter, v.Timing)
+	require.Equal(t, ast.TriggerEventUpdate, v.Event)
+	require.Equal(t, "t", v.Table.Name.O)
+	require.Equal(t, ast.TriggerOrderFollows, v.Order.Tp)
+	require.Equal(t, "trg0", v.Order.OtherTrigger.O)
+	require.Equal(t, "SET NEW.a = 1, b = 2", v.Body.Text())
+
+	set, ok := v.Body.(*ast.SetStmt)
+	require.True(t, ok)
+	require.True(t, set.Variables[0].IsLocal)
+	require.False(t, set.Variables[0].IsSystem)
+	require.False(t, set.Variables[1].IsLocal)
+	require.True(t, set.Variables[1].IsSystem)
+}
+
+func TestCreateEvent(t *testing.T) {
+	p := parser.New()
+	src := "CREATE EVENT e ON SCHEDULE EVERY 10 MINUTE STARTS NOW() DO BEGIN DECLARE c INT; SET c = 1; END"
+	st, err := p.ParseOneStmt(src, "", "")
+	require.NoError(t, err)
+	v, ok := st.(*ast.CreateEventStmt)
+	require.True(t, ok)
+	require.True(t, v.Definer.CurrentUser)
+	require.Equal(t, "e", v.Name.Name.O)
+	require.Nil(t, v.Schedule.At)
+	require.Equal(t, ast.TimeUnitMinute, v.Schedule.Unit)
+	require.NotNil(t, v.Schedule.Starts)
+	require.Nil(t, v.Schedule.Ends)
+	require.Equal(t, ast.EventCompletionUnspecified, v.OnCompletion)
+	require.Equal(t, ast.EventStatusUnspecified, v.Status)
+	require.Equal(t, "BEGIN DECLARE c INT; SET c = 1; END", v.Body.Text())
+
+	set := v.Body.(*ast.CompoundStmt).Stmts[1].(*ast.SetStmt)
+	require.True(t, set.Variables[0].IsLocal)
+
+	st, err = p.ParseOneStmt("ALTER EVENT e RENAME TO e2", "", "")
+	require.NoError(t, err)
+	alter := st.(*ast.AlterEventStmt)
+	require.Nil(t, alter.Schedule)
+	require.Nil(t, alter.Body)
+	require.Equal(t, "e2", alter.RenameTo.Name.O)
+}
+
+func TestCreateProcedure(t *testing.T) {
+	p := parser.New()
+	src := "CREATE PROCEDURE p(IN a INT, OUT b INT) BEGIN DECLARE c INT DEFAULT 0; SET c = a, b = c, d = 1; END"
+	st, err := p.ParseOneStmt(src, "", "")
+	require.NoError(t, err)
+	v, ok := st.(*ast.CreateProcedureStmt)
+	require.True(t, ok)
+	require.Equal(t, "p", v.Name.Name.O)
+	require.Len(t, v.Params, 2)
+	require.Equal(t, ast.ProcedureParamIn, v.Params[0].Mode)
+	require.Equal(t, ast.ProcedureParamOut, v.Params[1].Mode)
+	require.Equal(t, "BEGIN DECLARE c INT DEFAULT 0; SET c = a, b = c, d = 1; END", v.Body.Text())
+
+	block, ok := v.Body.(*ast.CompoundStmt)
+	require.True(t, ok)
+	require.Len(t, block.Stmts, 2)
+	decl, ok := block.Stmts[0].(*ast.DeclareVarStmt)
+	require.True(t, ok)
+	require.Equal(t, "c", decl.Names[0].L)
+
+	// Assignments to local variables and parameters are not system variables.
+	set, ok := block.Stmts[1].(*ast.SetStmt)
+	require.True(t, ok)
+	require.True(t, set.Variables[0].IsLocal)
+	require.False(t, set.Variables[0].IsSystem)
+	require.True(t, set.Variables[1].IsLocal)
+	require.False(t, set.Variables[2].IsLocal)
+	require.True(t, set.Variables[2].IsSystem)
+
+	// Explicitly scoped names are system variables even if a local variable has the same name.
+	st, err = p.ParseOneStmt("CREATE PROCEDURE p() BEGIN DECLARE x INT; SET SESSION x = 1, @@x = 2, x = 3; END", "", "")
+	require.NoError(t, err)
+	set = st.(*ast.CreateProcedureStmt).Body.(*ast.CompoundStmt).Stmts[1].(*ast.SetStmt)
+	for _, v := range set.Variables[:2] {
+		require.True(t, v.IsSystem)
+		require.True(t, v.IsScoped)
+		require.False(t, v.IsLocal)
+	}
+	require.True(t, set.Variables[2].IsLocal)
+	require.False(t, set.Variables[2].IsSystem)
+
+	// A local variable is only visible in the block declaring it and the nested blocks.
+	st, err = p.ParseOneStmt("CREATE PROCEDURE p() BEGIN DECLARE a INT; BEGIN DECLARE sql_mode INT; SET sql_mode = 1, a = 2; END; SET sql_mode = '', a = 3; END", "", "")
+	require.NoError(t, err)
+	block = st.(*ast.CreateProcedureStmt).Body.(*ast.CompoundStmt)
+	set = block.Stmts[1].(*ast.CompoundStmt).Stmts[1].(*ast.SetStmt)
+	require.True(t, set.Variables[0].IsLocal)
+	require.True(t, set.Variables[1].IsLocal)
+	set = block.Stmts[2].(*ast.SetStmt)
+	require.False(t, set.Variables[0].IsLocal)
+	require.True(t, set.Variables[0].IsSystem)
+	require.True(t, set.Variables[1].IsLocal)
+
+	st, err = p.ParseOneStmt("CREATE FUNCTION f(x INT) RETURNS INT l1: LOOP RETURN x; END LOOP l1", "", "")
+	require.NoError(t, err)
+	f, ok := st.(*ast.CreateFunctionStmt)
+	require.True(t, ok)
+	require.Equal(t, ast.ProcedureParamModeNone, f.Params[0].Mode)
+	require.Equal(t, mysql.TypeLong, f.Returns.Tp)
+	loop, ok := f.Body.(*ast.LoopStmt)
+	require.True(t, ok)
+	require.Equal(t, "l1", loop.Label.O)
+
+	_, err = p.ParseOneStmt("CREATE PROCEDURE p() l1: BEGIN END l2", "", "")
+	require.EqualError(t, err, "line 1 column 37 near \"\"End-label l2 without match ")
+}
+
+func TestSignal(t *testing.T) {
+	table := []testCase{
+		{"signal sqlstate '45000'", true, "SIGNAL SQLSTATE '45000'"},
+		{"signal sqlstate value '45000' set message_text = 'oops', mysql_errno = 1001", true, "SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = _UTF8MB4'oops', MYSQL_ERRNO = 1001"},
+		{"signal my_error set class_origin = @a, subclass_origin = b, constraint_catalog = 'c', constraint_schema = 'd', constraint_name = 'e', catalog_name = 'f', schema_name = 'g', table_name = 'h', column_name = 'i', cursor_name = 'j'", true, "SIGNAL `my_error` SET CLASS_ORIGIN = @`a`, SUBCLASS_ORIGIN = `b`, CONSTRAINT_CATALOG = _UTF8MB4'c', CONSTRAINT_SCHEMA = _UTF8MB4'd', CONSTRAINT_NAME = _UTF8MB4'e', CATALOG_NAME = _UTF8MB4'f', SCHEMA_NAME = _UTF8MB4'g', TABLE_NAME = _UTF8MB4'h', COLUMN_NAME = _UTF8MB4'i', CURSOR_NAME = _UTF8MB4'j'"},
+		{"signal sqlstate '45000' set mysql_errno = -1", true, "SIGNAL SQLSTATE '45000' SET MYSQL_ERRNO = -1"},
+		{"signal", false, ""},
+		{"signal sqlstate '45000' set message_text = 'a', message_text = 'b'", false, ""},
+		{"signal sqlstate '45000' set returned_sqlstate = '45000'", false, ""},
+		{"signal sqlstate '45000' set message_text = concat('a', 'b')", false, ""},
+		{"resignal", true, "RESIGNAL"},
+		{"resignal set message_text = 'oops'", true, "RESIGNAL SET MESSAGE_TEXT = _UTF8MB4'oops'"},
+		{"resignal sqlstate '45000' set mysql_errno = 5", true, "RESIGNAL SQLSTATE '45000' SET MYSQL_ERRNO = 5"},
+		{"resignal my_error", true, "RESIGNAL `my_error`"},
+
+		{"get diagnostics @n = number", true, "GET DIAGNOSTICS @`n` = NUMBER"},
+		{"get current diagnostics @n = number, rc = row_count", true, "GET CURRENT DIAGNOSTICS @`n` = NUMBER, `rc` = ROW_COUNT"},
+		{"get stacked diagnostics condition 1 @s = returned_sqlstate, @m = message_text, @e = mysql_errno", true, "GET STACKED DIAGNOSTICS CONDITION 1 @`s` = RETURNED_SQLSTATE, @`m` = MESSAGE_TEXT, @`e` = MYSQL_ERRNO"},
+		{"get diagnostics condition @i @t = table_name", true, "GET DIAGNOSTICS CONDITION @`i` @`t` = TABLE_NAME"},
+		{"get diagnostics @n = message_text", false, ""},
+		{"get diagnostics condition 1 @n = number", false, ""},
+
+		{"create procedure p() begin declare exit handler for sqlexception begin get diagnostics condition 1 @m = message_text; resignal; end; signal sqlstate '45000'; end", true, "CREATE PROCEDURE `p`() BEGIN DECLARE EXIT HANDLER FOR SQLEXCEPTION BEGIN GET DIAGNOSTICS CONDITION 1 @`m` = MESSAGE_TEXT; RESIGNAL; END; SIGNAL SQLSTATE '45000'; END"},
+	}
+	RunTest(t, table, false)
+
+	p := parser.New()
+	for _, src := range []string{
+		"signal sqlstate '00000'",
+		"signal sqlstate '00123'",
+		"signal sqlstate '4500'",
+		"signal sqlstate '4500a'",
+		"resignal sqlstate '00000'",
+		"create procedure p() begin declare c condition for sqlstate '00000'; end",
+		"create procedure p() begin declare continue handler for sqlstate '00000' begin end; end",
+	} {
+		_, warns, err := p.Parse(src, "", "")
+		require.NoError(t, err, src)
+		require.Len(t, warns, 1, src)
+		require.True(t, parser.ErrBadSQLState.Equal(warns[0]), src)
+	}
+	_, warns, err := p.Parse("signal sqlstate 'HY000'", "", "")
+	require.NoError(t, err)
+	require.Len(t, warns, 0)
+}
+
 func TestTimestampDiffUnit(t *testing.T) {
 	// Test case for timestampdiff unit.
 	// TimeUnit should be unified to upper case.
@@ -5484,6 +5950,47 @@ func TestTableSample(t *testing.T) {
 	}
 }
 
+func TestJSONTable(t *testing.T) {
+	table := []testCase{
+		{`select * from json_table('[{"a":1}]', '$[*]' columns (id for ordinality)) as jt`, true, "SELECT * FROM JSON_TABLE(_UTF8MB4'[{\"a\":1}]', '$[*]' COLUMNS(`id` FOR ORDINALITY)) AS `jt`"},
+		{"select * from json_table(@j, '$[*]' columns (a int path '$.a')) jt", true, "SELECT * FROM JSON_TABLE(@`j`, '$[*]' COLUMNS(`a` INT PATH '$.a')) AS `jt`"},
+		{"select * from json_table(@j, '$' columns (a int path '$.a' default '0' on empty)) jt", true, "SELECT * FROM JSON_TABLE(@`j`, '$' COLUMNS(`a` INT PATH '$.a' DEFAULT _UTF8MB4'0' ON EMPTY)) AS `jt`"},
+		{"select * from json_table(@j, '$' columns (a int path '$.a' error on error)) jt", true, "SELECT * FROM JSON_TABLE(@`j`, '$' COLUMNS(`a` INT PATH '$.a' ERROR ON ERROR)) AS `jt`"},
+		{"select * from json_table(@j, '$' columns (a int path '$.a' null on empty default 1 on error)) jt", true, "SELECT * FROM JSON_TABLE(@`j`, '$' COLUMNS(`a` INT PATH '$.a' NULL ON EMPTY DEFAULT 1 ON ERROR)) AS `jt`"},
+		{"select * from json_table(@j, '$' columns (a int path '$.a' error on error null on empty)) jt", true, "SELECT * FROM JSON_TABLE(@`j`, '$' COLUMNS(`a` INT PATH '$.a' NULL ON EMPTY ERROR ON ERROR)) AS `jt`"},
+		{"select * from json_table(@j, '$' columns (a int path '$.a' error on error null on empty default 1 on error)) jt", false, ""},
+		{"select * from json_table(@j, '$' columns (a int path '$.a' null on empty null on empty)) jt", false, ""},
+		{"select * from json_table(@j, '$' columns (b varchar(10) exists path '$.b')) jt", true, "SELECT * FROM JSON_TABLE(@`j`, '$' COLUMNS(`b` VARCHAR(10) EXISTS PATH '$.b')) AS `jt`"},
+		{"select * from json_table(@j, '$' columns (b int exists path '$.b' null on error)) jt", false, ""},
+		{"select * from json_table(@j, '$' columns (nested path '$.c[*]' columns (c json path '$'))) jt", true, "SELECT * FROM JSON_TABLE(@`j`, '$' COLUMNS(NESTED PATH '$.c[*]' COLUMNS(`c` JSON PATH '$'))) AS `jt`"},
+		{"select * from json_table(@j, '$' columns (id for ordinality, nested '$.c' columns (x int path '$.x', nested path '$.y' columns (y int path '$')))) jt", true, "SELECT * FROM JSON_TABLE(@`j`, '$' COLUMNS(`id` FOR ORDINALITY, NESTED PATH '$.c' COLUMNS(`x` INT PATH '$.x', NESTED PATH '$.y' COLUMNS(`y` INT PATH '$')))) AS `jt`"},
+		{"select * from json_table(@j, '$' columns (nested int path '$.n', path int path '$.p', empty int path '$.e')) jt", true, "SELECT * FROM JSON_TABLE(@`j`, '$' COLUMNS(`nested` INT PATH '$.n', `path` INT PATH '$.p', `empty` INT PATH '$.e')) AS `jt`"},
+		{"select t.id, jt.n from t, json_table(t.doc, '$' columns (n int path '$.n')) jt where jt.n > 1", true, "SELECT `t`.`id`,`jt`.`n` FROM (`t`) JOIN JSON_TABLE(`t`.`doc`, '$' COLUMNS(`n` INT PATH '$.n')) AS `jt` WHERE `jt`.`n`>1"},
+		{"select * from t left join json_table(t.doc, '$' columns (n int path '$.n')) as jt on true", true, "SELECT * FROM `t` LEFT JOIN JSON_TABLE(`t`.`doc`, '$' COLUMNS(`n` INT PATH '$.n')) AS `jt` ON TRUE"},
+		{"select * from json_table(@j, '$' columns (a int path '$.a'))", false, ""},
+		{"select * from json_table(@j, '$' columns ()) jt", false, ""},
+		{"select * from json_table(@j, '$') jt", false, ""},
+		{"select * from json_table(@j, @p columns (a int path '$.a')) jt", false, ""},
+	}
+	RunTest(t, table, false)
+}
+
+func TestLateral(t *testing.T) {
+	table := []testCase{
+		{"select * from t1, lateral (select * from t2 where t2.a = t1.a) as d", true, "SELECT * FROM (`t1`) JOIN LATERAL (SELECT * FROM `t2` WHERE `t2`.`a`=`t1`.`a`) AS `d`"},
+		{"select * from t1, lateral (select * from t2 where t2.a = t1.a) d, t3", true, "SELECT * FROM ((`t1`) JOIN LATERAL (SELECT * FROM `t2` WHERE `t2`.`a`=`t1`.`a`) AS `d`) JOIN `t3`"},
+		{"select * from t1 join lateral (select max(b) as m from t2 where t2.a = t1.a) as d on true", true, "SELECT * FROM `t1` JOIN LATERAL (SELECT MAX(`b`) AS `m` FROM `t2` WHERE `t2`.`a`=`t1`.`a`) AS `d` ON TRUE"},
+		{"select * from t1 left join lateral (select * from t2 where t2.a = t1.a limit 1) as d on d.b > 0", true, "SELECT * FROM `t1` LEFT JOIN LATERAL (SELECT * FROM `t2` WHERE `t2`.`a`=`t1`.`a` LIMIT 1) AS `d` ON `d`.`b`>0"},
+		{"select * from t1 cross join lateral (select t1.a union select 2) as d", true, "SELECT * FROM `t1` JOIN LATERAL (SELECT `t1`.`a` UNION SELECT 2) AS `d`"},
+		{"select * from t1 join lateral (table t2) as d", true, "SELECT * FROM `t1` JOIN LATERAL (TABLE `t2`) AS `d`"},
+		{"select * from t1, lateral (select 1) as d(a)", false, ""},
+		{"select * from t1, lateral (select 1)", false, ""},
+		{"select * from lateral t2", false, ""},
+		{"select lateral from t", false, ""},
+	}
+	RunTest(t, table, false)
+}
+
 func TestGeneratedColumn(t *testing.T) {
 	tests := []struct {
 		input string
@@ -5963,6 +6470,12 @@ func TestFieldText(t *testing.T) {
 	tmp := stmts[0].(*ast.SelectStmt)
 	require.Equal(t, "a", tmp.Fields.Fields[0].Text())
 
+	for _, sql := range []string{"select a + 1  into @x from t", "select a + 1 into @x", "select a + 1 from t into @x"} {
+		stmts, _, err = p.Parse(sql, "", "")
+		require.NoError(t, err)
+		require.Equal(t, "a + 1", stmts[0].(*ast.SelectStmt).Fields.Fields[0].Text(), sql)
+	}
+
 	sqls := []string{
 		"trace select a from t",
 		"trace format = 'row' select a from t",
@@ -6222,6 +6735,9 @@ func (checker *nodeTextCleaner) Enter(in ast.Node) (out ast.Node, skipChildren b
 		node.F = strings.ToLower(node.F)
 	case *ast.SelectField:
 		node.Offset = 0
+	case *ast.VariableAssignment:
+		// The scope of system variables is always restored explicitly.
+		node.IsScoped = false
 	case *test_driver.ValueExpr:
 		if node.Kind() == test_driver.KindMysqlDecimal {
 			_ = node.GetMysqlDecimal().FromString(node.GetMysqlDecimal().ToString())
@@ -6369,6 +6885,224 @@ func TestPurge(t *testing.T) {
 	RunTest(t, cases, false)
 }
 
+func TestReplication(t *testing.T) {
+	cases := []testCase{
+		{"CHANGE REPLICATION SOURCE TO SOURCE_HOST='h1', SOURCE_PORT=3306, source_user='repl', SOURCE_PASSWORD='secret', SOURCE_AUTO_POSITION=1 FOR CHANNEL 'ch1'", true, "CHANGE REPLICATION SOURCE TO SOURCE_HOST = 'h1', SOURCE_PORT = 3306, SOURCE_USER = 'repl', SOURCE_PASSWORD = 'secret', SOURCE_AUTO_POSITION = 1 FOR CHANNEL 'ch1'"},
+		{"CHANGE REPLICATION SOURCE TO SOURCE_TLS_CIPHERSUITES = NULL, SOURCE_TLS_VERSION = 'TLSv1.3'", true, "CHANGE REPLICATION SOURCE TO SOURCE_TLS_CIPHERSUITES = NULL, SOURCE_TLS_VERSION = 'TLSv1.3'"},
+		{"CHANGE MASTER TO MASTER_TLS_CIPHERSUITES = null", true, "CHANGE MASTER TO MASTER_TLS_CIPHERSUITES = NULL"},
+		{"CHANGE REPLICATION SOURCE TO SOURCE_TLS_CIPHERSUITES = 'TLS_AES_128_GCM_SHA256'", true, "CHANGE REPLICATION SOURCE TO SOURCE_TLS_CIPHERSUITES = 'TLS_AES_128_GCM_SHA256'"},
+		{"CHANGE REPLICATION SOURCE TO SOURCE_HOST = NULL", false, ""},
+		{"CHANGE REPLICATION SOURCE TO SOURCE_HEARTBEAT_PERIOD=1.5, IGNORE_SERVER_IDS=(1,2,3), GET_SOURCE_PUBLIC_KEY=1", true, "CHANGE REPLICATION SOURCE TO SOURCE_HEARTBEAT_PERIOD = 1.5, IGNORE_SERVER_IDS = (1, 2, 3), GET_SOURCE_PUBLIC_KEY = 1"},
+		{"CHANGE REPLICATION SOURCE TO PRIVILEGE_CHECKS_USER='priv'@'localhost', REQUIRE_TABLE_PRIMARY_KEY_CHECK=on", true, "CHANGE REPLICATION SOURCE TO PRIVILEGE_CHECKS_USER = `priv`@`localhost`, REQUIRE_TABLE_PRIMARY_KEY_CHECK = ON"},
+		{"CHANGE REPLICATION SOURCE TO ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS='aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa'", true, "CHANGE REPLICATION SOURCE TO ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS = 'aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa'"},
+		{"CHANGE MASTER TO MASTER_HOST='h1', SOURCE_LOG_FILE='binlog.000001', MASTER_LOG_POS=4, RELAY_LOG_FILE='r'", true, "CHANGE MASTER TO MASTER_HOST = 'h1', MASTER_LOG_FILE = 'binlog.000001', MASTER_LOG_POS = 4, RELAY_LOG_FILE = 'r'"},
+		{"CHANGE MASTER TO SOURCE_CONNECTION_AUTO_FAILOVER=0, PRIVILEGE_CHECKS_USER=NULL, IGNORE_SERVER_IDS=()", true, "CHANGE MASTER TO SOURCE_CONNECTION_AUTO_FAILOVER = 0, PRIVILEGE_CHECKS_USER = NULL, IGNORE_SERVER_IDS = ()"},
+		{"CHANGE MASTER TO REQUIRE_TABLE_PRIMARY_KEY_CHECK=stream, ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS=local", true, "CHANGE MASTER TO REQUIRE_TABLE_PRIMARY_KEY_CHECK = STREAM, ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS = LOCAL"},
+		{"CHANGE MASTER TO MASTER_SSL=2", false, ""},
+		{"CHANGE MASTER TO PRIVILEGE_CHECKS_USER=CURRENT_USER", false, ""},
+		{"CHANGE REPLICATION SOURCE TO", false, ""},
+		{"START REPLICA", true, "START REPLICA"},
+		{"START SLAVE IO_THREAD, SQL_THREAD UNTIL MASTER_LOG_FILE='b.1', MASTER_LOG_POS=100 USER='u' PASSWORD='p' DEFAULT_AUTH='a' PLUGIN_DIR='/d' FOR CHANNEL c", true, "START SLAVE IO_THREAD, SQL_THREAD UNTIL MASTER_LOG_FILE = 'b.1', MASTER_LOG_POS = 100 USER = 'u' PASSWORD = 'p' DEFAULT_AUTH = 'a' PLUGIN_DIR = '/d' FOR CHANNEL 'c'"},
+		{"START REPLICA SQL_THREAD UNTIL SQL_BEFORE_GTIDS='3E11FA47-71CA-11E1-9E33-C80AA9429562:11-56'", true, "START REPLICA SQL_THREAD UNTIL SQL_BEFORE_GTIDS = '3E11FA47-71CA-11E1-9E33-C80AA9429562:11-56'"},
+		{"START REPLICA UNTIL RELAY_LOG_FILE='r', RELAY_LOG_POS=5", true, "START REPLICA UNTIL RELAY_LOG_FILE = 'r', RELAY_LOG_POS = 5"},
+		{"START REPLICA RELAY_THREAD UNTIL SQL_AFTER_MTS_GAPS", true, "START REPLICA IO_THREAD UNTIL SQL_AFTER_MTS_GAPS"},
+		{"START REPLICA UNTIL MASTER_LOG_FILE='b.1'", false, ""},
+		{"STOP REPLICA IO_THREAD FOR CHANNEL ''", true, "STOP REPLICA IO_THREAD"},
+		{"STOP SLAVE", true, "STOP SLAVE"},
+		{"RESET REPLICA ALL FOR CHANNEL 'x'", true, "RESET REPLICA ALL FOR CHANNEL 'x'"},
+		{"RESET SLAVE", true, "RESET SLAVE"},
+		{"RESET MASTER", true, "RESET MASTER"},
+		{"RESET MASTER TO 1234", true, "RESET MASTER TO 1234"},
+		{"RESET MASTER TO 0", false, ""},
+		{"PURGE BINARY LOGS TO 'mysql-bin.010'", true, "PURGE BINARY LOGS TO 'mysql-bin.010'"},
+		{"PURGE MASTER LOGS BEFORE now() - interval 3 day", true, "PURGE MASTER LOGS BEFORE DATE_SUB(NOW(), INTERVAL 3 DAY)"},
+		{"PURGE BINARY LOGS", false, ""},
+		{"SHOW BINARY LOGS", true, "SHOW BINARY LOGS"},
+		{"SHOW MASTER LOGS", true, "SHOW BINARY LOGS"},
+		{"SHOW BINLOG EVENTS", true, "SHOW BINLOG EVENTS"},
+		{"SHOW BINLOG EVENTS IN 'mysql-bin.000001' FROM 4 LIMIT 1, 10", true, "SHOW BINLOG EVENTS IN 'mysql-bin.000001' FROM 4 LIMIT 1,10"},
+		{"SHOW RELAYLOG EVENTS", true, "SHOW RELAYLOG EVENTS"},
+		{"SHOW RELAYLOG EVENTS IN 'relay-bin.000002' FROM 4 LIMIT 10 FOR CHANNEL 'c1'", true, "SHOW RELAYLOG EVENTS IN 'relay-bin.000002' FROM 4 LIMIT 10 FOR CHANNEL 'c1'"},
+		{"show relaylog events limit 2 offset 1", true, "SHOW RELAYLOG EVENTS LIMIT 1,2"},
+		{"SHOW RELAYLOG EVENTS FOR CHANNEL 'c1' LIMIT 10", false, ""},
+		{"SHOW BINLOG EVENTS FOR CHANNEL 'c1'", false, ""},
+	}
+	RunTest(t, cases, false)
+}
+
+func TestShardingTableRule(t *testing.T) {
+	cases := []testCase{
+		{"CREATE SHARDING TABLE RULE IF NOT EXISTS employees.t_order (TOPOLOGY = 'db_${0000..0007}.t_order_${0000..0127}', SHARDING_COLUMNS = (uid), DATABASE_ALGORITHM = (TYPE = 'mod', PROPERTIES = ('divisor' = '8')), KEY_GENERATOR = (COLUMN = id, TYPE = 'snowflake'))", true, "CREATE SHARDING TABLE RULE IF NOT EXISTS `employees`.`t_order` (TOPOLOGY = 'db_${0000..0007}.t_order_${0000..0127}', SHARDING_COLUMNS = (`uid`), DATABASE_ALGORITHM = (TYPE = 'mod', PROPERTIES = ('divisor' = '8')), KEY_GENERATOR = (COLUMN = `id`, TYPE = 'snowflake'))"},
+		{"create sharding table rule t (table_algorithm (type 'scriptExpr', properties ('expr'='$value % 128', 'step'='128'))), t_user (topology 'db.t_user', sharding_columns (a, b))", true, "CREATE SHARDING TABLE RULE `t` (TABLE_ALGORITHM = (TYPE = 'scriptExpr', PROPERTIES = ('expr' = '$value % 128', 'step' = '128'))), `t_user` (TOPOLOGY = 'db.t_user', SHARDING_COLUMNS = (`a`, `b`))"},
+		{"CREATE SHARDING TABLE RULE t (TOPOLOGY = 'db_${0000..0007}')", false, ""},
+		{"CREATE SHARDING TABLE RULE t (TOPOLOGY = 'db_${0007..0000}.t')", false, ""},
+		{"CREATE SHARDING TABLE RULE t (TOPOLOGY = 'db_${a..b}.t')", false, ""},
+		{"CREATE SHARDING TABLE RULE t ()", false, ""},
+		{"CREATE SHARDING TABLE RULE t (DATABASE_ALGORITHM = (PROPERTIES = ('a' = 'b')))", false, ""},
+		{"CREATE SHARDING TABLE RULE t (KEY_GENERATOR = (TYPE = 'snowflake'))", false, ""},
+		{"ALTER SHARDING TABLE RULE t_order (TABLE_ALGORITHM = (TYPE = 'hash'))", true, "ALTER SHARDING TABLE RULE `t_order` (TABLE_ALGORITHM = (TYPE = 'hash'))"},
+		{"ALTER SHARDING TABLE RULE IF EXISTS t_order (TABLE_ALGORITHM = (TYPE = 'hash'))", false, ""},
+		{"DROP SHARDING TABLE RULE IF EXISTS t_order, db.t_user", true, "DROP SHARDING TABLE RULE IF EXISTS `t_order`, `db`.`t_user`"},
+		{"DROP SHARDING TABLE RULE t_order", true, "DROP SHARDING TABLE RULE `t_order`"},
+		{"DROP SHARDING TABLE RULE", false, ""},
+	}
+	RunTest(t, cases, false)
+}
+
+func TestTenantAndNode(t *testing.T) {
+	cases := []testCase{
+		{"CREATE TENANT IF NOT EXISTS t1", true, "CREATE TENANT IF NOT EXISTS `t1`"},
+		{"create tenant `t-2`", true, "CREATE TENANT `t-2`"},
+		{"CREATE TENANT", false, ""},
+		{"ALTER TENANT t1 ADD USER 'arana' IDENTIFIED BY '123456', ALTER USER root IDENTIFIED BY 'x'", true, "ALTER TENANT `t1` ADD USER 'arana' IDENTIFIED BY '123456', ALTER USER 'root' IDENTIFIED BY 'x'"},
+		{"ALTER TENANT t1 ADD USER IF NOT EXISTS 'arana' IDENTIFIED BY '123456'", true, "ALTER TENANT `t1` ADD USER IF NOT EXISTS 'arana' IDENTIFIED BY '123456'"},
+		{"ALTER TENANT t1 DROP USER IF EXISTS 'guest', RENAME TO t2", true, "ALTER TENANT `t1` DROP USER IF EXISTS 'guest', RENAME TO `t2`"},
+		{"ALTER TENANT t1 ADD USER 'arana'", false, ""},
+		{"ALTER TENANT t1", false, ""},
+		{"DROP TENANT IF EXISTS t1", true, "DROP TENANT IF EXISTS `t1`"},
+		{"DROP TENANT t1", true, "DROP TENANT `t1`"},
+		{"CREATE NODE node0 (HOST = '127.0.0.1', PORT = 3306, USERNAME = 'root', PASSWORD = '123456', WEIGHT = 10, LABELS = ('zone' = 'a', 'role'='primary')) FROM t1", true, "CREATE NODE `node0` (HOST = '127.0.0.1', PORT = 3306, USERNAME = 'root', PASSWORD = '123456', WEIGHT = 10, LABELS = ('zone' = 'a', 'role' = 'primary')) FROM `t1`"},
+		{"CREATE NODE IF NOT EXISTS node0 (HOST '127.0.0.1') FROM t1", true, "CREATE NODE IF NOT EXISTS `node0` (HOST = '127.0.0.1') FROM `t1`"},
+		{"CREATE NODE node0 (HOST = '127.0.0.1')", false, ""},
+		{"CREATE NODE node0 (PORT = 70000) FROM t1", false, ""},
+		{"ALTER NODE node0 (PASSWORD '1', port 3307) FROM t1", true, "ALTER NODE `node0` (PASSWORD = '1', PORT = 3307) FROM `t1`"},
+		{"DROP NODE IF EXISTS node0 FROM t1", true, "DROP NODE IF EXISTS `node0` FROM `t1`"},
+		{"DROP NODE node0", false, ""},
+	}
+	RunTest(t, cases, false)
+}
+
+func TestDBGroup(t *testing.T) {
+	cases := []testCase{
+		{"CREATE DB GROUP IF NOT EXISTS employees_0000 (PRIMARY = node0, REPLICAS = (node1 WEIGHT 10, node2), LOAD_BALANCE = weight, TRANSACTION_ROUTING = PRIMARY) FROM t1", true, "CREATE DB GROUP IF NOT EXISTS `employees_0000` (PRIMARY = `node0`, REPLICAS = (`node1` WEIGHT 10, `node2` WEIGHT 1), LOAD_BALANCE = WEIGHT, TRANSACTION_ROUTING = PRIMARY) FROM `t1`"},
+		{"CREATE DB GROUP g (PRIMARY node0) FROM t1", true, "CREATE DB GROUP `g` (PRIMARY = `node0`) FROM `t1`"},
+		{"CREATE DB GROUP g (PRIMARY node0)", false, ""},
+		{"ALTER DB GROUP g (REPLICAS (), load_balance round_robin, transaction_routing sticky) FROM t1", true, "ALTER DB GROUP `g` (REPLICAS = (), LOAD_BALANCE = ROUND_ROBIN, TRANSACTION_ROUTING = STICKY) FROM `t1`"},
+		{"ALTER DB GROUP g (transaction_routing = dynamic, LOAD_BALANCE = random) FROM t1", true, "ALTER DB GROUP `g` (TRANSACTION_ROUTING = DYNAMIC, LOAD_BALANCE = RANDOM) FROM `t1`"},
+		{"ALTER DB GROUP g (LOAD_BALANCE = foo) FROM t1", false, ""},
+		{"ALTER DB GROUP g (TRANSACTION_ROUTING = foo) FROM t1", false, ""},
+		{"ALTER DB GROUP g (REPLICAS = (node1 WEIGHT)) FROM t1", false, ""},
+		{"DROP DB GROUP IF EXISTS g FROM t1", true, "DROP DB GROUP IF EXISTS `g` FROM `t1`"},
+		{"DROP DB GROUP g FROM t1", true, "DROP DB GROUP `g` FROM `t1`"},
+		{"SHOW DB GROUPS FROM t1", true, "SHOW DB GROUPS FROM `t1`"},
+		{"SHOW DB GROUPS", false, ""},
+		{"SHOW DB GROUP FROM t1", false, ""},
+		{"SHOW DB foo FROM t1", false, ""},
+	}
+	RunTest(t, cases, false)
+}
+
+func TestShadowRule(t *testing.T) {
+	cases := []testCase{
+		{"CREATE SHADOW RULE IF NOT EXISTS db.student (SHADOW_GROUP = employees_shadow, MATCH uid = 10000 ON (INSERT, UPDATE), MATCH name REGEXP '^hanmeimei$' ON (delete), MATCH HINT ON (SELECT)), t2 (shadow_group g)", true, "CREATE SHADOW RULE IF NOT EXISTS `db`.`student` (SHADOW_GROUP = `employees_shadow`, MATCH `uid` = 10000 ON (INSERT, UPDATE), MATCH `name` REGEXP '^hanmeimei$' ON (DELETE), MATCH HINT ON (SELECT)), `t2` (SHADOW_GROUP = `g`)"},
+		{"CREATE SHADOW RULE t (SHADOW_GROUP = g, MATCH name REGEXP '(')", false, ""},
+		{"CREATE SHADOW RULE t (MATCH HINT)", false, ""},
+		{"CREATE SHADOW RULE t (SHADOW_GROUP = g, MATCH HINT ON ())", false, ""},
+		{"CREATE SHADOW RULE t (SHADOW_GROUP = g, MATCH uid = uid + 1)", false, ""},
+		{"ALTER SHADOW RULE student (SHADOW_GROUP = g, MATCH hint, MATCH tag = 'stress', MATCH k = -1 ON (UPDATE, SELECT, UPDATE))", true, "ALTER SHADOW RULE `student` (SHADOW_GROUP = `g`, MATCH HINT, MATCH `tag` = _UTF8MB4'stress', MATCH `k` = -1 ON (SELECT, UPDATE))"},
+		{"DROP SHADOW RULE IF EXISTS student, db.t2", true, "DROP SHADOW RULE IF EXISTS `student`, `db`.`t2`"},
+		{"DROP SHADOW RULE student", true, "DROP SHADOW RULE `student`"},
+		{"SHOW SHADOW RULES", true, "SHOW SHADOW RULES"},
+		{"SHOW SHADOW RULES FROM db", true, "SHOW SHADOW RULES FROM `db`"},
+		{"SHOW SHADOW TABLES", true, "SHOW SHADOW TABLES"},
+		{"SHOW SHADOW TABLES IN db", true, "SHOW SHADOW TABLES FROM `db`"},
+	}
+	RunTest(t, cases, false)
+}
+
+func TestExplainSharding(t *testing.T) {
+	cases := []testCase{
+		{"EXPLAIN SHARDING SELECT * FROM t WHERE uid = 1", true, "EXPLAIN SHARDING SELECT * FROM `t` WHERE `uid`=1"},
+		{"desc sharding select * from t1 join t2 on t1.id = t2.id", true, "EXPLAIN SHARDING SELECT * FROM `t1` JOIN `t2` ON `t1`.`id`=`t2`.`id`"},
+		{"explain sharding (select 1) union select 2", true, "EXPLAIN SHARDING (SELECT 1) UNION SELECT 2"},
+		{"explain sharding with cte as (select 1) select * from cte", true, "EXPLAIN SHARDING WITH `cte` AS (SELECT 1) SELECT * FROM `cte`"},
+		{"explain sharding insert into t values (1), (2)", true, "EXPLAIN SHARDING INSERT INTO `t` VALUES (1),(2)"},
+		{"explain sharding replace into t values (1)", true, "EXPLAIN SHARDING REPLACE INTO `t` VALUES (1)"},
+		{"PREVIEW UPDATE t SET c = 1 WHERE uid IN (1, 2)", true, "PREVIEW UPDATE `t` SET `c`=1 WHERE `uid` IN (1,2)"},
+		{"preview delete from t where uid between 1 and 10", true, "PREVIEW DELETE FROM `t` WHERE `uid` BETWEEN 1 AND 10"},
+		{"preview create table t (a int)", false, ""},
+		{"explain sharding begin", true, "DESC `sharding` `begin`"},
+		{"desc sharding", true, "DESC `sharding`"},
+	}
+	RunTest(t, cases, false)
+
+	p := parser.New()
+	src := "select 1; EXPLAIN SHARDING  select * from t where uid = ? ;\npreview delete from t"
+	stmts, _, err := p.Parse(src, "", "")
+	require.NoError(t, err)
+	require.Len(t, stmts, 3)
+	inner := stmts[1].(*ast.ExplainShardingStmt).Stmt
+	require.Equal(t, "select * from t where uid = ?", inner.Text())
+	require.Equal(t, strings.Index(src, "select * from t"), inner.OriginTextPosition())
+	inner = stmts[2].(*ast.ExplainShardingStmt).Stmt
+	require.Equal(t, "delete from t", inner.Text())
+	require.Equal(t, strings.Index(src, "delete"), inner.OriginTextPosition())
+	require.True(t, stmts[2].(*ast.ExplainShardingStmt).Preview)
+}
+
+func TestCheckShardingTable(t *testing.T) {
+	cases := []testCase{
+		{"CHECK SHARDING TABLE t", true, "CHECK SHARDING TABLE `t`"},
+		{"check sharding table db.t, t2 with (row_count)", true, "CHECK SHARDING TABLE `db`.`t`, `t2` WITH (ROW_COUNT)"},
+		{"check sharding table t with (indexes, columns, row_count, columns)", true, "CHECK SHARDING TABLE `t` WITH (COLUMNS, INDEXES, ROW_COUNT)"},
+		{"check sharding table t with ()", false, ""},
+		{"check sharding table t with (rows)", false, ""},
+		{"check sharding table", false, ""},
+	}
+	RunTest(t, cases, false)
+
+	p := parser.New()
+	stmt, err := p.ParseOneStmt("check sharding table t", "", "")
+	require.NoError(t, err)
+	require.Equal(t, ast.CheckShardingTableSchema, stmt.(*ast.CheckShardingTableStmt).CheckItems())
+	stmt, err = p.ParseOneStmt("check sharding table t with (row_count)", "", "")
+	require.NoError(t, err)
+	require.Equal(t, ast.CheckShardingTableRowCount, stmt.(*ast.CheckShardingTableStmt).CheckItems())
+}
+
+func TestProxySequence(t *testing.T) {
+	cases := []testCase{
+		{"create sequence seq type = snowflake worker_id 3", true, "CREATE SEQUENCE `seq` TYPE = SNOWFLAKE WORKER_ID = 3"},
+		{"create sequence seq type group step = 1000 cache 10", true, "CREATE SEQUENCE `seq` TYPE = GROUP STEP = 1000 CACHE 10"},
+		{"create sequence seq type = segment start with 100 step 500", true, "CREATE SEQUENCE `seq` TYPE = SEGMENT START WITH 100 STEP = 500"},
+		{"create sequence seq type = segment step 0", false, ""},
+		{"create sequence seq worker_id 3", false, ""},
+		{"create sequence seq type = group worker_id 3", false, ""},
+		{"create sequence seq type = snowflake worker_id 1024", false, ""},
+		{"create sequence seq type = snowflake step 10", false, ""},
+		{"create sequence seq type = snowflake type = group", false, ""},
+		{"create sequence seq type = auto", false, ""},
+		{"alter sequence seq set value = 100", true, "ALTER SEQUENCE `seq` SET VALUE = 100"},
+		{"alter sequence seq set value -1 cache 10", true, "ALTER SEQUENCE `seq` SET VALUE = -1 CACHE 10"},
+		{"alter sequence seq step 10", true, "ALTER SEQUENCE `seq` STEP = 10"},
+		{"alter sequence seq type snowflake worker_id 1", true, "ALTER SEQUENCE `seq` TYPE = SNOWFLAKE WORKER_ID = 1"},
+		{"alter sequence seq type segment worker_id 1", false, ""},
+		{"alter sequence seq worker_id 1023", true, "ALTER SEQUENCE `seq` WORKER_ID = 1023"},
+		{"alter sequence seq worker_id 5000", false, ""},
+		{"alter sequence seq step 0", false, ""},
+		{"alter sequence seq type group step 0", false, ""},
+		{"create sequence seq set value 1", false, ""},
+		{"show sequences", true, "SHOW SEQUENCES"},
+		{"show sequences from db", true, "SHOW SEQUENCES FROM `db`"},
+		{"show sequence status", true, "SHOW SEQUENCE STATUS"},
+		{"show sequence status in db", true, "SHOW SEQUENCE STATUS FROM `db`"},
+
+		// seq.NEXTVAL is the same as NEXTVAL(seq)
+		{"select seq.nextval, db.seq.NEXTVAL, seq.nextval + 1", true, "SELECT NEXTVAL(`seq`),NEXTVAL(`db`.`seq`),NEXTVAL(`seq`)+1"},
+		{"insert into t values (seq.nextval, nextval(seq2))", true, "INSERT INTO `t` VALUES (NEXTVAL(`seq`),NEXTVAL(`seq2`))"},
+		{"update t set id = seq.nextval where id in (select s.nextval)", true, "UPDATE `t` SET `id`=NEXTVAL(`seq`) WHERE `id` IN (SELECT NEXTVAL(`s`))"},
+		{"select `t`.`nextval`, nextval, db.`t`.`nextval` from t", true, "SELECT `t`.`nextval`,`nextval`,`db`.`t`.`nextval` FROM `t`"},
+		{"select s.nextval /* c */ from t", true, "SELECT NEXTVAL(`s`) FROM `t`"},
+		{"insert into t values (s.nextval/**/)", true, "INSERT INTO `t` VALUES (NEXTVAL(`s`))"},
+		{"select s.nextval -- c", true, "SELECT NEXTVAL(`s`)"},
+		{"select db.s.NEXTVAL/* c */, s . nextval from t", true, "SELECT NEXTVAL(`db`.`s`),NEXTVAL(`s`) FROM `t`"},
+		{"select s.`nextval` /* c */, db.s.`nextval`/**/ from t", true, "SELECT `s`.`nextval`,`db`.`s`.`nextval` FROM `t`"},
+		{"select s.`nextval`-- c", true, "SELECT `s`.`nextval`"},
+		{"select default(s.nextval), s.nextval from t", true, "SELECT DEFAULT(`s`.`nextval`),NEXTVAL(`s`) FROM `t`"},
+	}
+	RunTest(t, cases, false)
+}
+
 func TestAsyncImport(t *testing.T) {
 	cases := []testCase{
 		{"create import test from 'file:///d/'", true, "CREATE IMPORT `test` FROM 'file:///d/'"},
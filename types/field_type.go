@@ -46,6 +46,49 @@ type FieldType struct {
 	Collate string
 	// Elems is the element list for enum and set type.
 	Elems []string
+	// GeometryType is the spatial subtype of a geometry type.
+	GeometryType GeometryType
+}
+
+// GeometryType is the subtype of mysql.TypeGeometry. All spatial column types share
+// mysql.TypeGeometry, the same way MySQL reports them over the wire.
+type GeometryType byte
+
+// GeometryType values, in the same order as MySQL's Field::geometry_type.
+const (
+	GeometryTypeGeometry GeometryType = iota
+	GeometryTypePoint
+	GeometryTypeLineString
+	GeometryTypePolygon
+	GeometryTypeMultiPoint
+	GeometryTypeMultiLineString
+	GeometryTypeMultiPolygon
+	GeometryTypeGeometryCollection
+)
+
+var geometryType2Str = map[GeometryType]string{
+	GeometryTypeGeometry:           "geometry",
+	GeometryTypePoint:              "point",
+	GeometryTypeLineString:         "linestring",
+	GeometryTypePolygon:            "polygon",
+	GeometryTypeMultiPoint:         "multipoint",
+	GeometryTypeMultiLineString:    "multilinestring",
+	GeometryTypeMultiPolygon:       "multipolygon",
+	GeometryTypeGeometryCollection: "geometrycollection",
+}
+
+// String implements fmt.Stringer interface.
+func (t GeometryType) String() string {
+	return geometryType2Str[t]
+}
+
+// NewGeometryFieldType returns a FieldType of mysql.TypeGeometry with the given subtype.
+func NewGeometryFieldType(tp GeometryType) *FieldType {
+	ft := NewFieldType(mysql.TypeGeometry)
+	ft.GeometryType = tp
+	ft.Charset = charset.CharsetBin
+	ft.Collate = charset.CollationBin
+	return ft
 }
 
 // NewFieldType returns a FieldType,
@@ -79,7 +122,8 @@ func (ft *FieldType) Equal(other *FieldType) bool {
 		ft.Charset == other.Charset &&
 		ft.Collate == other.Collate &&
 		flenEqual &&
-		mysql.HasUnsignedFlag(ft.Flag) == mysql.HasUnsignedFlag(other.Flag)
+		mysql.HasUnsignedFlag(ft.Flag) == mysql.HasUnsignedFlag(other.Flag) &&
+		ft.GeometryType == other.GeometryType
 	if !partialEqual || len(ft.Elems) != len(other.Elems) {
 		return false
 	}
@@ -133,6 +177,9 @@ func (ft *FieldType) Init(tp byte) {
 // This is used for showing column type in infoschema.
 func (ft *FieldType) CompactStr() string {
 	ts := TypeToStr(ft.Tp, ft.Charset)
+	if ft.Tp == mysql.TypeGeometry {
+		ts = ft.GeometryType.String()
+	}
 	suffix := ""
 
 	defaultFlen, defaultDecimal := mysql.GetDefaultFieldLengthAndDecimal(ft.Tp)
@@ -222,7 +269,11 @@ func (ft *FieldType) String() string {
 
 // Restore implements Node interface.
 func (ft *FieldType) Restore(ctx *format.RestoreCtx) error {
-	ctx.WriteKeyWord(TypeToStr(ft.Tp, ft.Charset))
+	if ft.Tp == mysql.TypeGeometry {
+		ctx.WriteKeyWord(ft.GeometryType.String())
+	} else {
+		ctx.WriteKeyWord(TypeToStr(ft.Tp, ft.Charset))
+	}
 
 	precision := UnspecifiedLength
 	scale := UnspecifiedLength
@@ -300,4 +300,40 @@ func TestFieldTypeEqual(t *testing.T) {
 	ft2.Decimal = -1
 	ft1.Flen = 23
 	require.Equal(t, true, ft1.Equal(ft2))
+
+	// GeometryType not equal
+	ft1 = NewGeometryFieldType(GeometryTypePoint)
+	ft2 = NewGeometryFieldType(GeometryTypePolygon)
+	require.Equal(t, false, ft1.Equal(ft2))
+	ft2.GeometryType = GeometryTypePoint
+	require.Equal(t, true, ft1.Equal(ft2))
+}
+
+func TestGeometryFieldType(t *testing.T) {
+	p := parser.New()
+	cases := []struct {
+		sql string
+		ex  GeometryType
+	}{
+		{"geometry", GeometryTypeGeometry},
+		{"point", GeometryTypePoint},
+		{"linestring", GeometryTypeLineString},
+		{"polygon", GeometryTypePolygon},
+		{"multipoint", GeometryTypeMultiPoint},
+		{"multilinestring", GeometryTypeMultiLineString},
+		{"multipolygon", GeometryTypeMultiPolygon},
+		{"geometrycollection", GeometryTypeGeometryCollection},
+		{"geomcollection", GeometryTypeGeometryCollection},
+	}
+
+	for _, ca := range cases {
+		stmt, err := p.ParseOneStmt(fmt.Sprintf("create table t (g %v)", ca.sql), "", "")
+		require.NoError(t, err)
+		col := stmt.(*ast.CreateTableStmt).Cols[0]
+		require.Equal(t, mysql.TypeGeometry, col.Tp.Tp)
+		require.Equal(t, ca.ex, col.Tp.GeometryType)
+		require.Equal(t, ca.ex.String(), col.Tp.String())
+		require.Equal(t, charset.CharsetBin, col.Tp.Charset)
+		require.False(t, HasCharset(col.Tp))
+	}
 }
@@ -18,6 +18,7 @@ import (
 	"math"
 	"regexp"
 	"strconv"
+	"strings"
 	"unicode"
 
 	"github.com/pingcap/errors"
@@ -59,6 +60,10 @@ var (
 	ErrWarnDeprecatedIntegerDisplayWidth = terror.ClassParser.NewStdErr(mysql.ErrWarnDeprecatedSyntaxNoReplacement, mysql.Message("Integer display width is deprecated and will be removed in a future release.", nil))
 	// ErrWrongUsage returns for incorrect usages.
 	ErrWrongUsage = terror.ClassParser.NewStd(mysql.ErrWrongUsage)
+	// ErrBadSQLState returns for invalid SQLSTATE values.
+	ErrBadSQLState = terror.ClassParser.NewStd(mysql.ErrSpBadSQLstate)
+	// ErrDupSignalSet returns for duplicate condition information items of SIGNAL/RESIGNAL.
+	ErrDupSignalSet = terror.ClassParser.NewStd(mysql.ErrDupSignalSet)
 	// SpecFieldPattern special result field pattern
 	SpecFieldPattern = regexp.MustCompile(`(\/\*!(M?[0-9]{5,6})?|\*\/)`)
 	specCodeStart    = regexp.MustCompile(`^\/\*!(M?[0-9]{5,6})?[ \t]*`)
@@ -89,6 +94,8 @@ type Parser struct {
 	explicitCharset       bool
 	strictDoubleFieldType bool
 	paramMarkerCursor     int
+	// seqNextVal indicates the last reduced identifier expression is `seq.NEXTVAL`.
+	seqNextVal bool
 
 	// the following fields are used by yyParse to reduce allocation.
 	cache  []yySymType
@@ -229,6 +236,29 @@ func (parser *Parser) setLastSelectFieldText(st *ast.SelectStmt, lastEnd int) {
 	}
 }
 
+// isUnquotedNextVal checks whether the identifier is an unquoted NEXTVAL, the
+// quotedness is taken from the first character of the identifier token.
+func (parser *Parser) isUnquotedNextVal(ident string, v *yySymType) bool {
+	if strings.ToLower(ident) != ast.NextVal {
+		return false
+	}
+	ch := parser.src[v.offset]
+	return ch != '`' && ch != '"'
+}
+
+// The statement wrapped by EXPLAIN SHARDING is not at the end of the whole
+// statement, its text was set from its offset to the end of the src string,
+// trim it to the end of the enclosing statement.
+func (parser *Parser) setShardingPreviewText(st *ast.ExplainShardingStmt, lastEnd int) {
+	startOffset := st.Stmt.OriginTextPosition()
+	if startOffset >= lastEnd {
+		return
+	}
+	text := strings.TrimSpace(parser.src[startOffset:lastEnd])
+	text = strings.TrimSpace(strings.TrimSuffix(text, ";"))
+	st.Stmt.SetText(parser.lexer.client, text)
+}
+
 func (parser *Parser) startOffset(v *yySymType) int {
 	return v.offset
 }
@@ -446,3 +476,166 @@ func (c CharsetClient) ApplyOn(p *Parser) error {
 	p.lexer.client = charset.FindEncoding(string(c))
 	return nil
 }
+
+// localAssignmentMarker marks assignments to the local variables and parameters
+// of a stored program, and to the `NEW.col` and `OLD.col` row columns of a trigger
+// body as local, since the grammar can not tell them apart from assignments of
+// system variables. Only the unscoped names are marked, `SET SESSION a` and
+// `SET @@a` always assign the system variable. A local variable is only visible
+// in the BEGIN ... END block declaring it and the blocks nested in it.
+type localAssignmentMarker struct {
+	// scopes holds the parameters and the local variables declared by each of
+	// the enclosing BEGIN ... END blocks, innermost last.
+	scopes    []map[string]struct{}
+	isTrigger bool
+}
+
+// Enter implements ast.Visitor interface.
+func (m *localAssignmentMarker) Enter(n ast.Node) (ast.Node, bool) {
+	switch x := n.(type) {
+	case *ast.CompoundStmt:
+		m.scopes = append(m.scopes, make(map[string]struct{}))
+	case *ast.DeclareVarStmt:
+		scope := m.scopes[len(m.scopes)-1]
+		for _, name := range x.Names {
+			scope[name.L] = struct{}{}
+		}
+	case *ast.VariableAssignment:
+		if x.IsSystem && !x.IsScoped && m.isLocal(x.Name) {
+			x.IsSystem = false
+			x.IsLocal = true
+		}
+		return n, true
+	}
+	return n, false
+}
+
+// Leave implements ast.Visitor interface.
+func (m *localAssignmentMarker) Leave(n ast.Node) (ast.Node, bool) {
+	if _, ok := n.(*ast.CompoundStmt); ok {
+		m.scopes = m.scopes[:len(m.scopes)-1]
+	}
+	return n, true
+}
+
+func (m *localAssignmentMarker) isLocal(name string) bool {
+	name = strings.ToLower(name)
+	if m.isTrigger && (strings.HasPrefix(name, "new.") || strings.HasPrefix(name, "old.")) {
+		return true
+	}
+	for _, scope := range m.scopes {
+		if _, ok := scope[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func markLocalAssignments(body ast.StmtNode, params []*ast.ProcedureParameter, isTrigger bool) {
+	scope := make(map[string]struct{}, len(params))
+	for _, param := range params {
+		scope[param.Name.L] = struct{}{}
+	}
+	m := &localAssignmentMarker{
+		scopes:    []map[string]struct{}{scope},
+		isTrigger: isTrigger,
+	}
+	body.Accept(m)
+}
+
+// rowAliasMarker marks the columns referring to the row alias of
+// `INSERT ... AS alias ON DUPLICATE KEY UPDATE`, either qualified by the
+// alias or named by one of its column aliases.
+type rowAliasMarker struct {
+	alias   string
+	columns map[string]struct{}
+}
+
+// Enter implements ast.Visitor interface.
+func (m *rowAliasMarker) Enter(n ast.Node) (ast.Node, bool) {
+	if x, ok := n.(*ast.ColumnName); ok {
+		if x.Schema.L == "" && x.Table.L == m.alias {
+			x.IsRowAlias = true
+		} else if _, ok := m.columns[x.Name.L]; ok && x.Table.L == "" {
+			x.IsRowAlias = true
+		}
+	}
+	return n, false
+}
+
+// Leave implements ast.Visitor interface.
+func (m *rowAliasMarker) Leave(n ast.Node) (ast.Node, bool) {
+	return n, true
+}
+
+func markRowAliasColumns(stmt *ast.InsertStmt) {
+	if stmt.RowAlias.L == "" {
+		return
+	}
+	m := &rowAliasMarker{
+		alias:   stmt.RowAlias.L,
+		columns: make(map[string]struct{}, len(stmt.RowAliasColumns)),
+	}
+	for _, col := range stmt.RowAliasColumns {
+		m.columns[col.L] = struct{}{}
+	}
+	for _, assignment := range stmt.OnDuplicate {
+		assignment.Expr.Accept(m)
+	}
+}
+
+// newValuesSelectStmt builds the SelectStmt of a `VALUES ROW(...), ...` statement.
+func newValuesSelectStmt(rows []*ast.RowExpr) *ast.SelectStmt {
+	return &ast.SelectStmt{
+		Kind:   ast.SelectStmtKindValues,
+		Fields: &ast.FieldList{Fields: []*ast.SelectField{{WildCard: &ast.WildCardField{}}}},
+		Lists:  rows,
+	}
+}
+
+// binaryLiteralToUint64 converts a hexadecimal or bit literal to an unsigned integer,
+// it returns false if the value doesn't fit in 64 bits.
+func binaryLiteralToUint64(lit ast.BinaryLiteral) (uint64, bool) {
+	var v uint64
+	for _, b := range []byte(lit.ToString()) {
+		if v>>56 != 0 {
+			return 0, false
+		}
+		v = v<<8 | uint64(b)
+	}
+	return v, true
+}
+
+// checkSelectLockClause checks the locking clause can follow the clauses in info,
+// it returns the error message if a table would be locked by more than one clause.
+func checkSelectLockClause(info, clause *ast.SelectLockInfo) string {
+	locked := make(map[string]struct{})
+	for _, c := range info.Clauses() {
+		if len(c.Tables) == 0 && len(clause.Tables) == 0 {
+			return "Only one locking clause without OF is allowed in a query block"
+		}
+		for _, tbl := range c.Tables {
+			locked[tbl.Schema.L+"."+tbl.Name.L] = struct{}{}
+		}
+	}
+	for _, tbl := range clause.Tables {
+		key := tbl.Schema.L + "." + tbl.Name.L
+		if _, ok := locked[key]; ok {
+			return fmt.Sprintf("Table %s appears in multiple locking clauses", tbl.Name.O)
+		}
+		locked[key] = struct{}{}
+	}
+	return ""
+}
+
+// isArrayCastType checks whether the type can be the target of `CAST(expr AS type ARRAY)`,
+// which is used by multi-valued indexes.
+// See https://dev.mysql.com/doc/refman/8.0/en/create-index.html#create-index-multi-valued
+func isArrayCastType(tp *types.FieldType) bool {
+	switch tp.Tp {
+	case mysql.TypeVarString, mysql.TypeString, mysql.TypeDate, mysql.TypeDatetime,
+		mysql.TypeNewDecimal, mysql.TypeLonglong, mysql.TypeDuration:
+		return true
+	}
+	return false
+}